@@ -17,9 +17,18 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// MaxGPUCount is the largest GPUCount the CRD accepts. It must match the GPUCount validation
+// marker; the controller enforces it for objects that bypassed validation.
+const MaxGPUCount = 8
+
+// SchedulingStrategies lists the values SchedulingStrategy accepts. It must match the
+// SchedulingStrategy validation marker.
+var SchedulingStrategies = []string{"leastLoaded", "binPack", "random", "costOptimized", "numaAware", "migPartition", "requestRateBalance", "topologyAware", "dataLocality", "reliabilityWeighted", "fairShare", "healthScore", "spread"}
+
 // GPUWorkloadSpec defines the desired state of a GPU workload.
 type GPUWorkloadSpec struct {
 	// ModelName is the name of the model or workload (e.g., "llama2", "stable-diffusion").
@@ -28,11 +37,208 @@ type GPUWorkloadSpec struct {
 	// +kubebuilder:validation:MaxLength=255
 	ModelName string `json:"modelName"`
 
+	// Image is the container image that runs the workload. When omitted, a placeholder image is used.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image,omitempty"`
+
+	// Command overrides the entrypoint of the workload image.
+	// +kubebuilder:validation:Optional
+	Command []string `json:"command,omitempty"`
+
+	// Args are the arguments passed to the workload command.
+	// +kubebuilder:validation:Optional
+	Args []string `json:"args,omitempty"`
+
+	// Env sets environment variables in the workload container, such as HF_TOKEN from a
+	// Secret. A variable named like one the controller sets, e.g. MODEL_NAME, replaces it.
+	// +kubebuilder:validation:Optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Volumes are added to the workload's pod, for instance a PersistentVolumeClaim holding
+	// the model weights.
+	// +kubebuilder:validation:Optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// VolumeMounts mount Volumes into the workload container.
+	// +kubebuilder:validation:Optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+
 	// GPUCount is the number of GPUs required for this workload.
-	// +kubebuilder:validation:Required
+	// May be omitted when ModelSizeGB is set, in which case the count is inferred.
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=8
-	GPUCount int32 `json:"gpuCount"`
+	GPUCount int32 `json:"gpuCount,omitempty"`
+
+	// GPUVendor selects the GPU vendor whose resource is requested: "nvidia", "amd", or "intel".
+	// "auto" picks the first vendor, in the controller's preference order, with a fitting node.
+	// When empty, NVIDIA GPUs are requested.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=nvidia;amd;intel;auto
+	GPUVendor string `json:"gpuVendor,omitempty"`
+
+	// ModelSizeGB is the approximate memory footprint of the model in gigabytes.
+	// When GPUCount is omitted, the controller infers the GPU count from this value.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	ModelSizeGB int32 `json:"modelSizeGB,omitempty"`
+
+	// MIGProfile requests NVIDIA MIG slices of the given profile (e.g. "1g.10gb") instead of
+	// whole GPUs. GPUCount is then the number of slices.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+g\.[0-9]+gb$`
+	MIGProfile string `json:"migProfile,omitempty"`
+
+	// CPURequest is the CPU the workload container requests, e.g. "8" or "500m", replacing the
+	// amount derived from the priority. Left to the priority when empty.
+	// +kubebuilder:validation:Optional
+	CPURequest string `json:"cpuRequest,omitempty"`
+
+	// CPULimit caps the CPU of the workload container. Left to the priority when empty.
+	// +kubebuilder:validation:Optional
+	CPULimit string `json:"cpuLimit,omitempty"`
+
+	// MemoryRequest is the memory the workload container requests, e.g. "64Gi", replacing the
+	// amount derived from the priority. Left to the priority when empty.
+	// +kubebuilder:validation:Optional
+	MemoryRequest string `json:"memoryRequest,omitempty"`
+
+	// MemoryLimit caps the memory of the workload container. Left to the priority when empty.
+	// +kubebuilder:validation:Optional
+	MemoryLimit string `json:"memoryLimit,omitempty"`
+
+	// MinGPUMemoryGB is the minimum memory, in gigabytes, each GPU of the workload's node must
+	// have, as reported by the node's nvidia.com/gpu.memory label. Nodes without the label
+	// are not considered. Zero accepts any GPU.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	MinGPUMemoryGB int32 `json:"minGPUMemoryGB,omitempty"`
+
+	// DegradeOnRetry allows the controller to retry scheduling with fewer GPUs, one fewer
+	// per failed attempt, down to MinGPUCount, trading performance for availability.
+	// +kubebuilder:validation:Optional
+	DegradeOnRetry bool `json:"degradeOnRetry,omitempty"`
+
+	// MinGPUCount is the smallest GPU count accepted when DegradeOnRetry is set.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=8
+	MinGPUCount int32 `json:"minGPUCount,omitempty"`
+
+	// Replicas is the number of pods, each holding GPUCount GPUs, the workload runs as a gang.
+	// Every replica must fit across the candidate nodes before any Job is created; when they
+	// don't, the workload stays Pending rather than starting a partial set. Defaults to 1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +kubebuilder:default=1
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// RuntimeClassName is the RuntimeClass of the workload's pod. Its PodOverhead counts
+	// towards the resources a node must have free.
+	// +kubebuilder:validation:Optional
+	RuntimeClassName string `json:"runtimeClassName,omitempty"`
+
+	// Cluster selects where the workload runs: empty for the controller's own cluster, the
+	// name of a configured remote cluster, or "auto" to burst to the remote clusters, in
+	// configured order, when no local node fits.
+	// +kubebuilder:validation:Optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// DryRun computes where the workload would be placed without creating its Jobs. The
+	// chosen node is reported in status and the workload ends in the Simulated phase; it is
+	// placed for real once DryRun is cleared.
+	// +kubebuilder:validation:Optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// SuccessExitCodes lists the container exit codes that count as success. When set, a
+	// finished workload is only marked Succeeded if its pod terminated with one of these codes.
+	// +kubebuilder:validation:Optional
+	SuccessExitCodes []int32 `json:"successExitCodes,omitempty"`
+
+	// WarmupSeconds is how long the workload's pod must have been running before the
+	// workload is reported as Running, giving servers time to load their models.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	WarmupSeconds *int32 `json:"warmupSeconds,omitempty"`
+
+	// TerminationGracePeriodSeconds is how long the workload's pod is given to shut down, for
+	// instance to flush state, before it is killed. Defaults to the pod default of 30 seconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// JobBackoffLimit is how many times the workload's pod is retried before its Job, and
+	// with it the workload, fails. Defaults to 0, failing on the first container failure.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	JobBackoffLimit *int32 `json:"jobBackoffLimit,omitempty"`
+
+	// RestartPolicy of the workload's pod: "Never" replaces a failed pod, "OnFailure" restarts
+	// its container in place. Both count towards JobBackoffLimit. Defaults to "Never".
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Never;OnFailure
+	RestartPolicy corev1.RestartPolicy `json:"restartPolicy,omitempty"`
+
+	// AllowCPUFallback runs the workload without GPUs, using CPUFallbackImage, when no GPU node
+	// could host it for CPUFallbackAfterSeconds or its scheduling retries are exhausted.
+	// Intended for functional testing, not production.
+	// +kubebuilder:validation:Optional
+	AllowCPUFallback bool `json:"allowCPUFallback,omitempty"`
+
+	// CPUFallbackImage is the CPU-only container image run when falling back. Required with AllowCPUFallback.
+	// +kubebuilder:validation:Optional
+	CPUFallbackImage string `json:"cpuFallbackImage,omitempty"`
+
+	// CPUFallbackAfterSeconds is how long, from creation, the workload waits for a GPU node
+	// before falling back to CPU. Defaults to 600.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	CPUFallbackAfterSeconds *int32 `json:"cpuFallbackAfterSeconds,omitempty"`
+
+	// SchedulingDeadlineSeconds is how long, from creation, the workload may stay Pending
+	// before it fails with "scheduling deadline exceeded" instead of being retried further.
+	// Deadline failures are not auto-retried. Disabled when unset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	SchedulingDeadlineSeconds *int32 `json:"schedulingDeadlineSeconds,omitempty"`
+
+	// AutoRetryAfterSeconds returns a Failed workload to Pending this long after it failed,
+	// with its retries reset, to try again when failures come from transient capacity
+	// shortages. Workloads failed for an invalid spec are not retried. Disabled when unset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	AutoRetryAfterSeconds *int32 `json:"autoRetryAfterSeconds,omitempty"`
+
+	// MaxAutoRetries is how many times AutoRetryAfterSeconds may return the workload to
+	// Pending before it stays Failed. Defaults to 3.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	MaxAutoRetries int32 `json:"maxAutoRetries,omitempty"`
+
+	// AllowMigration lets the controller move the Running workload to a node that would leave
+	// noticeably more GPUs free around it than its current one, when --migration-period is set.
+	// The workload's Job is restarted on the new node.
+	// +kubebuilder:validation:Optional
+	AllowMigration bool `json:"allowMigration,omitempty"`
+
+	// AllowDefragmentation lets the defragmenter move the placed workload off its node to
+	// consolidate GPUs, restarting its Job elsewhere. A node holding any workload that does
+	// not allow it is never drained.
+	// +kubebuilder:validation:Optional
+	AllowDefragmentation bool `json:"allowDefragmentation,omitempty"`
+
+	// TTLSecondsAfterFinished deletes the workload, and with it its Jobs, this long after it
+	// Succeeded or Failed for good, i.e. with no auto-retry pending. Kept indefinitely when unset.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Schedule restricts the times at which the workload may be scheduled.
+	// +kubebuilder:validation:Optional
+	Schedule *WorkloadSchedule `json:"schedule,omitempty"`
 
 	// Priority defines the priority level of the workload: "low", "normal", or "high".
 	// +kubebuilder:validation:Optional
@@ -40,13 +246,42 @@ type GPUWorkloadSpec struct {
 	// +kubebuilder:default=normal
 	Priority string `json:"priority,omitempty"`
 
+	// PreemptionPolicy decides whether the workload may preempt scheduled or running workloads
+	// of a lower priority when no node has room for it: "Never" or "PreemptLowerPriority".
+	// Preempted workloads return to Pending.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Never;PreemptLowerPriority
+	// +kubebuilder:default=Never
+	PreemptionPolicy string `json:"preemptionPolicy,omitempty"`
+
 	// SchedulingStrategy defines which scheduling algorithm to use.
-	// Options: "leastLoaded", "random", "costOptimized"
+	// Options: "leastLoaded", "binPack", "random", "costOptimized", "numaAware", "migPartition", "requestRateBalance", "topologyAware", "dataLocality", "reliabilityWeighted", "fairShare", "healthScore", "spread"
+	// Defaults to leastLoaded, or migPartition when MIGProfile is set.
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:Enum=leastLoaded;random;costOptimized
-	// +kubebuilder:default=leastLoaded
+	// +kubebuilder:validation:Enum=leastLoaded;binPack;random;costOptimized;numaAware;migPartition;requestRateBalance;topologyAware;dataLocality;reliabilityWeighted;fairShare;healthScore;spread
 	SchedulingStrategy string `json:"schedulingStrategy,omitempty"`
 
+	// TopologyKey is the node label whose value names the topology domain, such as a zone or
+	// rack, the topologyAware strategy keeps all of the workload's GPUs within.
+	// Defaults to topology.kubernetes.io/zone.
+	// +kubebuilder:validation:Optional
+	TopologyKey string `json:"topologyKey,omitempty"`
+
+	// DataLocalityLabel is the node label, as "key=value" or just "key", marking nodes that
+	// have the workload's dataset cached, e.g. "dataset=imagenet". The dataLocality strategy
+	// prefers those nodes.
+	// +kubebuilder:validation:Optional
+	DataLocalityLabel string `json:"dataLocalityLabel,omitempty"`
+
+	// NodeSelector restricts the workload to nodes carrying all of these labels. It is also
+	// set on the workload's pods.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are added to the workload's pods, letting them run on tainted GPU nodes.
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
 	// RetryPolicy defines the retry behavior for failed scheduling attempts.
 	// +kubebuilder:validation:Optional
 	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
@@ -66,6 +301,44 @@ type RetryPolicy struct {
 	// +kubebuilder:validation:Maximum=300
 	// +kubebuilder:default=30
 	BackoffSeconds int32 `json:"backoffSeconds,omitempty"`
+
+	// BackoffMode selects how the delay grows between attempts: "exponential" doubles it
+	// on every attempt, "decorrelated" picks a random delay between backoffSeconds and three
+	// times the previous delay, spreading out workloads that fail together.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=exponential;decorrelated
+	// +kubebuilder:default=exponential
+	BackoffMode string `json:"backoffMode,omitempty"`
+}
+
+const (
+	// BackoffExponential doubles the retry delay on every attempt.
+	BackoffExponential = "exponential"
+
+	// BackoffDecorrelated picks each retry delay at random, building on the previous one.
+	BackoffDecorrelated = "decorrelated"
+)
+
+const (
+	// PreemptNever never preempts other workloads.
+	PreemptNever = "Never"
+
+	// PreemptLowerPriority preempts a workload of a lower priority to make room.
+	PreemptLowerPriority = "PreemptLowerPriority"
+)
+
+// WorkloadSchedule defines the recurring time windows in which a workload may run.
+type WorkloadSchedule struct {
+	// Windows lists the weekly windows, in UTC, during which the workload may be scheduled,
+	// as "[days] HH:MM-HH:MM" entries separated by ";", e.g. "Mon-Fri 22:00-06:00; Sat,Sun 00:00-23:59".
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Windows string `json:"windows"`
+
+	// SuspendOutsideWindow stops a workload still running when its window closes. Its Job is
+	// deleted and the workload returns to Pending until the next window opens.
+	// +kubebuilder:validation:Optional
+	SuspendOutsideWindow bool `json:"suspendOutsideWindow,omitempty"`
 }
 
 // GPUWorkloadPhase is the phase of a GPUWorkload.
@@ -89,6 +362,23 @@ const (
 
 	// PhaseSucceeded indicates the workload completed successfully.
 	PhaseSucceeded GPUWorkloadPhase = "Succeeded"
+
+	// PhaseSimulated indicates the placement of a dry-run workload was computed without creating its Jobs.
+	PhaseSimulated GPUWorkloadPhase = "Simulated"
+)
+
+// Condition types set on a GPUWorkload's status.
+const (
+	// ConditionScheduled is true while the workload is placed on a node: once it is
+	// Scheduled, while it runs and after it succeeded.
+	ConditionScheduled = "Scheduled"
+
+	// ConditionReady is true while the workload's Job is running.
+	ConditionReady = "Ready"
+
+	// ConditionStuckPending is true while the workload has been pending without a placement
+	// for longer than the controller's --stuck-pending-threshold.
+	ConditionStuckPending = "StuckPending"
 )
 
 // GPUWorkloadStatus defines the observed state of a GPU workload.
@@ -97,10 +387,42 @@ type GPUWorkloadStatus struct {
 	// +kubebuilder:validation:Optional
 	Phase GPUWorkloadPhase `json:"phase,omitempty"`
 
+	// ObservedGeneration is the metadata.generation of the spec the status was last written for.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions are the standard conditions of the workload, Scheduled and Ready, derived
+	// from its phase so tools like kubectl wait can watch them, and StuckPending when enabled.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
 	// AssignedNode is the name of the node where the workload is scheduled.
 	// +kubebuilder:validation:Optional
 	AssignedNode string `json:"assignedNode,omitempty"`
 
+	// AssignedNodeGPUInfo describes the GPUs of the assigned node at scheduling time.
+	// +kubebuilder:validation:Optional
+	AssignedNodeGPUInfo *NodeGPUInfo `json:"assignedNodeGPUInfo,omitempty"`
+
+	// NodeLabels holds the labels of the assigned node at scheduling time that the controller
+	// is configured to propagate, such as its zone or instance type.
+	// +kubebuilder:validation:Optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// Cluster is the remote cluster running the workload's Job, empty for the controller's own cluster.
+	// +kubebuilder:validation:Optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// NominatedNode is the node the workload should preferably be placed on next. It is set
+	// when the defragmenter moves the workload or the workload preempts another, and cleared
+	// once the workload is placed.
+	// +kubebuilder:validation:Optional
+	NominatedNode string `json:"nominatedNode,omitempty"`
+
 	// LastScheduleTime is the timestamp of the last scheduling attempt.
 	// +kubebuilder:validation:Optional
 	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
@@ -110,6 +432,48 @@ type GPUWorkloadStatus struct {
 	// +kubebuilder:validation:Minimum=0
 	RetryCount int32 `json:"retryCount,omitempty"`
 
+	// FailureReasons counts the failed scheduling attempts by reason. When retries are
+	// exhausted, the most frequent one becomes the workload's Reason.
+	// +kubebuilder:validation:Optional
+	FailureReasons map[string]int32 `json:"failureReasons,omitempty"`
+
+	// LastBackoff is the delay before the pending scheduling attempt. Decorrelated backoff
+	// derives the next delay from it. Cleared once the workload is scheduled.
+	// +kubebuilder:validation:Optional
+	LastBackoff *metav1.Duration `json:"lastBackoff,omitempty"`
+
+	// FailedTime is when the workload last failed for a reason that may clear up on its own,
+	// such as exhausted scheduling retries or a failed Job, starting the AutoRetryAfterSeconds
+	// cooldown. It is not set for workloads failed for an invalid spec.
+	// +kubebuilder:validation:Optional
+	FailedTime *metav1.Time `json:"failedTime,omitempty"`
+
+	// CompletionTime is when the workload last reached Succeeded or Failed, from which
+	// TTLSecondsAfterFinished counts. It is cleared when the workload is retried.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// AutoRetryCount is how many times the workload was returned to Pending after failing.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	AutoRetryCount int32 `json:"autoRetryCount,omitempty"`
+
+	// Placements counts how many times the workload was placed. The Jobs of each placement
+	// are named after it, so a new placement never adopts the Jobs of an earlier one.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	Placements int32 `json:"placements,omitempty"`
+
+	// ImagePullFailingSince is when the workload's pod was first seen unable to pull its
+	// image, from which ImagePullFailureThreshold counts. It is cleared once the pull no
+	// longer fails and when the workload is placed again.
+	// +kubebuilder:validation:Optional
+	ImagePullFailingSince *metav1.Time `json:"imagePullFailingSince,omitempty"`
+
+	// Reason is a machine-readable reason for the current phase, such as why a workload is still pending.
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty"`
+
 	// Message is a human-readable message about the last scheduling attempt.
 	// +kubebuilder:validation:Optional
 	Message string `json:"message,omitempty"`
@@ -117,6 +481,78 @@ type GPUWorkloadStatus struct {
 	// JobName is the name of the Kubernetes Job created for this workload (if any).
 	// +kubebuilder:validation:Optional
 	JobName string `json:"jobName,omitempty"`
+
+	// ReplicaNodes lists the node each replica of a gang-scheduled workload was placed on,
+	// indexed by replica. JobName and AssignedNode refer to replica 0.
+	// +kubebuilder:validation:Optional
+	ReplicaNodes []string `json:"replicaNodes,omitempty"`
+
+	// InferredGPUCount is the GPU count computed from ModelSizeGB when GPUCount is omitted.
+	// +kubebuilder:validation:Optional
+	InferredGPUCount int32 `json:"inferredGPUCount,omitempty"`
+
+	// AllocatedGPUCount is the number of GPUs actually requested for the workload's Job.
+	// It is lower than the requested count when the allocation was degraded.
+	// +kubebuilder:validation:Optional
+	AllocatedGPUCount int32 `json:"allocatedGPUCount,omitempty"`
+
+	// AllocatedMemory is the memory requested for the workload's Job, after rounding.
+	// +kubebuilder:validation:Optional
+	AllocatedMemory string `json:"allocatedMemory,omitempty"`
+
+	// GPUVendor is the GPU vendor the workload was scheduled on, resolved when the spec asks for "auto".
+	// +kubebuilder:validation:Optional
+	GPUVendor string `json:"gpuVendor,omitempty"`
+
+	// Strategy is the scheduling strategy that placed the workload.
+	// +kubebuilder:validation:Optional
+	Strategy string `json:"strategy,omitempty"`
+
+	// CanaryStrategy is true when the workload was placed by the canary strategy rather than the stable one.
+	// +kubebuilder:validation:Optional
+	CanaryStrategy bool `json:"canaryStrategy,omitempty"`
+
+	// CPUFallback is true when the workload runs its CPU fallback image without GPUs.
+	// +kubebuilder:validation:Optional
+	CPUFallback bool `json:"cpuFallback,omitempty"`
+
+	// ScheduledOnAttempt is the RetryCount at the time the workload was successfully placed.
+	// Zero means the workload was scheduled on its first attempt.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	ScheduledOnAttempt int32 `json:"scheduledOnAttempt,omitempty"`
+
+	// EstimatedCostPerHour is the estimated hourly cost of the workload on its assigned node,
+	// derived from the node's cost-per-hour label and the fraction of its GPUs in use.
+	// +kubebuilder:validation:Optional
+	EstimatedCostPerHour string `json:"estimatedCostPerHour,omitempty"`
+}
+
+// NodeGPUInfo describes the GPUs of a node, as advertised by its labels.
+type NodeGPUInfo struct {
+	// Product is the GPU model, e.g. "NVIDIA-A100-SXM4-80GB".
+	// +kubebuilder:validation:Optional
+	Product string `json:"product,omitempty"`
+
+	// Count is the number of GPUs on the node.
+	// +kubebuilder:validation:Optional
+	Count int32 `json:"count,omitempty"`
+
+	// MemoryMB is the memory of each GPU in megabytes.
+	// +kubebuilder:validation:Optional
+	MemoryMB int64 `json:"memoryMB,omitempty"`
+
+	// MIGStrategy is the node's MIG strategy ("none", "single", or "mixed").
+	// +kubebuilder:validation:Optional
+	MIGStrategy string `json:"migStrategy,omitempty"`
+
+	// MIGSlices lists the MIG slices available per profile.
+	// +kubebuilder:validation:Optional
+	MIGSlices map[string]int64 `json:"migSlices,omitempty"`
+
+	// NVLinkTopology describes how the node's GPUs are interconnected, e.g. "NV12" or "none".
+	// +kubebuilder:validation:Optional
+	NVLinkTopology string `json:"nvlinkTopology,omitempty"`
 }
 
 // GPUWorkload is the Schema for the gpuworkloads API.
@@ -128,6 +564,7 @@ type GPUWorkloadStatus struct {
 // +kubebuilder:printcolumn:name="GPUs",type=integer,JSONPath=`.spec.gpuCount`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Node",type=string,JSONPath=`.status.assignedNode`
+// +kubebuilder:printcolumn:name="Cost/h",type=string,JSONPath=`.status.estimatedCostPerHour`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type GPUWorkload struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -146,6 +583,15 @@ type GPUWorkloadList struct {
 	Items []GPUWorkload `json:"items"`
 }
 
+// RequestedGPUCount returns the number of GPUs the workload needs, preferring the
+// explicit spec value and falling back to the count inferred from the model size.
+func (in *GPUWorkload) RequestedGPUCount() int32 {
+	if in.Spec.GPUCount > 0 {
+		return in.Spec.GPUCount
+	}
+	return in.Status.InferredGPUCount
+}
+
 func init() {
 	SchemeBuilder.Register(&GPUWorkload{}, &GPUWorkloadList{})
 }
@@ -0,0 +1,230 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/reyisjones/GPU_Orchestrator/internal/timewindow"
+)
+
+// log is for logging in this package.
+var gpuworkloadlog = logf.Log.WithName("gpuworkload-resource")
+
+// RequireImageDigest makes the validating webhook reject workload images that are
+// referenced by tag instead of being pinned to a sha256 digest.
+var RequireImageDigest bool
+
+// imageDigestPattern matches image references pinned by digest, e.g. "repo/image@sha256:<64 hex>".
+var imageDigestPattern = regexp.MustCompile(`@sha256:[a-f0-9]{64}$`)
+
+// SetupWebhookWithManager registers the GPUWorkload webhooks with the manager.
+func (r *GPUWorkload) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-gpu-warp-dev-v1alpha1-gpuworkload,mutating=true,failurePolicy=fail,sideEffects=None,groups=gpu.warp.dev,resources=gpuworkloads,verbs=create;update,versions=v1alpha1,name=mgpuworkload.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &GPUWorkload{}
+
+const (
+	// DefaultPriority is the priority of workloads that do not set one.
+	DefaultPriority = "normal"
+
+	// DefaultSchedulingStrategy is the strategy of workloads that do not name one, unless
+	// they request a MIG profile.
+	DefaultSchedulingStrategy = "leastLoaded"
+
+	// DefaultMaxRetries is the number of scheduling attempts of workloads without a retry policy.
+	DefaultMaxRetries = 3
+
+	// DefaultBackoffSeconds is the base retry delay of workloads without a retry policy.
+	DefaultBackoffSeconds = 30
+)
+
+// Default implements webhook.Defaulter, filling in the optional fields the controller relies
+// on. The controller applies it as well, to workloads admitted without the webhook.
+func (r *GPUWorkload) Default() {
+	gpuworkloadlog.V(1).Info("default", "name", r.Name)
+
+	if r.Spec.Priority == "" {
+		r.Spec.Priority = DefaultPriority
+	}
+	if r.Spec.PreemptionPolicy == "" {
+		r.Spec.PreemptionPolicy = PreemptNever
+	}
+	if r.Spec.SchedulingStrategy == "" {
+		r.Spec.SchedulingStrategy = DefaultSchedulingStrategy
+		if r.Spec.MIGProfile != "" {
+			r.Spec.SchedulingStrategy = "migPartition"
+		}
+	}
+	if r.Spec.RetryPolicy == nil {
+		r.Spec.RetryPolicy = &RetryPolicy{}
+	}
+	if r.Spec.RetryPolicy.MaxRetries == 0 {
+		r.Spec.RetryPolicy.MaxRetries = DefaultMaxRetries
+	}
+	if r.Spec.RetryPolicy.BackoffSeconds == 0 {
+		r.Spec.RetryPolicy.BackoffSeconds = DefaultBackoffSeconds
+	}
+	if r.Spec.RetryPolicy.BackoffMode == "" {
+		r.Spec.RetryPolicy.BackoffMode = BackoffExponential
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-gpu-warp-dev-v1alpha1-gpuworkload,mutating=false,failurePolicy=fail,sideEffects=None,groups=gpu.warp.dev,resources=gpuworkloads,verbs=create;update,versions=v1alpha1,name=vgpuworkload.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &GPUWorkload{}
+
+// ValidateCreate implements webhook.Validator.
+func (r *GPUWorkload) ValidateCreate() (admission.Warnings, error) {
+	gpuworkloadlog.V(1).Info("validate create", "name", r.Name)
+	return nil, r.validateGPUWorkload()
+}
+
+// ValidateUpdate implements webhook.Validator.
+func (r *GPUWorkload) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	gpuworkloadlog.V(1).Info("validate update", "name", r.Name)
+	return nil, r.validateGPUWorkload()
+}
+
+// ValidateDelete implements webhook.Validator.
+func (r *GPUWorkload) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateGPUWorkload checks the spec against rules that cannot be expressed as CRD markers.
+func (r *GPUWorkload) validateGPUWorkload() error {
+	if r.Spec.ModelName == "" {
+		return fmt.Errorf("spec.modelName must not be empty")
+	}
+	if count := r.Spec.GPUCount; count < 0 || count > MaxGPUCount {
+		return fmt.Errorf("spec.gpuCount %d is out of range, must be between 1 and %d", count, MaxGPUCount)
+	}
+	if r.Spec.Replicas < 0 {
+		return fmt.Errorf("spec.replicas %d must not be negative", r.Spec.Replicas)
+	}
+	if strategy := r.Spec.SchedulingStrategy; strategy != "" && !slices.Contains(SchedulingStrategies, strategy) {
+		return fmt.Errorf("spec.schedulingStrategy %q is not supported, must be one of: %s", strategy, strings.Join(SchedulingStrategies, ", "))
+	}
+	if RequireImageDigest && r.Spec.Image != "" && !imageDigestPattern.MatchString(r.Spec.Image) {
+		return fmt.Errorf("spec.image %q must be pinned by digest (image@sha256:...)", r.Spec.Image)
+	}
+	if policy := r.Spec.RestartPolicy; policy != "" && policy != corev1.RestartPolicyNever && policy != corev1.RestartPolicyOnFailure {
+		return fmt.Errorf("spec.restartPolicy %q is not supported, must be Never or OnFailure", policy)
+	}
+	if r.Spec.JobBackoffLimit != nil && *r.Spec.JobBackoffLimit < 0 {
+		return fmt.Errorf("spec.jobBackoffLimit %d must not be negative", *r.Spec.JobBackoffLimit)
+	}
+	if err := r.Spec.ValidateQuantities(); err != nil {
+		return err
+	}
+	for i, mount := range r.Spec.VolumeMounts {
+		if !slices.ContainsFunc(r.Spec.Volumes, func(v corev1.Volume) bool { return v.Name == mount.Name }) {
+			return fmt.Errorf("spec.volumeMounts[%d] refers to volume %q, which is not in spec.volumes", i, mount.Name)
+		}
+	}
+	if r.Spec.AllowCPUFallback && r.Spec.CPUFallbackImage == "" {
+		return fmt.Errorf("spec.cpuFallbackImage is required when spec.allowCPUFallback is set")
+	}
+	if RequireImageDigest && r.Spec.CPUFallbackImage != "" && !imageDigestPattern.MatchString(r.Spec.CPUFallbackImage) {
+		return fmt.Errorf("spec.cpuFallbackImage %q must be pinned by digest (image@sha256:...)", r.Spec.CPUFallbackImage)
+	}
+	if r.Spec.Schedule != nil {
+		if _, err := timewindow.Parse(r.Spec.Schedule.Windows); err != nil {
+			return fmt.Errorf("spec.schedule.windows: %w", err)
+		}
+	}
+	if r.Spec.MIGProfile != "" {
+		return validateMIGProfile(&r.Spec)
+	}
+	return nil
+}
+
+// ValidateQuantities rejects CPU and memory quantities that do not parse or are negative,
+// and requests above their limits. The controller applies it too, for workloads admitted
+// without the webhook.
+func (spec *GPUWorkloadSpec) ValidateQuantities() error {
+	for _, pair := range []struct {
+		name           string
+		request, limit string
+	}{
+		{"cpu", spec.CPURequest, spec.CPULimit},
+		{"memory", spec.MemoryRequest, spec.MemoryLimit},
+	} {
+		request, err := parseQuantity("spec."+pair.name+"Request", pair.request)
+		if err != nil {
+			return err
+		}
+		limit, err := parseQuantity("spec."+pair.name+"Limit", pair.limit)
+		if err != nil {
+			return err
+		}
+		if request != nil && limit != nil && request.Cmp(*limit) > 0 {
+			return fmt.Errorf("spec.%sRequest %s must not exceed spec.%sLimit %s", pair.name, pair.request, pair.name, pair.limit)
+		}
+	}
+	return nil
+}
+
+// parseQuantity parses the value of the named field, returning nil when it is empty.
+func parseQuantity(field, value string) (*resource.Quantity, error) {
+	if value == "" {
+		return nil, nil
+	}
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q is not a valid quantity: %w", field, value, err)
+	}
+	if quantity.Sign() < 0 {
+		return nil, fmt.Errorf("%s %q must not be negative", field, value)
+	}
+	return &quantity, nil
+}
+
+// validateMIGProfile rejects MIG requests no node can satisfy: profiles of a size GPUs are
+// not partitioned into, vendors other than NVIDIA, and slice counts left to be inferred.
+func validateMIGProfile(spec *GPUWorkloadSpec) error {
+	profile := spec.MIGProfile
+	compute, _, _ := strings.Cut(profile, "g.")
+	computeSlices, err := strconv.Atoi(compute)
+	if err != nil || !slices.Contains([]int{1, 2, 3, 4, 7}, computeSlices) {
+		return fmt.Errorf("spec.migProfile %q is not a MIG profile, its compute slices must be one of 1, 2, 3, 4 or 7", profile)
+	}
+	if vendor := spec.GPUVendor; vendor != "" && vendor != "nvidia" {
+		return fmt.Errorf("spec.migProfile requires NVIDIA GPUs, got spec.gpuVendor %q", vendor)
+	}
+	if spec.GPUCount == 0 {
+		return fmt.Errorf("spec.gpuCount must be set with spec.migProfile, it cannot be inferred from spec.modelSizeGB")
+	}
+	return nil
+}
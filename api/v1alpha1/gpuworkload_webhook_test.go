@@ -0,0 +1,207 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testDigest = "sha256:3b1f6c0f1c7f7c4e5b2c8d9a0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b"
+
+func TestValidateCreate_ImageDigest(t *testing.T) {
+	RequireImageDigest = true
+	defer func() { RequireImageDigest = false }()
+
+	tests := []struct {
+		name    string
+		image   string
+		wantErr bool
+	}{
+		{"tag is rejected", "ghcr.io/acme/llama:v1", true},
+		{"implicit latest is rejected", "ghcr.io/acme/llama", true},
+		{"digest is accepted", "ghcr.io/acme/llama@" + testDigest, false},
+		{"tag and digest is accepted", "ghcr.io/acme/llama:v1@" + testDigest, false},
+		{"empty image uses controller default", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama", GPUCount: 1, Image: tt.image}}
+			_, err := gw.ValidateCreate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreate_TagsAllowedWithoutDigestRequirement(t *testing.T) {
+	gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama", GPUCount: 1, Image: "ghcr.io/acme/llama:v1"}}
+	if _, err := gw.ValidateCreate(); err != nil {
+		t.Errorf("ValidateCreate() error = %v, expected tags to be allowed", err)
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama2", GPUCount: 1}}
+
+	gw.Spec.Schedule = &WorkloadSchedule{Windows: "Mon-Fri 22:00-06:00"}
+	if _, err := gw.ValidateCreate(); err != nil {
+		t.Errorf("Expected valid schedule to be accepted, got %v", err)
+	}
+
+	gw.Spec.Schedule = &WorkloadSchedule{Windows: "nightly"}
+	if _, err := gw.ValidateCreate(); err == nil {
+		t.Error("Expected invalid schedule to be rejected")
+	}
+}
+
+func TestValidateCPUFallback(t *testing.T) {
+	gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama2", GPUCount: 1, AllowCPUFallback: true}}
+	if _, err := gw.ValidateCreate(); err == nil {
+		t.Error("Expected CPU fallback without an image to be rejected")
+	}
+
+	gw.Spec.CPUFallbackImage = "python:3.11-slim"
+	if _, err := gw.ValidateCreate(); err != nil {
+		t.Errorf("Expected CPU fallback with an image to be accepted, got %v", err)
+	}
+}
+
+func TestValidateCreate_RejectsInvalidSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(spec *GPUWorkloadSpec)
+		wantErr string
+	}{
+		{"empty model name", func(spec *GPUWorkloadSpec) { spec.ModelName = "" }, "spec.modelName must not be empty"},
+		{"negative replicas", func(spec *GPUWorkloadSpec) { spec.Replicas = -1 }, "spec.replicas -1 must not be negative"},
+		{"unknown strategy", func(spec *GPUWorkloadSpec) { spec.SchedulingStrategy = "fastest" }, `spec.schedulingStrategy "fastest" is not supported`},
+		{"too many GPUs", func(spec *GPUWorkloadSpec) { spec.GPUCount = MaxGPUCount + 1 }, "spec.gpuCount 9 is out of range"},
+		{"negative GPUs", func(spec *GPUWorkloadSpec) { spec.GPUCount = -2 }, "spec.gpuCount -2 is out of range"},
+		{"mount of unknown volume", func(spec *GPUWorkloadSpec) {
+			spec.VolumeMounts = []corev1.VolumeMount{{Name: "weights", MountPath: "/models"}}
+		}, `refers to volume "weights"`},
+		{"unsupported restart policy", func(spec *GPUWorkloadSpec) { spec.RestartPolicy = "Always" }, `spec.restartPolicy "Always" is not supported`},
+		{"invalid CPU request", func(spec *GPUWorkloadSpec) { spec.CPURequest = "four" }, `spec.cpuRequest "four" is not a valid quantity`},
+		{"negative memory limit", func(spec *GPUWorkloadSpec) { spec.MemoryLimit = "-1Gi" }, `spec.memoryLimit "-1Gi" must not be negative`},
+		{"request above limit", func(spec *GPUWorkloadSpec) {
+			spec.MemoryRequest, spec.MemoryLimit = "64Gi", "32Gi"
+		}, "spec.memoryRequest 64Gi must not exceed spec.memoryLimit 32Gi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama2", GPUCount: 1}}
+			tt.mutate(&gw.Spec)
+			_, err := gw.ValidateCreate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+			}
+			if _, err := gw.ValidateUpdate(&GPUWorkload{}); err == nil {
+				t.Error("ValidateUpdate() accepted the invalid spec")
+			}
+		})
+	}
+}
+
+func TestValidateCreate_AcceptsKnownStrategies(t *testing.T) {
+	for _, strategy := range append([]string{""}, SchedulingStrategies...) {
+		gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama2", GPUCount: 1, Replicas: 2, SchedulingStrategy: strategy}}
+		if _, err := gw.ValidateCreate(); err != nil {
+			t.Errorf("ValidateCreate() rejected strategy %q: %v", strategy, err)
+		}
+	}
+}
+
+func TestDefault_FillsUnsetFields(t *testing.T) {
+	gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama2", GPUCount: 1}}
+	gw.Default()
+
+	if gw.Spec.Priority != "normal" || gw.Spec.SchedulingStrategy != "leastLoaded" || gw.Spec.PreemptionPolicy != PreemptNever {
+		t.Errorf("Unexpected defaults: priority %q, strategy %q, preemption %q", gw.Spec.Priority, gw.Spec.SchedulingStrategy, gw.Spec.PreemptionPolicy)
+	}
+	want := RetryPolicy{MaxRetries: 3, BackoffSeconds: 30, BackoffMode: BackoffExponential}
+	if gw.Spec.RetryPolicy == nil || *gw.Spec.RetryPolicy != want {
+		t.Errorf("Expected retry policy %+v, got %+v", want, gw.Spec.RetryPolicy)
+	}
+}
+
+func TestDefault_KeepsSetFields(t *testing.T) {
+	gw := &GPUWorkload{Spec: GPUWorkloadSpec{
+		ModelName:          "llama2",
+		Priority:           "high",
+		SchedulingStrategy: "binPack",
+		RetryPolicy:        &RetryPolicy{MaxRetries: 5},
+	}}
+	gw.Default()
+
+	if gw.Spec.Priority != "high" || gw.Spec.SchedulingStrategy != "binPack" {
+		t.Errorf("Expected set fields to be kept, got priority %q, strategy %q", gw.Spec.Priority, gw.Spec.SchedulingStrategy)
+	}
+	want := RetryPolicy{MaxRetries: 5, BackoffSeconds: 30, BackoffMode: BackoffExponential}
+	if *gw.Spec.RetryPolicy != want {
+		t.Errorf("Expected retry policy %+v, got %+v", want, *gw.Spec.RetryPolicy)
+	}
+}
+
+func TestDefault_MIGWorkloadsUseMIGPartition(t *testing.T) {
+	gw := &GPUWorkload{Spec: GPUWorkloadSpec{ModelName: "llama2", GPUCount: 1, MIGProfile: "1g.10gb"}}
+	gw.Default()
+	if gw.Spec.SchedulingStrategy != "migPartition" {
+		t.Errorf("Expected migPartition, got %q", gw.Spec.SchedulingStrategy)
+	}
+}
+
+func TestValidateMIGProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    GPUWorkloadSpec
+		wantErr string
+	}{
+		{"single slice", GPUWorkloadSpec{GPUCount: 1, MIGProfile: "1g.10gb"}, ""},
+		{"slices of one GPU", GPUWorkloadSpec{GPUCount: 7, MIGProfile: "1g.10gb"}, ""},
+		{"two halves", GPUWorkloadSpec{GPUCount: 2, MIGProfile: "3g.40gb"}, ""},
+		{"full GPU profile", GPUWorkloadSpec{GPUCount: 1, MIGProfile: "7g.80gb", GPUVendor: "nvidia"}, ""},
+		{"slices of two GPUs", GPUWorkloadSpec{GPUCount: 8, MIGProfile: "1g.5gb"}, ""},
+		{"several full GPU profiles", GPUWorkloadSpec{GPUCount: 4, MIGProfile: "7g.80gb"}, ""},
+		{"unknown profile size", GPUWorkloadSpec{GPUCount: 1, MIGProfile: "5g.50gb"}, "is not a MIG profile"},
+		{"malformed profile", GPUWorkloadSpec{GPUCount: 1, MIGProfile: "mig-large"}, "is not a MIG profile"},
+		{"other vendor", GPUWorkloadSpec{GPUCount: 1, MIGProfile: "1g.10gb", GPUVendor: "amd"}, "requires NVIDIA GPUs"},
+		{"inferred count", GPUWorkloadSpec{ModelSizeGB: 20, MIGProfile: "2g.20gb"}, "spec.gpuCount must be set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.ModelName = "llama2"
+			gw := &GPUWorkload{Spec: tt.spec}
+			_, err := gw.ValidateCreate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() error = %v, expected it to be accepted", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCreate() error = %v, expected one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import corev1 "k8s.io/api/core/v1"
+
+// InheritedSpec returns the effective spec of a workload created from a parent, such as a
+// workload set's template: the child's spec, with the priority and scheduling configuration
+// it leaves unset taken from the parent. Fields the child sets always win; node selector
+// labels are merged, the child's value winning for a key both set. Neither spec is
+// modified. Inheritance must be resolved before Default, which would otherwise fill in the
+// fields the parent should provide.
+func InheritedSpec(parent, child *GPUWorkloadSpec) GPUWorkloadSpec {
+	spec := *child.DeepCopy()
+	if parent == nil {
+		return spec
+	}
+
+	inheritString(&spec.Priority, parent.Priority)
+	inheritString(&spec.PreemptionPolicy, parent.PreemptionPolicy)
+	inheritString(&spec.SchedulingStrategy, parent.SchedulingStrategy)
+	inheritString(&spec.TopologyKey, parent.TopologyKey)
+	inheritString(&spec.DataLocalityLabel, parent.DataLocalityLabel)
+	inheritString(&spec.GPUVendor, parent.GPUVendor)
+	inheritString(&spec.Cluster, parent.Cluster)
+
+	if len(parent.NodeSelector) > 0 {
+		selector := make(map[string]string, len(parent.NodeSelector)+len(spec.NodeSelector))
+		for key, value := range parent.NodeSelector {
+			selector[key] = value
+		}
+		for key, value := range spec.NodeSelector {
+			selector[key] = value
+		}
+		spec.NodeSelector = selector
+	}
+	if spec.Tolerations == nil && parent.Tolerations != nil {
+		spec.Tolerations = make([]corev1.Toleration, len(parent.Tolerations))
+		for i := range parent.Tolerations {
+			parent.Tolerations[i].DeepCopyInto(&spec.Tolerations[i])
+		}
+	}
+	if spec.RetryPolicy == nil && parent.RetryPolicy != nil {
+		spec.RetryPolicy = parent.RetryPolicy.DeepCopy()
+	}
+	return spec
+}
+
+// inheritString sets field to the parent's value when it is unset.
+func inheritString(field *string, parent string) {
+	if *field == "" {
+		*field = parent
+	}
+}
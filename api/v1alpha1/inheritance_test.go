@@ -0,0 +1,85 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestInheritedSpec_ChildInheritsParentSchedulingConfig(t *testing.T) {
+	parent := &GPUWorkloadSpec{
+		Priority:           "high",
+		SchedulingStrategy: "binPack",
+		NodeSelector:       map[string]string{"pool": "inference"},
+		Tolerations:        []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+		RetryPolicy:        &RetryPolicy{MaxRetries: 5, BackoffSeconds: 10},
+	}
+	child := &GPUWorkloadSpec{ModelName: "llama", GPUCount: 2}
+
+	spec := InheritedSpec(parent, child)
+	if spec.Priority != "high" || spec.SchedulingStrategy != "binPack" {
+		t.Errorf("Expected the parent's priority and strategy, got %q/%q", spec.Priority, spec.SchedulingStrategy)
+	}
+	if spec.ModelName != "llama" || spec.GPUCount != 2 {
+		t.Errorf("Expected the child's own fields to be kept, got %q/%d", spec.ModelName, spec.GPUCount)
+	}
+	if !reflect.DeepEqual(spec.NodeSelector, parent.NodeSelector) || !reflect.DeepEqual(spec.Tolerations, parent.Tolerations) {
+		t.Errorf("Expected the parent's node selector and tolerations, got %v/%v", spec.NodeSelector, spec.Tolerations)
+	}
+	if spec.RetryPolicy == parent.RetryPolicy || !reflect.DeepEqual(spec.RetryPolicy, parent.RetryPolicy) {
+		t.Errorf("Expected a copy of the parent's retry policy, got %+v", spec.RetryPolicy)
+	}
+
+	// Defaulting the effective spec keeps what was inherited
+	gw := &GPUWorkload{Spec: spec}
+	gw.Default()
+	if gw.Spec.Priority != "high" || gw.Spec.SchedulingStrategy != "binPack" || gw.Spec.RetryPolicy.MaxRetries != 5 {
+		t.Errorf("Expected defaulting to keep the inherited config, got %+v", gw.Spec)
+	}
+}
+
+func TestInheritedSpec_ChildOverridesWin(t *testing.T) {
+	parent := &GPUWorkloadSpec{
+		Priority:           "high",
+		SchedulingStrategy: "binPack",
+		NodeSelector:       map[string]string{"pool": "inference", "zone": "a"},
+	}
+	child := &GPUWorkloadSpec{
+		ModelName:          "llama",
+		Priority:           "low",
+		SchedulingStrategy: "topologyAware",
+		NodeSelector:       map[string]string{"zone": "b"},
+		Tolerations:        []corev1.Toleration{},
+	}
+
+	spec := InheritedSpec(parent, child)
+	if spec.Priority != "low" || spec.SchedulingStrategy != "topologyAware" {
+		t.Errorf("Expected the child's priority and strategy, got %q/%q", spec.Priority, spec.SchedulingStrategy)
+	}
+	if want := map[string]string{"pool": "inference", "zone": "b"}; !reflect.DeepEqual(spec.NodeSelector, want) {
+		t.Errorf("Expected node selector %v, got %v", want, spec.NodeSelector)
+	}
+	if len(parent.NodeSelector) != 2 || parent.NodeSelector["zone"] != "a" || child.NodeSelector["pool"] != "" {
+		t.Errorf("Expected neither spec to be modified, got parent %v and child %v", parent.NodeSelector, child.NodeSelector)
+	}
+	if InheritedSpec(nil, child).Priority != "low" {
+		t.Error("Expected a spec without parent to be the child's")
+	}
+}
@@ -19,6 +19,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -80,6 +82,96 @@ func (in *GPUWorkloadList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GPUWorkloadSpec) DeepCopyInto(out *GPUWorkloadSpec) {
 	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SuccessExitCodes != nil {
+		in, out := &in.SuccessExitCodes, &out.SuccessExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.CPUFallbackAfterSeconds != nil {
+		in, out := &in.CPUFallbackAfterSeconds, &out.CPUFallbackAfterSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SchedulingDeadlineSeconds != nil {
+		in, out := &in.SchedulingDeadlineSeconds, &out.SchedulingDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AutoRetryAfterSeconds != nil {
+		in, out := &in.AutoRetryAfterSeconds, &out.AutoRetryAfterSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.WarmupSeconds != nil {
+		in, out := &in.WarmupSeconds, &out.WarmupSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.JobBackoffLimit != nil {
+		in, out := &in.JobBackoffLimit, &out.JobBackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(WorkloadSchedule)
+		**out = **in
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RetryPolicy != nil {
 		in, out := &in.RetryPolicy, &out.RetryPolicy
 		*out = new(RetryPolicy)
@@ -100,10 +192,58 @@ func (in *GPUWorkloadSpec) DeepCopy() *GPUWorkloadSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GPUWorkloadStatus) DeepCopyInto(out *GPUWorkloadStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastScheduleTime != nil {
 		in, out := &in.LastScheduleTime, &out.LastScheduleTime
 		*out = (*in).DeepCopy()
 	}
+	if in.AssignedNodeGPUInfo != nil {
+		in, out := &in.AssignedNodeGPUInfo, &out.AssignedNodeGPUInfo
+		*out = new(NodeGPUInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureReasons != nil {
+		in, out := &in.FailureReasons, &out.FailureReasons
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastBackoff != nil {
+		in, out := &in.LastBackoff, &out.LastBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailedTime != nil {
+		in, out := &in.FailedTime, &out.FailedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ImagePullFailingSince != nil {
+		in, out := &in.ImagePullFailingSince, &out.ImagePullFailingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ReplicaNodes != nil {
+		in, out := &in.ReplicaNodes, &out.ReplicaNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUWorkloadStatus.
@@ -116,6 +256,28 @@ func (in *GPUWorkloadStatus) DeepCopy() *GPUWorkloadStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGPUInfo) DeepCopyInto(out *NodeGPUInfo) {
+	*out = *in
+	if in.MIGSlices != nil {
+		in, out := &in.MIGSlices, &out.MIGSlices
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGPUInfo.
+func (in *NodeGPUInfo) DeepCopy() *NodeGPUInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGPUInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
@@ -130,3 +292,18 @@ func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSchedule) DeepCopyInto(out *WorkloadSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSchedule.
+func (in *WorkloadSchedule) DeepCopy() *WorkloadSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -17,22 +17,40 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
 	"github.com/reyisjones/GPU_Orchestrator/controllers"
+	"github.com/reyisjones/GPU_Orchestrator/internal/approval"
+	"github.com/reyisjones/GPU_Orchestrator/internal/logging"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/notification"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+	"github.com/reyisjones/GPU_Orchestrator/internal/sizing"
+	"github.com/reyisjones/GPU_Orchestrator/internal/timewindow"
 )
 
 var (
@@ -49,6 +67,65 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var gpuMemoryGB int
+	var modelGPUCounts string
+	var allowControlPlaneNodes bool
+	var ignoredPressureConditions string
+	var metricsWorkloadLabels string
+	var pendingResyncPeriod time.Duration
+	var pendingResyncBatchSize int
+	var debugAddr string
+	var evaluationAddr string
+	var debugTokenFile string
+	var evaluationTokenFile string
+	var maintenanceWindow string
+	var enableWebhooks bool
+	var requireImageDigest bool
+	var approvalEndpoint string
+	var notificationURL string
+	var notificationLogLines int64
+	var gpuVendorPreference string
+	var logDedupWindow time.Duration
+	var tieBreakPolicy string
+	var namespaceSelector string
+	var strategyBenchmarkPeriod time.Duration
+	var serializePlacements bool
+	var priorityOrdering bool
+	var placementDebounce time.Duration
+	var gpuOvercommitRatio float64
+	var defragMode string
+	var defragPeriod time.Duration
+	var migrationPeriod time.Duration
+	var migrationMinImprovement int64
+	var canaryStrategy string
+	var canaryPercent int
+	var minObservedNodes int
+	var triggerAutoscaler bool
+	var maxActiveJobs int
+	var stuckPendingThreshold time.Duration
+	var allocationDriftPeriod time.Duration
+	var nodeMetricsPeriod time.Duration
+	var allocationDriftThreshold int64
+	var gpuTolerationKey string
+	var disableGPUToleration bool
+	var maxConcurrentPerModel int
+	var modelConcurrencyLimits string
+	var requestRatePrometheusURL string
+	var requestRateQuery string
+	var reliabilityStateFile string
+	var healthScoreWeights string
+	var printPrometheusRules bool
+	var costOptimizedNodeLabel string
+	var imagePullFailureThreshold time.Duration
+	var phaseTransitionDelay time.Duration
+	var remoteClusters string
+	var propagatedNodeLabels string
+	var simulate bool
+	var requestRounding string
+	var gpuRequestGranularity int
+	var memoryRequestGranularity string
+	var cpuPerGPU string
+	var memoryPerGPU string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -56,8 +133,160 @@ func main() {
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
 
+	flag.IntVar(&gpuMemoryGB, "gpu-memory-gb", sizing.DefaultGPUMemoryGB,
+		"Per-GPU memory in GB assumed when inferring GPU counts from a workload's modelSizeGB.")
+	flag.StringVar(&modelGPUCounts, "model-gpu-counts", "",
+		"Comma-separated model=gpuCount pairs used to infer GPU counts for known models (e.g. llama2-70b=4).")
+	flag.BoolVar(&allowControlPlaneNodes, "allow-control-plane-nodes", false,
+		"Allow GPU workloads to be scheduled onto control-plane nodes.")
+	flag.StringVar(&ignoredPressureConditions, "ignore-node-pressure", "",
+		"Comma-separated node pressure conditions (MemoryPressure, DiskPressure, PIDPressure) that do not keep "+
+			"workloads off a node reporting them. By default nodes under any of them are skipped.")
+	flag.StringVar(&metricsWorkloadLabels, "metrics-workload-labels", "team,project",
+		"Comma-separated GPUWorkload labels propagated onto per-workload metrics. Keep this list small to bound cardinality.")
+	flag.DurationVar(&pendingResyncPeriod, "pending-resync-period", 0,
+		"Period over which pending workloads are re-enqueued in jittered batches. 0 disables the resync.")
+	flag.IntVar(&pendingResyncBatchSize, "pending-resync-batch-size", 10,
+		"Maximum number of pending workloads re-enqueued together during a resync.")
+	flag.StringVar(&debugAddr, "debug-bind-address", "",
+		"The address the debug endpoints bind to (e.g. :8082). Debug endpoints are disabled when empty.")
+	flag.StringVar(&debugTokenFile, "debug-token-file", "",
+		"File holding the bearer token clients of the debug endpoints must present. Required with --debug-bind-address.")
+	flag.StringVar(&evaluationAddr, "evaluation-bind-address", "",
+		"The address the workload evaluation endpoint binds to (e.g. :8083). The endpoint is disabled when empty.")
+	flag.StringVar(&evaluationTokenFile, "evaluation-token-file", "",
+		"File holding the bearer token clients of the evaluation endpoint must present. Required with --evaluation-bind-address.")
+	flag.StringVar(&maintenanceWindow, "maintenance-window", "",
+		"Weekly UTC windows during which new placements are paused, e.g. \"Sat,Sun 02:00-06:00\". "+
+			"Multiple windows are separated by \";\".")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Serve the GPUWorkload admission webhooks. Requires webhook serving certificates.")
+	flag.BoolVar(&requireImageDigest, "require-image-digest", false,
+		"Force imagePullPolicy Always and, with webhooks enabled, reject workload images that are not pinned by digest.")
+	flag.StringVar(&approvalEndpoint, "approval-endpoint", "",
+		"URL of an external approval service consulted before scheduling. Approval is not required when empty.")
+	flag.StringVar(&notificationURL, "notification-webhook-url", "",
+		"URL notified with a JSON payload when a workload's Job finishes. No notifications are sent when empty.")
+	flag.Int64Var(&notificationLogLines, "notification-log-lines", 0,
+		"Number of trailing lines of the workload's logs included in completion notifications. Zero includes none.")
+	flag.StringVar(&gpuVendorPreference, "gpu-vendor-preference", "nvidia,amd,intel",
+		"Comma-separated order in which GPU vendors are tried for workloads with gpuVendor \"auto\".")
+	flag.DurationVar(&logDedupWindow, "log-dedup-window", 0,
+		"Window over which identical controller log messages, including their key/value pairs, are coalesced. Zero disables coalescing.")
+	flag.StringVar(&tieBreakPolicy, "tie-break-policy", string(scheduling.TieBreakName),
+		"How strategies choose between equally suitable nodes: name, random, or leastRecentlyUsed.")
+	flag.StringVar(&namespaceSelector, "namespace-selector", "",
+		"Label selector namespaces must match for their workloads to be scheduled, e.g. \"gpu.warp.dev/approved=true\". "+
+			"Every namespace is allowed when empty.")
+	flag.DurationVar(&strategyBenchmarkPeriod, "strategy-benchmark-period", 0,
+		"Interval at which every scheduling strategy is benchmarked over the current GPU nodes. Zero disables benchmarking.")
+	flag.BoolVar(&serializePlacements, "serialize-placements", false,
+		"Serialize node selection and job creation across workers, counting placed workloads against node capacity. "+
+			"Prevents oversubscription races at the cost of throughput.")
+	flag.BoolVar(&priorityOrdering, "priority-ordering", false,
+		"Place pending workloads in queue order, by priority then creation time, so a workload waits while one ahead "+
+			"of it fits on a node both may use. Implies --serialize-placements.")
+	flag.DurationVar(&placementDebounce, "placement-debounce", 0,
+		"Minimum interval between two placement attempts of the same workload. Bursts of events are coalesced "+
+			"into one node listing and the workload is requeued for the remainder. Zero disables the debounce.")
+	flag.Float64Var(&gpuOvercommitRatio, "gpu-overcommit-ratio", 1,
+		"Factor applied to every node's allocatable GPUs to allow oversubscription, clamped to [1, 10]. "+
+			"Nodes can override it with the gpu-orchestrator/gpu-overcommit-ratio annotation.")
+	flag.StringVar(&defragMode, "defrag-mode", string(controllers.DefragOff),
+		"Defragmentation of GPU placements: off, manual (drain nodes annotated gpu-orchestrator/defragment=true), "+
+			"or auto (also consolidate the least used node onto nodes already in use).")
+	flag.DurationVar(&defragPeriod, "defrag-period", 10*time.Minute,
+		"Interval between defragmentation passes. Each pass drains at most one node.")
+	flag.DurationVar(&migrationPeriod, "migration-period", 0,
+		"Interval between passes moving Running workloads with spec.allowMigration to better nodes. "+
+			"Each pass moves at most one workload. Zero disables migration.")
+	flag.Int64Var(&migrationMinImprovement, "migration-min-improvement", 2,
+		"How many more GPUs a node must leave free around a workload than its current node for the workload to migrate to it.")
+	flag.StringVar(&canaryStrategy, "canary-strategy", "",
+		"Scheduling strategy to roll out to --canary-percent of workloads in place of their own strategy. Disabled when empty.")
+	flag.IntVar(&canaryPercent, "canary-percent", 0,
+		"Percentage of workloads, 0 to 100, scheduled with --canary-strategy.")
+	flag.StringVar(&costOptimizedNodeLabel, "cost-optimized-node-label", "",
+		"Node label, as key or key=value, marking the nodes preferred by the costOptimized strategy. "+
+			"Defaults to gpu-orchestrator/cheap-node=true.")
+	flag.DurationVar(&imagePullFailureThreshold, "image-pull-failure-threshold", 2*time.Minute,
+		"How long a workload's pod may fail to pull its image (ImagePullBackOff, ErrImagePull) before the workload is failed.")
+	flag.DurationVar(&phaseTransitionDelay, "phase-transition-delay", 0,
+		"How long a Job must keep reporting that it finished before the workload moves to Succeeded or Failed. Zero follows the Job immediately.")
+	flag.StringVar(&remoteClusters, "remote-clusters", "",
+		"Comma-separated name=kubeconfig pairs of remote clusters workloads can be placed in through spec.cluster, "+
+			"e.g. burst=/etc/gpu-orchestrator/burst.kubeconfig.")
+	flag.StringVar(&propagatedNodeLabels, "propagated-node-labels",
+		"topology.kubernetes.io/zone,node.kubernetes.io/instance-type,nvidia.com/gpu.product",
+		"Comma-separated node label keys copied into status.nodeLabels of the workloads placed on the node.")
+	flag.BoolVar(&simulate, "simulate", false,
+		"Schedule workloads without creating Jobs and advance them through Running to Succeeded on a timer. "+
+			"For load-testing the controller; no GPUs are used.")
+	flag.StringVar(&requestRounding, "request-rounding", "",
+		"Round GPU and memory requests to --gpu-request-granularity and --memory-request-granularity: up, down, or nearest. "+
+			"Requests are not rounded when empty.")
+	flag.IntVar(&gpuRequestGranularity, "gpu-request-granularity", 1,
+		"Multiple of GPUs workload requests are rounded to with --request-rounding.")
+	flag.StringVar(&memoryRequestGranularity, "memory-request-granularity", "1Gi",
+		"Multiple of memory workload requests are rounded to with --request-rounding.")
+	flag.StringVar(&cpuPerGPU, "cpu-per-gpu", "4",
+		"CPU requested per GPU by high and normal priority workloads that do not set spec.cpuRequest.")
+	flag.StringVar(&memoryPerGPU, "memory-per-gpu", "16Gi",
+		"Memory requested per GPU by high and normal priority workloads that do not set spec.memoryRequest.")
+	flag.IntVar(&maxConcurrentPerModel, "max-concurrent-per-model", 0,
+		"Most workloads of the same modelName that may be scheduled or running at once. Further workloads stay pending "+
+			"with reason model_concurrency_limit. Zero leaves models unlimited.")
+	flag.StringVar(&modelConcurrencyLimits, "model-concurrency-limits", "",
+		"Comma-separated model=limit pairs overriding --max-concurrent-per-model for individual models (e.g. llama2-70b=2). "+
+			"A limit of 0 leaves the model unlimited.")
+	flag.IntVar(&minObservedNodes, "min-observed-nodes", 0,
+		"Fewest GPU nodes the controller expects to list. Scheduling is deferred with reason insufficient_node_visibility "+
+			"while fewer are seen, e.g. during a partial API outage. Zero disables the guard.")
+	flag.IntVar(&maxActiveJobs, "max-active-jobs", 0,
+		"Most unfinished Jobs the controller may manage at once, as a safety limit. Further workloads stay pending "+
+			"with reason max_active_jobs. Zero leaves Jobs unlimited.")
+	flag.DurationVar(&stuckPendingThreshold, "stuck-pending-threshold", 0,
+		"How long a workload may stay pending without a placement before its StuckPending condition is set and "+
+			"a warning event emitted, repeated each time it stays pending that much longer. Zero disables the condition.")
+	flag.DurationVar(&allocationDriftPeriod, "allocation-drift-period", 0,
+		"Interval between audits comparing the GPUs placed workloads are assumed to hold on each node with the GPU "+
+			"requests of its pods, reported as warp_gpu_allocation_drift. Zero disables the audit.")
+	flag.Int64Var(&allocationDriftThreshold, "allocation-drift-threshold", 0,
+		"GPUs of allocation drift a node may show before a GPUAllocationDrift warning event is emitted for it.")
+	flag.DurationVar(&nodeMetricsPeriod, "node-metrics-period", 30*time.Second,
+		"Interval at which the per-node GPU metrics are refreshed, so they follow workloads that finish while nothing "+
+			"is pending. Zero updates them only while workloads are scheduled.")
+	flag.StringVar(&gpuTolerationKey, "gpu-toleration-key", "nvidia.com/gpu",
+		"Key of the GPU node taint every workload pod tolerates, with any value, in addition to the workload's own tolerations.")
+	flag.BoolVar(&disableGPUToleration, "disable-gpu-toleration", false,
+		"Do not add the --gpu-toleration-key toleration to workload pods.")
+	flag.BoolVar(&triggerAutoscaler, "trigger-autoscaler", false,
+		"Create a pending placeholder pod requesting the GPUs of each workload no GPU node has room for, "+
+			"so the cluster-autoscaler provisions GPU nodes. The placeholders are deleted once the workload is placed.")
+	flag.StringVar(&requestRatePrometheusURL, "request-rate-prometheus-url", "",
+		"URL of the Prometheus server the requestRateBalance strategy reads per-node request rates from. "+
+			"requestRateBalance behaves like leastLoaded when empty.")
+	flag.StringVar(&requestRateQuery, "request-rate-query", scheduling.DefaultRequestRateQuery,
+		"PromQL query returning the requests per second served by each node, labeled with \"node\".")
+	flag.StringVar(&reliabilityStateFile, "reliability-state-file", "",
+		"File the per-node workload success and failure counts of the reliabilityWeighted strategy are loaded from "+
+			"at startup and saved to every minute. The counts are kept in memory only when empty.")
+	flag.StringVar(&healthScoreWeights, "health-score-weights", "",
+		"Relative weights of the node signals the healthScore strategy combines, as signal=weight pairs of "+
+			"utilization, temperature, failures and pressure, e.g. \"utilization=2,temperature=1\". Every signal weighs the same when empty.")
+	flag.BoolVar(&printPrometheusRules, "print-prometheus-rules", false,
+		"Print the recommended Prometheus recording and alerting rules as YAML and exit.")
+
 	flag.Parse()
 
+	if printPrometheusRules {
+		if err := metrics.WriteRules(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Setup zap logger with JSON formatting for production
 	config := zap.NewProductionConfig()
 	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
@@ -71,6 +300,136 @@ func main() {
 
 	ctrl.SetLogger(zapr.NewLogger(zapLogger))
 
+	if err := metrics.ConfigureWorkloadLabels(splitList(metricsWorkloadLabels)); err != nil {
+		setupLog.Error(err, "invalid --metrics-workload-labels value")
+		os.Exit(1)
+	}
+	if err := scheduling.ConfigureTieBreak(scheduling.TieBreakPolicy(tieBreakPolicy)); err != nil {
+		setupLog.Error(err, "invalid tie-break policy")
+		os.Exit(1)
+	}
+	overcommitRatio := scheduling.ConfigureOvercommit(gpuOvercommitRatio)
+	if overcommitRatio > scheduling.MinOvercommitRatio {
+		setupLog.Info("GPU over-commit enabled", "ratio", overcommitRatio, "requested", gpuOvercommitRatio)
+	}
+
+	switch controllers.DefragMode(defragMode) {
+	case controllers.DefragOff, controllers.DefragManual, controllers.DefragAuto:
+	default:
+		setupLog.Error(fmt.Errorf("unknown defrag mode %q", defragMode), "invalid --defrag-mode value")
+		os.Exit(1)
+	}
+
+	if canaryStrategy != "" && !scheduling.IsStrategyName(canaryStrategy) {
+		setupLog.Error(fmt.Errorf("unknown strategy %q", canaryStrategy), "invalid --canary-strategy value")
+		os.Exit(1)
+	}
+	if canaryPercent < 0 || canaryPercent > 100 {
+		setupLog.Error(fmt.Errorf("%d is not between 0 and 100", canaryPercent), "invalid --canary-percent value")
+		os.Exit(1)
+	}
+
+	metrics.GetMetrics().SetSchedulingConfig(metrics.SchedulingConfig{
+		DefaultStrategy:    scheduling.DefaultStrategyName,
+		CanaryStrategy:     canaryStrategy,
+		CanaryPercent:      canaryPercent,
+		TieBreakPolicy:     tieBreakPolicy,
+		GPUOvercommitRatio: overcommitRatio,
+	})
+
+	costOptimizedOptions, err := scheduling.ParseCostOptimizedLabel(costOptimizedNodeLabel)
+	if err != nil {
+		setupLog.Error(err, "invalid --cost-optimized-node-label value")
+		os.Exit(1)
+	}
+
+	weights, err := scheduling.ParseHealthScoreWeights(healthScoreWeights)
+	if err != nil {
+		setupLog.Error(err, "invalid --health-score-weights value")
+		os.Exit(1)
+	}
+
+	strategyOptions := scheduling.Options{CostOptimized: costOptimizedOptions, HealthScore: scheduling.HealthScoreOptions{Weights: weights}}
+	if requestRatePrometheusURL != "" {
+		strategyOptions.RequestRateBalance.Source = scheduling.NewPrometheusRequestRates(requestRatePrometheusURL, requestRateQuery)
+	}
+	strategyOptions.ReliabilityWeighted.Tracker = scheduling.NewReliabilityTracker()
+	if reliabilityStateFile != "" {
+		if err := strategyOptions.ReliabilityWeighted.Tracker.Load(reliabilityStateFile); err != nil {
+			setupLog.Error(err, "unable to load node reliability state", "file", reliabilityStateFile)
+			os.Exit(1)
+		}
+	}
+
+	remotes, err := newRemoteClusters(remoteClusters)
+	if err != nil {
+		setupLog.Error(err, "invalid --remote-clusters value")
+		os.Exit(1)
+	}
+	ignoredPressure, err := controllers.ParsePressureConditions(ignoredPressureConditions)
+	if err != nil {
+		setupLog.Error(err, "invalid --ignore-node-pressure value")
+		os.Exit(1)
+	}
+	for _, remote := range remotes {
+		setupLog.Info("Remote cluster configured", "cluster", remote.Name)
+	}
+
+	modelLookup, err := sizing.ParseModelGPUCounts(modelGPUCounts)
+	if err != nil {
+		setupLog.Error(err, "invalid --model-gpu-counts value")
+		os.Exit(1)
+	}
+
+	concurrencyLimits, err := controllers.ParseModelConcurrencyLimits(modelConcurrencyLimits)
+	if err != nil {
+		setupLog.Error(err, "invalid --model-concurrency-limits value")
+		os.Exit(1)
+	}
+	if maxConcurrentPerModel < 0 {
+		setupLog.Error(fmt.Errorf("%d is negative", maxConcurrentPerModel), "invalid --max-concurrent-per-model value")
+		os.Exit(1)
+	}
+
+	roundingMode, err := sizing.ParseRoundingMode(requestRounding)
+	if err != nil {
+		setupLog.Error(err, "invalid --request-rounding value")
+		os.Exit(1)
+	}
+	if gpuRequestGranularity < 1 || gpuRequestGranularity > gpuv1alpha1.MaxGPUCount {
+		setupLog.Error(fmt.Errorf("must be between 1 and %d", gpuv1alpha1.MaxGPUCount), "invalid --gpu-request-granularity value")
+		os.Exit(1)
+	}
+	memoryGranularity, err := resource.ParseQuantity(memoryRequestGranularity)
+	if err != nil {
+		setupLog.Error(err, "invalid --memory-request-granularity value")
+		os.Exit(1)
+	}
+	cpuPerGPUQuantity, err := resource.ParseQuantity(cpuPerGPU)
+	if err != nil {
+		setupLog.Error(err, "invalid --cpu-per-gpu value")
+		os.Exit(1)
+	}
+	memoryPerGPUQuantity, err := resource.ParseQuantity(memoryPerGPU)
+	if err != nil {
+		setupLog.Error(err, "invalid --memory-per-gpu value")
+		os.Exit(1)
+	}
+
+	nsSelector, err := labels.Parse(namespaceSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid --namespace-selector value")
+		os.Exit(1)
+	}
+
+	var maintenanceSchedule timewindow.Schedule
+	if maintenanceWindow != "" {
+		if maintenanceSchedule, err = timewindow.Parse(maintenanceWindow); err != nil {
+			setupLog.Error(err, "invalid --maintenance-window value")
+			os.Exit(1)
+		}
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
@@ -88,15 +447,114 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.GPUWorkloadReconciler{
-		Client: mgr.GetClient(),
-		Log:    ctrl.Log.WithName("controllers").WithName("GPUWorkload"),
-		Scheme: mgr.GetScheme(),
-	}).SetupWithManager(mgr); err != nil {
+	reconciler := &controllers.GPUWorkloadReconciler{
+		Client:    mgr.GetClient(),
+		APIReader: mgr.GetAPIReader(),
+		Log:       logging.NewDedupLogger(ctrl.Log.WithName("controllers").WithName("GPUWorkload"), logDedupWindow, nil),
+		Scheme:    mgr.GetScheme(),
+
+		GPUMemoryGB:    int32(gpuMemoryGB),
+		ModelGPUCounts: modelLookup,
+
+		AllowControlPlaneNodes:    allowControlPlaneNodes,
+		IgnoredPressureConditions: ignoredPressure,
+		PendingResyncPeriod:       pendingResyncPeriod,
+		PendingResyncBatchSize:    pendingResyncBatchSize,
+		StrategyBenchmarkPeriod:   strategyBenchmarkPeriod,
+		MaintenanceWindow:         maintenanceSchedule,
+		RequireImageDigest:        requireImageDigest,
+		GPUVendorPreference:       splitList(gpuVendorPreference),
+		NamespaceSelector:         nsSelector,
+		SerializePlacements:       serializePlacements,
+		PriorityOrdering:          priorityOrdering,
+		PlacementDebounce:         placementDebounce,
+		DefragMode:                controllers.DefragMode(defragMode),
+		DefragPeriod:              defragPeriod,
+		MigrationPeriod:           migrationPeriod,
+		MigrationMinImprovement:   migrationMinImprovement,
+		CanaryStrategy:            canaryStrategy,
+		CanaryPercent:             canaryPercent,
+		StrategyOptions:           strategyOptions,
+		ImagePullFailureThreshold: imagePullFailureThreshold,
+		PhaseTransitionDelay:      phaseTransitionDelay,
+		RemoteClusters:            remotes,
+		PropagatedNodeLabels:      splitList(propagatedNodeLabels),
+		Simulate:                  simulate,
+		GPURequestRounding:        sizing.Rounding{Mode: roundingMode, Granularity: int64(gpuRequestGranularity)},
+		MemoryRequestRounding:     sizing.Rounding{Mode: roundingMode, Granularity: memoryGranularity.Value()},
+		CPUPerGPU:                 cpuPerGPUQuantity,
+		MemoryPerGPU:              memoryPerGPUQuantity,
+		MinObservedNodes:          minObservedNodes,
+		MaxActiveJobs:             maxActiveJobs,
+		StuckPendingThreshold:     stuckPendingThreshold,
+		AllocationDriftPeriod:     allocationDriftPeriod,
+		NodeMetricsPeriod:         nodeMetricsPeriod,
+		AllocationDriftThreshold:  allocationDriftThreshold,
+		TriggerAutoscaler:         triggerAutoscaler,
+		GPUTolerationKey:          gpuTolerationKey,
+		DisableGPUToleration:      disableGPUToleration,
+		MaxConcurrentPerModel:     int32(maxConcurrentPerModel),
+		ModelConcurrencyLimits:    concurrencyLimits,
+	}
+	if approvalEndpoint != "" {
+		reconciler.Approver = approval.NewHTTPApprover(approvalEndpoint)
+	}
+	if notificationURL != "" {
+		reconciler.Notifier = notification.NewHTTPNotifier(notificationURL)
+		if notificationLogLines > 0 {
+			pods, err := corev1client.NewForConfig(mgr.GetConfig())
+			if err != nil {
+				setupLog.Error(err, "unable to create pod log client")
+				os.Exit(1)
+			}
+			reconciler.LogSource = &notification.PodLogSource{Pods: pods}
+			reconciler.NotificationLogLines = notificationLogLines
+		}
+	}
+	if err = reconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "GPUWorkload")
 		os.Exit(1)
 	}
 
+	if enableWebhooks {
+		gpuv1alpha1.RequireImageDigest = requireImageDigest
+		if err = (&gpuv1alpha1.GPUWorkload{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "GPUWorkload")
+			os.Exit(1)
+		}
+	}
+
+	if reliabilityStateFile != "" {
+		if err := mgr.Add(newReliabilityPersister(strategyOptions.ReliabilityWeighted.Tracker, reliabilityStateFile)); err != nil {
+			setupLog.Error(err, "unable to set up node reliability persistence")
+			os.Exit(1)
+		}
+	}
+
+	if debugAddr != "" {
+		token, err := readToken("--debug-token-file", debugTokenFile)
+		if err != nil {
+			setupLog.Error(err, "unable to read debug endpoint token")
+			os.Exit(1)
+		}
+		if err := mgr.Add(newHTTPServer("debug", debugAddr, reconciler.DebugHandler(token))); err != nil {
+			setupLog.Error(err, "unable to set up debug server")
+			os.Exit(1)
+		}
+	}
+
+	if evaluationAddr != "" {
+		token, err := readToken("--evaluation-token-file", evaluationTokenFile)
+		if err != nil {
+			setupLog.Error(err, "unable to read evaluation endpoint token")
+			os.Exit(1)
+		}
+		if err := mgr.Add(newHTTPServer("evaluation", evaluationAddr, reconciler.EvaluationHandler(token))); err != nil {
+			setupLog.Error(err, "unable to set up evaluation server")
+			os.Exit(1)
+		}
+	}
+
 	// Setup health checks
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -113,3 +571,90 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// readToken returns the trimmed bearer token held in the file named by the given flag.
+func readToken(flagName, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("%s must name a file holding a non-empty token", flagName)
+	}
+	return token, nil
+}
+
+// newHTTPServer returns a runnable serving the named endpoints until the manager stops.
+func newHTTPServer(name, addr string, handler http.Handler) manager.RunnableFunc {
+	return func(ctx context.Context) error {
+		server := &http.Server{Addr: addr, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			<-ctx.Done()
+			server.Close()
+		}()
+		setupLog.Info("starting "+name+" server", "address", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+	}
+}
+
+// newReliabilityPersister returns a runnable saving the node reliability state to path every
+// minute and when the manager stops.
+func newReliabilityPersister(tracker *scheduling.ReliabilityTracker, path string) manager.RunnableFunc {
+	return func(ctx context.Context) error {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return tracker.Save(path)
+			case <-ticker.C:
+				if err := tracker.Save(path); err != nil {
+					setupLog.Error(err, "unable to save node reliability state", "file", path)
+				}
+			}
+		}
+	}
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// newRemoteClusters builds a client for each name=kubeconfig pair of the --remote-clusters flag.
+func newRemoteClusters(value string) ([]controllers.RemoteCluster, error) {
+	var remotes []controllers.RemoteCluster
+	seen := map[string]bool{}
+	for _, entry := range splitList(value) {
+		name, kubeconfig, ok := strings.Cut(entry, "=")
+		name, kubeconfig = strings.TrimSpace(name), strings.TrimSpace(kubeconfig)
+		if !ok || name == "" || kubeconfig == "" {
+			return nil, fmt.Errorf("%q is not a name=kubeconfig pair", entry)
+		}
+		if name == controllers.ClusterAuto || seen[name] {
+			return nil, fmt.Errorf("cluster name %q is reserved or duplicated", name)
+		}
+		seen[name] = true
+
+		config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", name, err)
+		}
+		c, err := client.New(config, client.Options{Scheme: scheme})
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", name, err)
+		}
+		remotes = append(remotes, controllers.RemoteCluster{Name: name, Client: c})
+	}
+	return remotes, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// activeJobsRecheckInterval is how often workloads held back by MaxActiveJobs check it again.
+const activeJobsRecheckInterval = 30 * time.Second
+
+// managedJobs selects the Jobs the controller created for workloads.
+var managedJobs = client.MatchingLabels{"gpu.warp.dev/controller": "gpu-orchestrator"}
+
+// activeJobsExceeded reports whether the workload's Jobs, one per replica, would take the
+// unfinished Jobs the controller manages past MaxActiveJobs, with a message for the workload
+// status. Called while holding the placement lock, it reads the Jobs past the cache so those
+// the previous holder created are counted. The count is published as warp_active_jobs
+// whenever the cap is set.
+func (r *GPUWorkloadReconciler) activeJobsExceeded(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) (string, bool, error) {
+	if r.MaxActiveJobs <= 0 {
+		return "", false, nil
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := r.apiReader().List(ctx, jobs, managedJobs); err != nil {
+		return "", false, err
+	}
+	active := 0
+	for i := range jobs.Items {
+		if finished, _ := jobFinished(&jobs.Items[i]); !finished {
+			active++
+		}
+	}
+	if m := metrics.GetMetrics(); m != nil {
+		m.SetActiveJobs(active)
+	}
+	replicas := int(replicaCount(gw))
+	if active+replicas <= r.MaxActiveJobs {
+		return "", false, nil
+	}
+	return fmt.Sprintf("%d Jobs are already active and %d more are needed, the limit is %d", active, replicas, r.MaxActiveJobs), true, nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+func TestReconcile_MaxActiveJobsDefersScheduling(t *testing.T) {
+	// A finished Job of the controller and an unrelated Job do not count
+	finished := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "default", Labels: managedJobs},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+			{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+		}},
+	}
+	unrelated := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"}}
+	first := createTestWorkload("first", 1)
+	second := createTestWorkload("second", 1)
+	third := createTestWorkload("third", 1)
+	node := createGPUNode("node1", 8)
+
+	r := newTestReconciler(t, finished, unrelated, first, second, third, &node)
+	r.MaxActiveJobs = 2
+
+	for _, gw := range []*gpuv1alpha1.GPUWorkload{first, second} {
+		if _, updated := reconcileWorkload(t, r, gw); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+			t.Fatalf("Expected %s to be scheduled, got %s: %s", gw.Name, updated.Status.Phase, updated.Status.Message)
+		}
+	}
+
+	result, updated := reconcileWorkload(t, r, third)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "max_active_jobs" {
+		t.Fatalf("Expected Pending with reason max_active_jobs, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if result.RequeueAfter != activeJobsRecheckInterval {
+		t.Errorf("Expected requeue after %v, got %v", activeJobsRecheckInterval, result.RequeueAfter)
+	}
+	if active := testutil.ToFloat64(metrics.GetMetrics().ActiveJobs); active != 2 {
+		t.Errorf("Expected 2 active jobs to be reported, got %v", active)
+	}
+
+	// Once a Job finishes the next workload is placed
+	job := &batchv1.Job{}
+	if err := r.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: getWorkload(t, r, "first").Status.JobName}, job); err != nil {
+		t.Fatalf("unable to get job: %v", err)
+	}
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+	if err := r.Status().Update(context.Background(), job); err != nil {
+		t.Fatalf("unable to update job: %v", err)
+	}
+	if _, updated := reconcileWorkload(t, r, third); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected third to be scheduled once a job finished, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+}
+
+func TestReconcile_MaxActiveJobsCountsEveryReplica(t *testing.T) {
+	running := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "default", Labels: managedJobs}}
+	gang := createTestWorkload("gang", 1)
+	gang.Spec.Replicas = 2
+	nodeA := createGPUNode("node-a", 8)
+	nodeB := createGPUNode("node-b", 8)
+
+	r := newTestReconciler(t, running, gang, &nodeA, &nodeB)
+	r.MaxActiveJobs = 2
+
+	// One Job is active, so the gang's two would exceed the limit
+	if _, updated := reconcileWorkload(t, r, gang); updated.Status.Reason != "max_active_jobs" {
+		t.Errorf("Expected the gang to wait with reason max_active_jobs, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 1 {
+		t.Errorf("Expected no Job to be created for the gang, got %d jobs", len(jobs))
+	}
+}
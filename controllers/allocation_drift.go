@@ -0,0 +1,147 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// allocationDriftAuditor periodically compares, on every GPU node, the GPUs the controller
+// assumes its Scheduled and Running workloads hold with the GPUs actually requested by the
+// pods of its workload Jobs bound to the node, to surface accounting bugs. Nodes whose drift
+// exceeds the threshold get a warning event. It implements manager.Runnable.
+type allocationDriftAuditor struct {
+	reconciler *GPUWorkloadReconciler
+	log        logr.Logger
+	period     time.Duration
+	threshold  int64
+}
+
+// Start runs the audit loop until the context is cancelled.
+func (a *allocationDriftAuditor) Start(ctx context.Context) error {
+	ticker := time.NewTicker(a.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := a.runOnce(ctx); err != nil {
+			a.log.Error(err, "allocation drift audit aborted")
+		}
+	}
+}
+
+// runOnce records the allocation drift of every GPU node and warns about those beyond the threshold.
+func (a *allocationDriftAuditor) runOnce(ctx context.Context) error {
+	r := a.reconciler
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return err
+	}
+	var gpuNodes []corev1.Node
+	for i := range nodes.Items {
+		if hasGPUs(&nodes.Items[i]) {
+			gpuNodes = append(gpuNodes, nodes.Items[i])
+		}
+	}
+	pods, err := nodePods(ctx, r.Client, gpuNodes)
+	if err != nil {
+		return err
+	}
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, managedJobs); err != nil {
+		return err
+	}
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return err
+	}
+	assumed := assumedGPUAllocations(workloads.Items)
+
+	drifts := make(map[string]float64, len(gpuNodes))
+	for i := range gpuNodes {
+		node := &gpuNodes[i]
+		actual := scheduling.PodGPURequests(managedJobPods(pods[node.Name], jobs.Items))
+		drift := actual - assumed[node.Name]
+		drifts[node.Name] = float64(drift)
+		if drift > a.threshold || -drift > a.threshold {
+			message := fmt.Sprintf("Pods of workload Jobs on node %s request %d GPUs, but the controller assumes its placed workloads hold %d",
+				node.Name, actual, assumed[node.Name])
+			a.log.Info("GPU allocation drift detected", "node", node.Name, "podGPUs", actual, "assumedGPUs", assumed[node.Name])
+			r.Recorder.Event(node, corev1.EventTypeWarning, "GPUAllocationDrift", message)
+		}
+	}
+	if m := metrics.GetMetrics(); m != nil {
+		m.UpdateGPUAllocationDrift(drifts)
+	}
+	return nil
+}
+
+// managedJobPods returns the pods created by the given Jobs of workloads. GPU pods of other
+// owners, such as system daemons or other tenants, are outside the controller's accounting.
+func managedJobPods(pods []corev1.Pod, jobs []batchv1.Job) []corev1.Pod {
+	managed := make(map[types.NamespacedName]bool, len(jobs))
+	for i := range jobs {
+		managed[client.ObjectKeyFromObject(&jobs[i])] = true
+	}
+
+	var owned []corev1.Pod
+	for i := range pods {
+		owner := metav1.GetControllerOf(&pods[i])
+		if owner != nil && owner.Kind == "Job" && managed[types.NamespacedName{Namespace: pods[i].Namespace, Name: owner.Name}] {
+			owned = append(owned, pods[i])
+		}
+	}
+	return owned
+}
+
+// assumedGPUAllocations sums, by node, the whole GPUs the controller assumes the replicas of
+// its Scheduled and Running workloads in the local cluster hold. MIG workloads, whose slices
+// pods do not request as whole GPUs, and CPU fallbacks are left out.
+func assumedGPUAllocations(workloads []gpuv1alpha1.GPUWorkload) map[string]int64 {
+	assumed := map[string]int64{}
+	for i := range workloads {
+		gw := &workloads[i]
+		if gw.Status.Phase != gpuv1alpha1.PhaseScheduled && gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			continue
+		}
+		if gw.Status.Cluster != "" || gw.Status.CPUFallback || gw.Spec.MIGProfile != "" {
+			continue
+		}
+		for _, node := range placedNodes(gw) {
+			assumed[node] += int64(placedGPUs(gw))
+		}
+	}
+	return assumed
+}
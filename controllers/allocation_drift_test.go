@@ -0,0 +1,163 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// managedJob labels the Job as created by the controller.
+func managedJob(job *batchv1.Job) *batchv1.Job {
+	job.Labels = map[string]string{"gpu.warp.dev/controller": "gpu-orchestrator"}
+	return job
+}
+
+// jobGPUPod returns a running pod of the Job bound to the node requesting the given GPUs.
+func jobGPUPod(job *batchv1.Job, node string, gpus int64) *corev1.Pod {
+	pod := createGPUPod(job.Name+"-pod", node, gpus)
+	pod.Labels = map[string]string{"job-name": job.Name}
+	pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: job.Name, Controller: boolPtr(true)}}
+	return pod
+}
+
+func TestAllocationDriftAuditor_ReportsDivergingNodes(t *testing.T) {
+	node1 := createGPUNode("node1", 8)
+	node2 := createGPUNode("node2", 8)
+	node3 := createGPUNode("node3", 8)
+
+	// node1 is consistent: its workload's pod requests the GPUs it is assumed to hold, and
+	// the GPU pod of another tenant is none of the controller's business
+	wa, jobA, _ := createPlacedWorkload("wa", "node1", 2)
+	podA := jobGPUPod(managedJob(jobA), "node1", 2)
+	tenant := createGPUPod("other-tenant", "node1", 4)
+
+	// node2 runs the pod of a workload Job no placed workload accounts for
+	orphan := managedJob(&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "orphan-job", Namespace: "default"}})
+	leaked := jobGPUPod(orphan, "node2", 3)
+
+	// node3's workload is assumed to hold 4 GPUs, but its pod is gone
+	wc, jobC, _ := createPlacedWorkload("wc", "node3", 4)
+
+	r := newTestReconciler(t, &node1, &node2, &node3, wa, jobA, podA, tenant, orphan, leaked, wc, managedJob(jobC))
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+	defer metrics.GetMetrics().UpdateGPUAllocationDrift(nil)
+
+	auditor := &allocationDriftAuditor{reconciler: r, log: logr.Discard(), threshold: 1}
+	if err := auditor.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+
+	drift := func(node string) float64 {
+		return testutil.ToFloat64(metrics.GetMetrics().GPUAllocationDrift.WithLabelValues(node))
+	}
+	for node, expected := range map[string]float64{"node1": 0, "node2": 3, "node3": -4} {
+		if got := drift(node); got != expected {
+			t.Errorf("Expected a drift of %v GPUs on %s, got %v", expected, node, got)
+		}
+	}
+
+	warned := map[string]string{}
+	for _, event := range recorder.events {
+		if event.reason != "GPUAllocationDrift" || event.eventType != corev1.EventTypeWarning {
+			t.Errorf("Unexpected event %+v", event)
+			continue
+		}
+		warned[event.object.(*corev1.Node).Name] = event.message
+	}
+	if len(warned) != 2 {
+		t.Fatalf("Expected warnings for node2 and node3, got %v", warned)
+	}
+	if !strings.Contains(warned["node2"], "request 3 GPUs") || !strings.Contains(warned["node3"], "assumes its placed workloads hold 4") {
+		t.Errorf("Unexpected warnings: %v", warned)
+	}
+}
+
+func TestAllocationDriftAuditor_ToleratesDriftWithinThreshold(t *testing.T) {
+	node := createGPUNode("node1", 8)
+	orphan := managedJob(&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "orphan-job", Namespace: "default"}})
+	leaked := jobGPUPod(orphan, "node1", 1)
+
+	r := newTestReconciler(t, &node, orphan, leaked)
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+	defer metrics.GetMetrics().UpdateGPUAllocationDrift(nil)
+
+	auditor := &allocationDriftAuditor{reconciler: r, log: logr.Discard(), threshold: 1}
+	if err := auditor.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+
+	if got := testutil.ToFloat64(metrics.GetMetrics().GPUAllocationDrift.WithLabelValues("node1")); got != 1 {
+		t.Errorf("Expected a drift of 1 GPU, got %v", got)
+	}
+	if len(recorder.events) != 0 {
+		t.Errorf("Expected no warning within the threshold, got %+v", recorder.events)
+	}
+}
+
+func TestAllocationDriftAuditor_ForgetsRemovedNodes(t *testing.T) {
+	node1 := createGPUNode("node1", 8)
+	node2 := createGPUNode("node2", 8)
+
+	r := newTestReconciler(t, &node1, &node2)
+	defer metrics.GetMetrics().UpdateGPUAllocationDrift(nil)
+
+	auditor := &allocationDriftAuditor{reconciler: r, log: logr.Discard()}
+	if err := auditor.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if err := r.Delete(context.Background(), &node2); err != nil {
+		t.Fatalf("unable to delete node2: %v", err)
+	}
+	if err := auditor.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+
+	if n := testutil.CollectAndCount(&metrics.GetMetrics().GPUAllocationDrift); n != 1 {
+		t.Errorf("Expected only node1's drift series once node2 left, got %d", n)
+	}
+}
+
+func TestAssumedGPUAllocations_SkipsUnheldPlacements(t *testing.T) {
+	running, _, _ := createPlacedWorkload("running", "node1", 2)
+
+	mig, _, _ := createPlacedWorkload("mig", "node1", 1)
+	mig.Spec.MIGProfile = "1g.5gb"
+
+	remote, _, _ := createPlacedWorkload("remote", "node1", 4)
+	remote.Status.Cluster = "edge"
+
+	finished, _, _ := createPlacedWorkload("finished", "node1", 4)
+	finished.Status.Phase = gpuv1alpha1.PhaseSucceeded
+
+	got := assumedGPUAllocations([]gpuv1alpha1.GPUWorkload{*running, *mig, *remote, *finished})
+	if len(got) != 1 || got["node1"] != 2 {
+		t.Errorf("Expected 2 GPUs assumed on node1, got %v", got)
+	}
+}
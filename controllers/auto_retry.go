@@ -0,0 +1,106 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// defaultMaxAutoRetries is the number of auto-retry cycles of workloads that don't set MaxAutoRetries.
+const defaultMaxAutoRetries = 3
+
+// markFailed moves the workload to Failed for a reason that may clear up on its own,
+// starting its auto-retry cooldown. The caller updates the status.
+func (r *GPUWorkloadReconciler) markFailed(gw *gpuv1alpha1.GPUWorkload, reason, message string) {
+	gw.Status.Phase = gpuv1alpha1.PhaseFailed
+	gw.Status.Reason = reason
+	gw.Status.Message = message
+	gw.Status.FailedTime = &metav1.Time{Time: r.now()}
+}
+
+// autoRetryRemaining returns how long until a Failed workload is returned to Pending, and
+// false when it will not be: auto-retry is disabled, its cycles are used up, or the workload
+// failed for an invalid spec.
+func (r *GPUWorkloadReconciler) autoRetryRemaining(gw *gpuv1alpha1.GPUWorkload) (time.Duration, bool) {
+	if gw.Spec.AutoRetryAfterSeconds == nil || gw.Status.FailedTime == nil {
+		return 0, false
+	}
+	maxAutoRetries := gw.Spec.MaxAutoRetries
+	if maxAutoRetries <= 0 {
+		maxAutoRetries = defaultMaxAutoRetries
+	}
+	if gw.Status.AutoRetryCount >= maxAutoRetries {
+		return 0, false
+	}
+
+	cooldown := time.Duration(*gw.Spec.AutoRetryAfterSeconds) * time.Second
+	remaining := gw.Status.FailedTime.Add(cooldown).Sub(r.now())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
+// failedResult returns the result of a reconcile that failed the workload, requeueing it for
+// its auto-retry when one is due, or else for its deletion after TTLSecondsAfterFinished.
+func (r *GPUWorkloadReconciler) failedResult(gw *gpuv1alpha1.GPUWorkload) ctrl.Result {
+	if remaining, ok := r.autoRetryRemaining(gw); ok {
+		return ctrl.Result{RequeueAfter: remaining}
+	}
+	return r.finishedResult(gw)
+}
+
+// autoRetry returns a Failed workload to Pending with its retries reset once its auto-retry
+// cooldown has passed, deleting the failed Job, if any, so a new one is created. It returns
+// false when the workload is not retried and stays Failed.
+func (r *GPUWorkloadReconciler) autoRetry(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, bool, error) {
+	remaining, ok := r.autoRetryRemaining(gw)
+	if !ok {
+		return ctrl.Result{}, false, nil
+	}
+	if remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, true, nil
+	}
+
+	maxAutoRetries := gw.Spec.MaxAutoRetries
+	if maxAutoRetries <= 0 {
+		maxAutoRetries = defaultMaxAutoRetries
+	}
+	message := fmt.Sprintf("Retrying after failure (%s), auto-retry %d/%d", gw.Status.Reason, gw.Status.AutoRetryCount+1, maxAutoRetries)
+
+	gw.Status.AutoRetryCount++
+	gw.Status.RetryCount = 0
+	gw.Status.FailureReasons = nil
+	gw.Status.LastBackoff = nil
+	gw.Status.FailedTime = nil
+	if err := r.releasePlacement(ctx, log, gw, "auto_retry", message); err != nil {
+		return ctrl.Result{}, true, err
+	}
+
+	log.Info("Auto-retrying failed GPUWorkload", "autoRetryCount", gw.Status.AutoRetryCount, "maxAutoRetries", maxAutoRetries)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "AutoRetry", message)
+	return ctrl.Result{Requeue: true}, true, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_ExhaustedRetriesAreAutoRetriedAfterCooldown(t *testing.T) {
+	gw := createTestWorkload("transient", 2)
+	gw.Spec.AutoRetryAfterSeconds = int32Ptr(60)
+	gw.Status = gpuv1alpha1.GPUWorkloadStatus{
+		Phase:          gpuv1alpha1.PhasePending,
+		RetryCount:     3,
+		FailureReasons: map[string]int32{"no_suitable_node": 3},
+	}
+	r := newTestReconciler(t, gw)
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	r.Clock = fakeClock
+
+	// Exhausting the retries fails the workload and requeues it for the cooldown
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.FailedTime == nil {
+		t.Fatalf("Expected Failed with a failure time, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter != time.Minute {
+		t.Errorf("Expected a requeue after the 1m cooldown, got %v", result.RequeueAfter)
+	}
+
+	// Still cooling down
+	fakeClock.SetTime(fakeClock.Now().Add(45 * time.Second))
+	result, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || result.RequeueAfter != 15*time.Second {
+		t.Errorf("Expected to stay Failed for another 15s, got %s after %v", updated.Status.Phase, result.RequeueAfter)
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(15 * time.Second))
+	result, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "auto_retry" {
+		t.Fatalf("Expected Pending with reason auto_retry, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if updated.Status.RetryCount != 0 || updated.Status.AutoRetryCount != 1 || updated.Status.FailureReasons != nil {
+		t.Errorf("Expected retries reset and one auto-retry, got retries %d, auto-retries %d, reasons %v",
+			updated.Status.RetryCount, updated.Status.AutoRetryCount, updated.Status.FailureReasons)
+	}
+	if !result.Requeue {
+		t.Error("Expected the retried workload to be requeued")
+	}
+
+	// Capacity has come back and the workload is placed
+	node := createGPUNode("node1", 4)
+	if err := r.Create(context.Background(), &node); err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected Scheduled after the auto-retry, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+}
+
+func TestReconcile_AutoRetryDeletesFailedJob(t *testing.T) {
+	gw := createTestWorkload("job-failed", 1)
+	gw.Spec.AutoRetryAfterSeconds = int32Ptr(30)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw.Status = gpuv1alpha1.GPUWorkloadStatus{
+		Phase:        gpuv1alpha1.PhaseFailed,
+		Reason:       "workload_failed",
+		JobName:      "job-failed-job",
+		AssignedNode: "node1",
+		FailedTime:   &metav1.Time{Time: now.Add(-time.Minute)},
+	}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job-failed-job", Namespace: "default"}}
+	r := newTestReconciler(t, gw, job)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.JobName != "" || updated.Status.AssignedNode != "" {
+		t.Errorf("Expected Pending with the placement released, got %s on %q with job %q",
+			updated.Status.Phase, updated.Status.AssignedNode, updated.Status.JobName)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected the failed job to be deleted, got %d jobs", len(jobs))
+	}
+}
+
+func TestReconcile_AutoRetryPlacesNewJobWhileOldOneIsDeleted(t *testing.T) {
+	gw := createTestWorkload("retried", 1)
+	gw.Spec.AutoRetryAfterSeconds = int32Ptr(30)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	oldJob := replicaJobName(gw, 0)
+	gw.Status = gpuv1alpha1.GPUWorkloadStatus{
+		Phase:        gpuv1alpha1.PhaseFailed,
+		Reason:       "workload_failed",
+		JobName:      oldJob,
+		AssignedNode: "node1",
+		Placements:   1,
+		FailedTime:   &metav1.Time{Time: now.Add(-time.Minute)},
+	}
+	// The finalizer keeps the failed Job around, being deleted, after the retry releases it
+	job, _ := createFinishedJob(gw, batchv1.JobFailed, 1)
+	job.Name = oldJob
+	job.Finalizers = []string{"example.com/hold"}
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, job, &node)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending {
+		t.Fatalf("Expected Pending after the auto-retry, got %s", updated.Status.Phase)
+	}
+
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.JobName == oldJob || updated.Status.Placements != 2 {
+		t.Errorf("Expected a new Job for the second placement, got %q after %d placements", updated.Status.JobName, updated.Status.Placements)
+	}
+}
+
+func TestCreateJobForWorkload_RefusesFinishedJob(t *testing.T) {
+	gw := createTestWorkload("stale", 1)
+	job, _ := createFinishedJob(gw, batchv1.JobFailed, 1)
+	job.Name = replicaJobName(gw, 0)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, job, &node)
+
+	if _, err := r.createJobForWorkload(gw, "", &node, 0); err == nil {
+		t.Error("Expected a finished Job of the same name not to be adopted")
+	}
+}
+
+func TestReconcile_AutoRetryRespectsCycleCap(t *testing.T) {
+	gw := createTestWorkload("keeps-failing", 1)
+	gw.Spec.AutoRetryAfterSeconds = int32Ptr(30)
+	gw.Spec.MaxAutoRetries = 2
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw.Status = gpuv1alpha1.GPUWorkloadStatus{
+		Phase:          gpuv1alpha1.PhaseFailed,
+		Reason:         "no_suitable_node",
+		AutoRetryCount: 2,
+		FailedTime:     &metav1.Time{Time: now.Add(-time.Hour)},
+	}
+	r := newTestReconciler(t, gw)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.AutoRetryCount != 2 {
+		t.Errorf("Expected to stay Failed after 2 auto-retries, got %s with %d", updated.Status.Phase, updated.Status.AutoRetryCount)
+	}
+	if result.Requeue || result.RequeueAfter != 0 {
+		t.Errorf("Expected no requeue once the cycles are used up, got %+v", result)
+	}
+}
+
+func TestReconcile_InvalidSpecIsNotAutoRetried(t *testing.T) {
+	gw := createTestWorkload("invalid", 0)
+	gw.Spec.AutoRetryAfterSeconds = int32Ptr(1)
+	r := newTestReconciler(t, gw)
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.FailedTime != nil {
+		t.Fatalf("Expected Failed without a failure time, got %s (%v)", updated.Status.Phase, updated.Status.FailedTime)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("Expected no auto-retry for an invalid spec, got a requeue after %v", result.RequeueAfter)
+	}
+}
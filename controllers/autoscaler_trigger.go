@@ -0,0 +1,134 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+const (
+	// autoscalerTriggerLabel marks the placeholder pods that ask the cluster-autoscaler for GPU nodes.
+	autoscalerTriggerLabel = "gpu.warp.dev/autoscaler-trigger"
+
+	// autoscalerTriggerImage is the image of the placeholder pods; they never run anything.
+	autoscalerTriggerImage = "registry.k8s.io/pause:3.9"
+)
+
+// autoscalerTriggerName returns the name of the placeholder pod standing in for a replica.
+func autoscalerTriggerName(gw *gpuv1alpha1.GPUWorkload, replica int32) string {
+	return fmt.Sprintf("%s-scale-up-%s-%d", gw.Name, gw.UID[:8], replica)
+}
+
+// triggerScaleUp asks the cluster-autoscaler for GPU nodes for a workload that does not fit
+// the local cluster, when enabled. Failing to do so only delays the workload, so errors are
+// logged rather than returned.
+func (r *GPUWorkloadReconciler) triggerScaleUp(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, cluster string) {
+	if !r.TriggerAutoscaler || cluster != "" || r.Simulate || gw.Spec.DryRun {
+		return
+	}
+	if err := r.requestScaleUp(ctx, log, gw); err != nil {
+		log.Error(err, "unable to create autoscaler placeholder pods")
+	}
+}
+
+// requestScaleUp creates, for each replica of a workload no GPU node has room for, a
+// placeholder pod requesting the replica's resources. The default scheduler cannot place
+// the pods either, and the cluster-autoscaler provisions GPU nodes for them. The pods are
+// owned by the workload and deleted by cancelScaleUp once it is placed.
+func (r *GPUWorkloadReconciler) requestScaleUp(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) error {
+	created := 0
+	for i := int32(0); i < replicaCount(gw); i++ {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      autoscalerTriggerName(gw, i),
+				Namespace: gw.Namespace,
+				Labels: map[string]string{
+					"gpu.warp.dev/workload":   gw.Name,
+					"gpu.warp.dev/controller": "gpu-orchestrator",
+					autoscalerTriggerLabel:    "true",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: gw.APIVersion,
+						Kind:       gw.Kind,
+						Name:       gw.Name,
+						UID:        gw.UID,
+						Controller: boolPtr(true),
+					},
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:      "scale-up",
+						Image:     autoscalerTriggerImage,
+						Resources: r.roundedResources(gw),
+					},
+				},
+				// GPU nodes are commonly tainted for their GPUs; the autoscaler must consider them
+				Tolerations: append([]corev1.Toleration{
+					{Key: string(gpuResourceName(gw)), Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+				}, gw.Spec.Tolerations...),
+				NodeSelector:                  gw.Spec.NodeSelector,
+				TerminationGracePeriodSeconds: new(int64),
+			},
+		}
+		if gw.Spec.RuntimeClassName != "" {
+			pod.Spec.RuntimeClassName = &gw.Spec.RuntimeClassName
+		}
+
+		if err := r.Create(ctx, pod); apierrors.IsAlreadyExists(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		created++
+	}
+
+	if created > 0 {
+		log.Info("Requested GPU nodes from the cluster-autoscaler", "pods", created)
+		r.Recorder.Event(gw, corev1.EventTypeNormal, "TriggeredScaleUp",
+			fmt.Sprintf("Created %d placeholder pods for the cluster-autoscaler to provision GPU nodes for", created))
+	}
+	return nil
+}
+
+// cancelScaleUp deletes the workload's placeholder pods, so they neither hold GPUs on the
+// nodes provisioned for them nor ask for more.
+func (r *GPUWorkloadReconciler) cancelScaleUp(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) error {
+	for i := int32(0); i < replicaCount(gw); i++ {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: autoscalerTriggerName(gw, i), Namespace: gw.Namespace}}
+		if err := r.Delete(ctx, pod, client.GracePeriodSeconds(0)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isAutoscalerTrigger reports whether the pod is a placeholder created by requestScaleUp.
+func isAutoscalerTrigger(pod *corev1.Pod) bool {
+	return pod.Labels[autoscalerTriggerLabel] == "true"
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_TriggersAutoscalerWhenNodesAreFull(t *testing.T) {
+	gw := createTestWorkload("scale-up", 6)
+	node := createGPUNode("node1", 2)
+	r := newTestReconciler(t, gw, &node)
+	r.TriggerAutoscaler = true
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Reason != "all_nodes_full" {
+		t.Fatalf("Expected all_nodes_full, got %q", updated.Status.Reason)
+	}
+	trigger := &corev1.Pod{}
+	key := types.NamespacedName{Name: autoscalerTriggerName(gw, 0), Namespace: gw.Namespace}
+	if err := r.Get(context.Background(), key, trigger); err != nil {
+		t.Fatalf("Expected an autoscaler placeholder pod: %v", err)
+	}
+	if !isAutoscalerTrigger(trigger) || trigger.Spec.NodeName != "" {
+		t.Errorf("Expected an unbound placeholder pod, got labels %v on node %q", trigger.Labels, trigger.Spec.NodeName)
+	}
+	if _, ok := trigger.Spec.Containers[0].Resources.Requests[gpuResourceName(gw)]; !ok {
+		t.Errorf("Expected the placeholder to request GPUs, got %v", trigger.Spec.Containers[0].Resources.Requests)
+	}
+
+	// The autoscaler adds a node; the placeholder bound to it does not keep the workload off it
+	large := createGPUNode("node2", 8)
+	if err := r.Create(context.Background(), &large); err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	trigger.Spec.NodeName = large.Name
+	if err := r.Update(context.Background(), trigger); err != nil {
+		t.Fatalf("unable to bind placeholder: %v", err)
+	}
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.AssignedNode != large.Name {
+		t.Fatalf("Expected the workload scheduled on node2, got %s on %q: %s", updated.Status.Phase, updated.Status.AssignedNode, updated.Status.Message)
+	}
+	if err := r.Get(context.Background(), key, &corev1.Pod{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the placeholder pod to be deleted, got %v", err)
+	}
+}
+
+func TestReconcile_AutoscalerTriggerDisabledByDefault(t *testing.T) {
+	gw := createTestWorkload("no-scale-up", 4)
+	node := createGPUNode("node1", 2)
+	r := newTestReconciler(t, gw, &node)
+
+	reconcileWorkload(t, r, gw)
+	pods := &corev1.PodList{}
+	if err := r.List(context.Background(), pods); err != nil {
+		t.Fatalf("unable to list pods: %v", err)
+	}
+	if len(pods.Items) != 0 {
+		t.Errorf("Expected no placeholder pods, got %d", len(pods.Items))
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// ClusterAuto, as a workload's spec.cluster, bursts the workload to the remote clusters
+// when no node of the controller's own cluster fits it.
+const ClusterAuto = "auto"
+
+// remoteJobPollInterval is how often a Job running in a remote cluster is checked. Remote
+// Jobs are not watched, so their progress is polled.
+const remoteJobPollInterval = 30 * time.Second
+
+// RemoteCluster is a secondary cluster GPU workloads can be placed in. Its nodes are
+// considered alongside the local ones and the workload's Job is created there. The Job's
+// namespace must exist in the remote cluster.
+type RemoteCluster struct {
+	// Name identifies the cluster in spec.cluster and status.cluster.
+	Name string
+
+	// Client reads the cluster's nodes and manages Jobs and pods in it.
+	Client client.Client
+}
+
+// clusterClient returns the client for the named cluster; the empty name is the local cluster.
+func (r *GPUWorkloadReconciler) clusterClient(name string) (client.Client, error) {
+	if name == "" {
+		return r.Client, nil
+	}
+	for _, cluster := range r.RemoteClusters {
+		if cluster.Name == name {
+			return cluster.Client, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown cluster %q", name)
+}
+
+// workloadClusters returns the clusters the workload may be placed in, in the order they are tried.
+func (r *GPUWorkloadReconciler) workloadClusters(gw *gpuv1alpha1.GPUWorkload) ([]string, error) {
+	switch gw.Spec.Cluster {
+	case "":
+		return []string{""}, nil
+	case ClusterAuto:
+		clusters := []string{""}
+		for _, cluster := range r.RemoteClusters {
+			clusters = append(clusters, cluster.Name)
+		}
+		return clusters, nil
+	}
+	if _, err := r.clusterClient(gw.Spec.Cluster); err != nil {
+		return nil, err
+	}
+	return []string{gw.Spec.Cluster}, nil
+}
+
+// clusterPlacement is a node chosen in one of the workload's clusters.
+type clusterPlacement struct {
+	cluster string
+	node    *corev1.Node
+	vendor  string
+}
+
+// placeInRemoteClusters tries the remote clusters after the first in order, returning the
+// first node a cluster offers. Clusters that cannot be listed are skipped.
+func (r *GPUWorkloadReconciler) placeInRemoteClusters(ctx context.Context, log logr.Logger, clusters []string, strategy scheduling.Strategy, gw *gpuv1alpha1.GPUWorkload) (clusterPlacement, bool) {
+	for _, cluster := range clusters {
+		if cluster == "" {
+			continue
+		}
+		c, err := r.clusterClient(cluster)
+		if err != nil {
+			log.Error(err, "unable to reach remote cluster")
+			continue
+		}
+		nodes := &corev1.NodeList{}
+		if err := c.List(ctx, nodes); err != nil {
+			log.Error(err, "unable to list remote cluster nodes", "cluster", cluster)
+			continue
+		}
+
+		gpuNodes := selectedNodes(r.filterGPUNodes(nodes.Items), gw)
+		pods, err := nodePods(ctx, c, gpuNodes)
+		if err != nil {
+			log.Error(err, "unable to list remote cluster pods", "cluster", cluster)
+			continue
+		}
+		if r.serializePlacements() {
+			if gpuNodes, err = r.withoutPlacedGPUs(ctx, cluster, gpuNodes, pods); err != nil {
+				log.Error(err, "unable to account for placed workloads", "cluster", cluster)
+				continue
+			}
+		}
+		requests, err := r.effectiveRequests(ctx, c, gw)
+		if err != nil {
+			log.Error(err, "unable to compute effective resource requests", "cluster", cluster)
+			continue
+		}
+		candidates, _ := fitNodes(gpuNodes, pods, requests, gpuResourceName(gw))
+		if len(candidates) == 0 {
+			continue
+		}
+
+		node, vendor, err := r.chooseNode(ctx, strategy, candidates, pods, gw)
+		if err != nil {
+			log.V(1).Info("No node fits in remote cluster", "cluster", cluster, "error", err)
+			continue
+		}
+		return clusterPlacement{cluster: cluster, node: node, vendor: vendor}, true
+	}
+	return clusterPlacement{}, false
+}
+
+// pollRemoteJob makes sure a workload whose Job runs in a remote cluster is reconciled again
+// within remoteJobPollInterval, since remote Jobs are not watched.
+func pollRemoteJob(gw *gpuv1alpha1.GPUWorkload, result ctrl.Result) ctrl.Result {
+	if gw.Status.Cluster != "" && (result.RequeueAfter == 0 || result.RequeueAfter > remoteJobPollInterval) {
+		result.RequeueAfter = remoteJobPollInterval
+	}
+	return result
+}
+
+// placedIn returns the workloads whose Jobs run in the named cluster.
+func placedIn(workloads []gpuv1alpha1.GPUWorkload, cluster string) []gpuv1alpha1.GPUWorkload {
+	var placed []gpuv1alpha1.GPUWorkload
+	for i := range workloads {
+		if workloads[i].Status.Cluster == cluster {
+			placed = append(placed, workloads[i])
+		}
+	}
+	return placed
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	nodev1 "k8s.io/api/node/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// newRemoteCluster returns a fake cluster named name holding objs.
+func newRemoteCluster(t *testing.T, name string, objs ...client.Object) RemoteCluster {
+	remote := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(objs...).
+		Build()
+	return RemoteCluster{Name: name, Client: remote}
+}
+
+func listClusterJobs(t *testing.T, c client.Client) []batchv1.Job {
+	t.Helper()
+	jobs := &batchv1.JobList{}
+	if err := c.List(context.Background(), jobs); err != nil {
+		t.Fatalf("unable to list jobs: %v", err)
+	}
+	return jobs.Items
+}
+
+func TestReconcile_AutoClusterBurstsToRemoteCluster(t *testing.T) {
+	gw := createTestWorkload("burst", 4)
+	gw.Spec.Cluster = ClusterAuto
+	local := createGPUNode("local-node", 2)
+	remoteNode := createGPUNode("remote-node", 8)
+
+	r := newTestReconciler(t, gw, &local)
+	remote := newRemoteCluster(t, "burst", &remoteNode)
+	r.RemoteClusters = []RemoteCluster{remote}
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled in the remote cluster, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.Cluster != "burst" || updated.Status.AssignedNode != "remote-node" {
+		t.Errorf("Expected placement on burst/remote-node, got %s/%s", updated.Status.Cluster, updated.Status.AssignedNode)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no local Job, got %d", len(jobs))
+	}
+	jobs := listClusterJobs(t, remote.Client)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 Job in the remote cluster, got %d", len(jobs))
+	}
+	if len(jobs[0].OwnerReferences) != 0 {
+		t.Errorf("Expected no owner references across clusters, got %v", jobs[0].OwnerReferences)
+	}
+
+	// The remote Job is not watched, so its progress is polled
+	result, _ := reconcileWorkload(t, r, updated)
+	if result.RequeueAfter == 0 || result.RequeueAfter > remoteJobPollInterval {
+		t.Errorf("Expected the remote Job to be polled within %v, got %v", remoteJobPollInterval, result.RequeueAfter)
+	}
+}
+
+func TestReconcile_AutoClusterPrefersLocalNodes(t *testing.T) {
+	gw := createTestWorkload("local-first", 2)
+	gw.Spec.Cluster = ClusterAuto
+	local := createGPUNode("local-node", 4)
+	remoteNode := createGPUNode("remote-node", 8)
+
+	r := newTestReconciler(t, gw, &local)
+	remote := newRemoteCluster(t, "burst", &remoteNode)
+	r.RemoteClusters = []RemoteCluster{remote}
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Cluster != "" || updated.Status.AssignedNode != "local-node" {
+		t.Errorf("Expected placement on the local node, got %q/%s", updated.Status.Cluster, updated.Status.AssignedNode)
+	}
+	if jobs := listClusterJobs(t, remote.Client); len(jobs) != 0 {
+		t.Errorf("Expected no remote Job, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_PinnedClusterUsesOnlyItsNodes(t *testing.T) {
+	gw := createTestWorkload("pinned", 2)
+	gw.Spec.Cluster = "burst"
+	local := createGPUNode("local-node", 8)
+	remoteNode := createGPUNode("remote-node", 4)
+
+	r := newTestReconciler(t, gw, &local)
+	remote := newRemoteCluster(t, "burst", &remoteNode)
+	r.RemoteClusters = []RemoteCluster{remote}
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Cluster != "burst" || updated.Status.AssignedNode != "remote-node" {
+		t.Errorf("Expected placement on burst/remote-node, got %q/%s", updated.Status.Cluster, updated.Status.AssignedNode)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no local Job, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_RemoteRuntimeClassIsReadFromItsCluster(t *testing.T) {
+	gw := createTestWorkload("sandboxed", 2)
+	gw.Spec.Cluster = "burst"
+	gw.Spec.RuntimeClassName = "kata"
+	remoteNode := createGPUNode("remote-node", 4)
+	// Only the remote cluster defines the RuntimeClass
+	kata := &nodev1.RuntimeClass{ObjectMeta: metav1.ObjectMeta{Name: "kata"}, Handler: "kata"}
+
+	r := newTestReconciler(t, gw)
+	r.RemoteClusters = []RemoteCluster{newRemoteCluster(t, "burst", &remoteNode, kata)}
+
+	if _, updated := reconcileWorkload(t, r, gw); updated.Status.Cluster != "burst" || updated.Status.AssignedNode != "remote-node" {
+		t.Errorf("Expected placement on burst/remote-node, got %q/%s: %s", updated.Status.Cluster, updated.Status.AssignedNode, updated.Status.Message)
+	}
+}
+
+func TestReconcile_UnknownClusterStaysPending(t *testing.T) {
+	gw := createTestWorkload("lost", 1)
+	gw.Spec.Cluster = "elsewhere"
+	local := createGPUNode("local-node", 8)
+
+	r := newTestReconciler(t, gw, &local)
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhasePending {
+		t.Fatalf("Expected Pending, got %s", updated.Status.Phase)
+	}
+	if !strings.Contains(updated.Status.Message, `unknown cluster "elsewhere"`) {
+		t.Errorf("Expected the message to name the unknown cluster, got %q", updated.Status.Message)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no Job, got %d", len(jobs))
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// updateStatus writes the workload's status, first deriving its conditions and completion
+// time from its phase and recording the spec generation the status reflects. Failed writes
+// are counted as status_update reconcile errors.
+func (r *GPUWorkloadReconciler) updateStatus(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) error {
+	setStatusConditions(gw)
+	warning := r.setStuckPendingCondition(gw)
+	r.setCompletionTime(gw)
+	if err := r.Status().Update(ctx, gw); err != nil {
+		recordReconcileError(metrics.ReconcileErrorStatusUpdate, err)
+		return err
+	}
+	if warning != "" {
+		r.Recorder.Event(gw, corev1.EventTypeWarning, stuckPendingReason, warning)
+	}
+	return nil
+}
+
+// setStatusConditions sets the Scheduled and Ready conditions from the workload's phase and
+// updates ObservedGeneration. Conditions carry the phase as their reason; the finer grained
+// reason stays in Status.Reason.
+func setStatusConditions(gw *gpuv1alpha1.GPUWorkload) {
+	phase := gw.Status.Phase
+	reason := string(phase)
+	if reason == "" {
+		reason = string(gpuv1alpha1.PhasePending)
+	}
+
+	scheduled := metav1.ConditionFalse
+	switch phase {
+	case gpuv1alpha1.PhaseScheduled, gpuv1alpha1.PhaseRunning, gpuv1alpha1.PhaseSucceeded:
+		scheduled = metav1.ConditionTrue
+	}
+	ready := metav1.ConditionFalse
+	if phase == gpuv1alpha1.PhaseRunning {
+		ready = metav1.ConditionTrue
+	}
+
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               gpuv1alpha1.ConditionScheduled,
+		Status:             scheduled,
+		ObservedGeneration: gw.Generation,
+		Reason:             reason,
+		Message:            gw.Status.Message,
+	})
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               gpuv1alpha1.ConditionReady,
+		Status:             ready,
+		ObservedGeneration: gw.Generation,
+		Reason:             reason,
+		Message:            gw.Status.Message,
+	})
+	gw.Status.ObservedGeneration = gw.Generation
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_SetsScheduledCondition(t *testing.T) {
+	gw := createTestWorkload("conditions", 2)
+	gw.Generation = 3
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.ObservedGeneration != 3 {
+		t.Errorf("Expected observedGeneration 3, got %d", updated.Status.ObservedGeneration)
+	}
+	scheduled := meta.FindStatusCondition(updated.Status.Conditions, gpuv1alpha1.ConditionScheduled)
+	if scheduled == nil || scheduled.Status != metav1.ConditionTrue || scheduled.ObservedGeneration != 3 {
+		t.Errorf("Expected a true Scheduled condition for generation 3, got %+v", scheduled)
+	}
+	if !meta.IsStatusConditionFalse(updated.Status.Conditions, gpuv1alpha1.ConditionReady) {
+		t.Errorf("Expected Ready to be false before the job runs, got %+v", updated.Status.Conditions)
+	}
+}
+
+func TestReconcile_PendingWorkloadIsNotScheduled(t *testing.T) {
+	gw := createTestWorkload("no-nodes", 2)
+	r := newTestReconciler(t, gw)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	scheduled := meta.FindStatusCondition(updated.Status.Conditions, gpuv1alpha1.ConditionScheduled)
+	if scheduled == nil || scheduled.Status != metav1.ConditionFalse || scheduled.Reason != string(gpuv1alpha1.PhasePending) {
+		t.Errorf("Expected a false Scheduled condition with reason Pending, got %+v", scheduled)
+	}
+}
+
+func TestSetStatusConditions_FollowsPhase(t *testing.T) {
+	gw := createTestWorkload("phases", 1)
+
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	setStatusConditions(gw)
+	transition := meta.FindStatusCondition(gw.Status.Conditions, gpuv1alpha1.ConditionScheduled).LastTransitionTime
+
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.Message = "Workload running on node node1"
+	setStatusConditions(gw)
+	if !meta.IsStatusConditionTrue(gw.Status.Conditions, gpuv1alpha1.ConditionReady) {
+		t.Errorf("Expected Ready while running, got %+v", gw.Status.Conditions)
+	}
+	scheduled := meta.FindStatusCondition(gw.Status.Conditions, gpuv1alpha1.ConditionScheduled)
+	if scheduled.Status != metav1.ConditionTrue || !scheduled.LastTransitionTime.Equal(&transition) {
+		t.Errorf("Expected Scheduled to stay true without a new transition, got %+v", scheduled)
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhaseFailed
+	setStatusConditions(gw)
+	for _, conditionType := range []string{gpuv1alpha1.ConditionScheduled, gpuv1alpha1.ConditionReady} {
+		condition := meta.FindStatusCondition(gw.Status.Conditions, conditionType)
+		if condition.Status != metav1.ConditionFalse || condition.Reason != string(gpuv1alpha1.PhaseFailed) {
+			t.Errorf("Expected %s to be false with reason Failed, got %+v", conditionType, condition)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// defaultCPUFallbackAfter is how long a workload waits for a GPU node before falling back to CPU.
+const defaultCPUFallbackAfter = 10 * time.Minute
+
+// cpuFallbackAllowed reports whether the workload may run on CPU at all. Gangs never fall
+// back, since a single CPU Job would run only part of them.
+func cpuFallbackAllowed(gw *gpuv1alpha1.GPUWorkload) bool {
+	return gw.Spec.AllowCPUFallback && gw.Spec.CPUFallbackImage != "" && replicaCount(gw) == 1 && !gw.Spec.DryRun
+}
+
+// cpuFallbackDue reports whether the workload has waited long enough for a GPU node to fall back to CPU.
+func (r *GPUWorkloadReconciler) cpuFallbackDue(gw *gpuv1alpha1.GPUWorkload) bool {
+	if !cpuFallbackAllowed(gw) {
+		return false
+	}
+	wait := defaultCPUFallbackAfter
+	if gw.Spec.CPUFallbackAfterSeconds != nil {
+		wait = time.Duration(*gw.Spec.CPUFallbackAfterSeconds) * time.Second
+	}
+	return r.now().Sub(gw.CreationTimestamp.Time) >= wait
+}
+
+// scheduleOnCPU creates a Job running the workload's CPU fallback image without GPUs and
+// records the degraded mode in status. The Job is left to the default scheduler.
+func (r *GPUWorkloadReconciler) scheduleOnCPU(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	job, err := r.createJobForWorkload(gw, "", nil, 0)
+	if err != nil {
+		log.Error(err, "failed to create CPU fallback job")
+		recordReconcileError(metrics.ReconcileErrorJobCreate, err)
+		return ctrl.Result{}, err
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.Reason = "cpu_fallback"
+	gw.Status.Message = fmt.Sprintf("No GPU node available; running CPU fallback image %s without GPUs", gw.Spec.CPUFallbackImage)
+	gw.Status.CPUFallback = true
+	gw.Status.JobName = job.Name
+	gw.Status.Placements++
+	gw.Status.ImagePullFailingSince = nil
+	gw.Status.AssignedNode = ""
+	gw.Status.Cluster = ""
+	gw.Status.AllocatedGPUCount = 0
+	gw.Status.AllocatedMemory = ""
+	gw.Status.AssignedNodeGPUInfo = nil
+	gw.Status.NodeLabels = nil
+	gw.Status.LastScheduleTime = &metav1.Time{Time: r.now()}
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("GPUWorkload fell back to CPU", "job", job.Name, "image", gw.Spec.CPUFallbackImage)
+	r.Recorder.Event(gw, corev1.EventTypeWarning, "CPUFallback", gw.Status.Message)
+	return ctrl.Result{}, nil
+}
+
+// cpuFallbackResources returns the workload's resources without any GPU request.
+func (r *GPUWorkloadReconciler) cpuFallbackResources(gw *gpuv1alpha1.GPUWorkload) corev1.ResourceRequirements {
+	resources := r.workloadResources(gw)
+	delete(resources.Requests, gpuResourceName(gw))
+	delete(resources.Limits, gpuResourceName(gw))
+	return resources
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func createFallbackWorkload(age time.Duration, now time.Time) *gpuv1alpha1.GPUWorkload {
+	gw := createTestWorkload("fallback", 2)
+	gw.CreationTimestamp = metav1.NewTime(now.Add(-age))
+	gw.Spec.AllowCPUFallback = true
+	gw.Spec.CPUFallbackImage = "example.com/model-cpu:latest"
+	gw.Spec.CPUFallbackAfterSeconds = int32Ptr(300)
+	return gw
+}
+
+func TestReconcile_FallsBackToCPUAfterGPUWait(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createFallbackWorkload(10*time.Minute, now)
+	cpuOnly := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cpu-node"}}
+
+	r := newTestReconciler(t, gw, &cpuOnly)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || !updated.Status.CPUFallback {
+		t.Fatalf("Expected the workload to be scheduled in CPU fallback mode, got phase %s cpuFallback %v",
+			updated.Status.Phase, updated.Status.CPUFallback)
+	}
+	if updated.Status.Reason != "cpu_fallback" || updated.Status.AllocatedGPUCount != 0 {
+		t.Errorf("Expected reason cpu_fallback and no allocated GPUs, got %q and %d", updated.Status.Reason, updated.Status.AllocatedGPUCount)
+	}
+
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected one fallback job, got %d", len(jobs))
+	}
+	podSpec := jobs[0].Spec.Template.Spec
+	if podSpec.NodeName != "" {
+		t.Errorf("Expected the fallback job to be left to the default scheduler, got node %q", podSpec.NodeName)
+	}
+	container := podSpec.Containers[0]
+	if container.Image != "example.com/model-cpu:latest" {
+		t.Errorf("Expected the fallback image, got %q", container.Image)
+	}
+	for _, resources := range []corev1.ResourceList{container.Resources.Requests, container.Resources.Limits} {
+		if _, ok := resources["nvidia.com/gpu"]; ok {
+			t.Errorf("Expected no GPU request in the fallback job, got %v", resources)
+		}
+	}
+}
+
+func TestReconcile_WaitsForGPUBeforeFallback(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createFallbackWorkload(time.Minute, now)
+
+	r := newTestReconciler(t, gw)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.CPUFallback {
+		t.Errorf("Expected the workload to keep waiting for a GPU node, got phase %s cpuFallback %v",
+			updated.Status.Phase, updated.Status.CPUFallback)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no job before the GPU wait timeout, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_FallsBackToCPUWhenRetriesExhausted(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createFallbackWorkload(time.Minute, now)
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	gw.Status.RetryCount = 3
+
+	r := newTestReconciler(t, gw)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || !updated.Status.CPUFallback {
+		t.Errorf("Expected exhausted retries to fall back to CPU instead of failing, got phase %s", updated.Status.Phase)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// autoscalerDeletionTaint is set by cluster-autoscaler on nodes it is draining for removal.
+const autoscalerDeletionTaint = "ToBeDeletedByClusterAutoscaler"
+
+// NodeSnapshot describes a GPU node as seen by the controller.
+type NodeSnapshot struct {
+	Name            string `json:"name"`
+	Ready           bool   `json:"ready"`
+	AllocatableGPUs int64  `json:"allocatableGPUs"`
+	AvailableGPUs   int64  `json:"availableGPUs"`
+	Cordoned        bool   `json:"cordoned"`
+	Draining        bool   `json:"draining"`
+	ControlPlane    bool   `json:"controlPlane"`
+	Eligible        bool   `json:"eligible"`
+}
+
+// DebugHandler returns an HTTP handler exposing the controller's view of the cluster
+// for troubleshooting. It is only served when a debug bind address is configured, and
+// requests must carry the token as a bearer token.
+func (r *GPUWorkloadReconciler) DebugHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/nodes", r.serveNodeSnapshot)
+	mux.HandleFunc("/debug/queue", r.serveSchedulingQueue)
+	mux.HandleFunc("/debug/latency", r.serveSchedulingLatency)
+	return requireBearerToken(token, mux)
+}
+
+// serveNodeSnapshot writes the eligibility snapshot of every GPU node as JSON.
+func (r *GPUWorkloadReconciler) serveNodeSnapshot(w http.ResponseWriter, req *http.Request) {
+	nodes := &corev1.NodeList{}
+	if err := r.List(req.Context(), nodes); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	eligible := map[string]bool{}
+	for _, node := range r.filterGPUNodes(nodes.Items) {
+		eligible[node.Name] = true
+	}
+
+	snapshot := []NodeSnapshot{}
+	for _, node := range nodes.Items {
+		if !hasGPUs(&node) {
+			continue
+		}
+		snapshot = append(snapshot, NodeSnapshot{
+			Name:            node.Name,
+			Ready:           isNodeReady(&node),
+			AllocatableGPUs: allocatableGPUs(&node),
+			AvailableGPUs:   r.capacity().AvailableGPUs(&node),
+			Cordoned:        node.Spec.Unschedulable,
+			Draining:        hasTaint(&node, autoscalerDeletionTaint),
+			ControlPlane:    isControlPlaneNode(&node),
+			Eligible:        eligible[node.Name],
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(snapshot); err != nil {
+		r.Log.Error(err, "unable to encode node snapshot")
+	}
+}
+
+func allocatableGPUs(node *corev1.Node) int64 {
+	for _, vendor := range scheduling.DefaultVendorPreference {
+		resource, _ := scheduling.VendorResourceName(vendor)
+		if quantity, ok := node.Status.Allocatable[resource]; ok && quantity.Value() > 0 {
+			return quantity.Value()
+		}
+	}
+	return 0
+}
+
+func hasTaint(node *corev1.Node, key string) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == key {
+			return true
+		}
+	}
+	return false
+}
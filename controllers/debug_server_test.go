@@ -0,0 +1,97 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// getDebug issues an authorized GET for the debug path.
+func getDebug(r *GPUWorkloadReconciler, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	rec := httptest.NewRecorder()
+	r.DebugHandler("s3cret").ServeHTTP(rec, req)
+	return rec
+}
+
+func TestDebugHandler_RequiresToken(t *testing.T) {
+	r := newTestReconciler(t)
+	for _, header := range []string{"", "Bearer wrong"} {
+		req := httptest.NewRequest(http.MethodGet, "/debug/nodes", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		r.DebugHandler("s3cret").ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("Expected status 401 for Authorization %q, got %d", header, rec.Code)
+		}
+	}
+}
+
+func TestDebugHandler_NodeSnapshot(t *testing.T) {
+	ready := createGPUNode("gpu-ready", 4)
+
+	cordoned := createGPUNode("gpu-cordoned", 2)
+	cordoned.Spec.Unschedulable = true
+	cordoned.Spec.Taints = []corev1.Taint{{Key: autoscalerDeletionTaint, Effect: corev1.TaintEffectNoSchedule}}
+
+	notReady := createGPUNode("gpu-not-ready", 8)
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+
+	cpuOnly := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cpu-only"}}
+
+	r := newTestReconciler(t, &ready, &cordoned, &notReady, &cpuOnly)
+
+	rec := getDebug(r, "/debug/nodes")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var snapshot []NodeSnapshot
+	if err := json.Unmarshal(rec.Body.Bytes(), &snapshot); err != nil {
+		t.Fatalf("unable to decode snapshot: %v", err)
+	}
+
+	byName := map[string]NodeSnapshot{}
+	for _, node := range snapshot {
+		byName[node.Name] = node
+	}
+	if len(byName) != 3 {
+		t.Fatalf("Expected 3 GPU nodes in snapshot, got %v", snapshot)
+	}
+	if _, ok := byName["cpu-only"]; ok {
+		t.Error("Expected node without GPUs to be omitted")
+	}
+
+	if got := byName["gpu-ready"]; !got.Ready || !got.Eligible || got.AllocatableGPUs != 4 || got.AvailableGPUs != 4 {
+		t.Errorf("Unexpected snapshot for gpu-ready: %+v", got)
+	}
+	if got := byName["gpu-cordoned"]; !got.Cordoned || !got.Draining {
+		t.Errorf("Expected gpu-cordoned to be cordoned and draining: %+v", got)
+	}
+	if got := byName["gpu-not-ready"]; got.Ready || got.Eligible {
+		t.Errorf("Expected gpu-not-ready to be not ready and ineligible: %+v", got)
+	}
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// DefragmentAnnotation, set to "true" on a node, asks the defragmenter to move every
+// workload off it. Remove it once the node is no longer to be drained.
+const DefragmentAnnotation = "gpu-orchestrator/defragment"
+
+// DefragMode selects which nodes the defragmenter drains.
+type DefragMode string
+
+const (
+	// DefragOff disables the defragmenter.
+	DefragOff DefragMode = "off"
+
+	// DefragManual only drains nodes carrying the DefragmentAnnotation.
+	DefragManual DefragMode = "manual"
+
+	// DefragAuto also consolidates the least used GPU node onto nodes already in use.
+	DefragAuto DefragMode = "auto"
+)
+
+// defragMove relocates a placed workload to a target node.
+type defragMove struct {
+	workload *gpuv1alpha1.GPUWorkload
+	target   string
+}
+
+// defragmenter periodically consolidates placed workloads onto fewer nodes. Each pass
+// drains at most one node, and only when every workload on it allows defragmentation, fits
+// elsewhere and no PodDisruptionBudget forbids evicting it. Moved workloads return to Pending with the
+// target as their nominated node. It implements manager.Runnable.
+type defragmenter struct {
+	reconciler *GPUWorkloadReconciler
+	log        logr.Logger
+	period     time.Duration
+	mode       DefragMode
+	events     chan<- event.GenericEvent
+}
+
+// Start runs the defragmentation loop until the context is cancelled.
+func (d *defragmenter) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := d.runOnce(ctx); err != nil {
+			d.log.Error(err, "defragmentation pass aborted")
+		}
+	}
+}
+
+// runOnce drains the first source node whose workloads can all be moved safely.
+func (d *defragmenter) runOnce(ctx context.Context) error {
+	r := d.reconciler
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return err
+	}
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return err
+	}
+
+	placed := map[string][]*gpuv1alpha1.GPUWorkload{}
+	for i := range workloads.Items {
+		gw := &workloads.Items[i]
+		if gw.DeletionTimestamp != nil || gw.Status.AssignedNode == "" || gw.Status.Cluster != "" {
+			continue
+		}
+		if gw.Status.Phase == gpuv1alpha1.PhaseScheduled || gw.Status.Phase == gpuv1alpha1.PhaseRunning {
+			placed[gw.Status.AssignedNode] = append(placed[gw.Status.AssignedNode], gw)
+		}
+	}
+
+	eligible := r.filterGPUNodes(nodes.Items)
+	pods, err := nodePods(ctx, r.Client, eligible)
+	if err != nil {
+		return err
+	}
+	withFree, err := r.withoutPlacedGPUs(ctx, "", eligible, pods)
+	if err != nil {
+		return err
+	}
+	capacity := pods.Capacity(r.capacity())
+	free := make(map[string]int64, len(withFree))
+	for i := range withFree {
+		free[withFree[i].Name] = capacity.AvailableGPUs(&withFree[i])
+	}
+
+	for _, source := range d.sources(nodes.Items, eligible, placed) {
+		moves, ok := d.plan(source, placed[source.Name], eligible, free, placed)
+		if !ok {
+			continue
+		}
+		blocked, err := r.disruptionBlocked(ctx, moves)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			d.log.Info("Not draining node, a PodDisruptionBudget forbids evicting its workloads", "node", source.Name)
+			continue
+		}
+		return d.execute(ctx, source.Name, moves)
+	}
+	return nil
+}
+
+// sources returns the nodes to try draining, in order: annotated nodes first, then in auto
+// mode the eligible nodes holding the fewest placed GPUs.
+func (d *defragmenter) sources(nodes, eligible []corev1.Node, placed map[string][]*gpuv1alpha1.GPUWorkload) []corev1.Node {
+	var requested, automatic []corev1.Node
+	for _, node := range nodes {
+		if len(placed[node.Name]) > 0 && node.Annotations[DefragmentAnnotation] == "true" {
+			requested = append(requested, node)
+		}
+	}
+
+	if d.mode == DefragAuto {
+		for _, node := range eligible {
+			if len(placed[node.Name]) > 0 && node.Annotations[DefragmentAnnotation] != "true" {
+				automatic = append(automatic, node)
+			}
+		}
+		sort.SliceStable(automatic, func(i, j int) bool {
+			gi, gj := totalPlacedGPUs(placed[automatic[i].Name]), totalPlacedGPUs(placed[automatic[j].Name])
+			if gi != gj {
+				return gi < gj
+			}
+			return automatic[i].Name < automatic[j].Name
+		})
+	}
+	return append(requested, automatic...)
+}
+
+// plan assigns every workload on the source node to a target, largest first, choosing the
+// fitting target with the least free GPUs. In auto mode only nodes already in use are
+// targets, so consolidation never spreads work onto empty nodes. It returns false when any
+// workload cannot be moved or does not allow defragmentation.
+func (d *defragmenter) plan(source corev1.Node, workloads []*gpuv1alpha1.GPUWorkload, eligible []corev1.Node, free map[string]int64, placed map[string][]*gpuv1alpha1.GPUWorkload) ([]defragMove, bool) {
+	var targets []*corev1.Node
+	for i := range eligible {
+		target := &eligible[i]
+		if target.Name == source.Name || target.Annotations[DefragmentAnnotation] == "true" {
+			continue
+		}
+		if d.mode == DefragAuto && len(placed[target.Name]) == 0 {
+			continue
+		}
+		if nodeGPUVendor(target) != nodeGPUVendor(&source) {
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	ordered := make([]*gpuv1alpha1.GPUWorkload, len(workloads))
+	copy(ordered, workloads)
+	sort.SliceStable(ordered, func(i, j int) bool { return placedGPUs(ordered[i]) > placedGPUs(ordered[j]) })
+
+	remaining := make(map[string]int64, len(targets))
+	for _, target := range targets {
+		remaining[target.Name] = free[target.Name]
+	}
+
+	moves := make([]defragMove, 0, len(ordered))
+	for _, gw := range ordered {
+		// MIG slices are accounted per profile, not in whole GPUs, and gang replicas span
+		// nodes; leave those workloads alone
+		if !gw.Spec.AllowDefragmentation || gw.Spec.MIGProfile != "" || len(gw.Status.ReplicaNodes) > 1 {
+			return nil, false
+		}
+		gpus := int64(placedGPUs(gw))
+		best := ""
+		for _, target := range targets {
+			left := remaining[target.Name]
+			if left < gpus || !matchesNodeSelector(target, gw) {
+				continue
+			}
+			if best == "" || left < remaining[best] || (left == remaining[best] && target.Name < best) {
+				best = target.Name
+			}
+		}
+		if best == "" {
+			return nil, false
+		}
+		remaining[best] -= gpus
+		moves = append(moves, defragMove{workload: gw, target: best})
+	}
+
+	return moves, true
+}
+
+// disruptionBlocked reports whether evicting the moved workloads' pods would exceed the
+// disruptions allowed by any PodDisruptionBudget selecting them.
+func (r *GPUWorkloadReconciler) disruptionBlocked(ctx context.Context, moves []defragMove) (bool, error) {
+	allowed := map[types.NamespacedName]int32{}
+	budgets := map[string][]policyv1.PodDisruptionBudget{}
+
+	for _, move := range moves {
+		gw := move.workload
+		if gw.Status.JobName == "" {
+			continue
+		}
+		if _, ok := budgets[gw.Namespace]; !ok {
+			pdbs := &policyv1.PodDisruptionBudgetList{}
+			if err := r.List(ctx, pdbs, client.InNamespace(gw.Namespace)); err != nil {
+				return false, err
+			}
+			budgets[gw.Namespace] = pdbs.Items
+			for _, pdb := range pdbs.Items {
+				allowed[types.NamespacedName{Namespace: pdb.Namespace, Name: pdb.Name}] = pdb.Status.DisruptionsAllowed
+			}
+		}
+		if len(budgets[gw.Namespace]) == 0 {
+			continue
+		}
+
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: gw.Status.JobName, Namespace: gw.Namespace}}
+		pods, err := r.workloadPods(ctx, r.Client, job)
+		if err != nil {
+			return false, err
+		}
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodRunning && pod.Status.Phase != corev1.PodPending {
+				continue
+			}
+			for _, pdb := range budgets[gw.Namespace] {
+				selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+				if err != nil || selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+					continue
+				}
+				key := types.NamespacedName{Namespace: pdb.Namespace, Name: pdb.Name}
+				if allowed[key] < 1 {
+					return true, nil
+				}
+				allowed[key]--
+			}
+		}
+	}
+	return false, nil
+}
+
+// execute releases each workload for rescheduling onto its target. It stops at the first
+// failure, leaving the remaining workloads where they are.
+func (d *defragmenter) execute(ctx context.Context, source string, moves []defragMove) error {
+	r := d.reconciler
+	d.log.Info("Draining node to defragment GPUs", "node", source, "workloads", len(moves))
+
+	for _, move := range moves {
+		gw := move.workload
+		log := d.log.WithValues("gpuworkload", client.ObjectKeyFromObject(gw), "target", move.target)
+
+		gw.Status.NominatedNode = move.target
+		message := fmt.Sprintf("Moved off node %s to consolidate GPUs onto %s", source, move.target)
+		if err := r.releasePlacement(ctx, log, gw, "defragmented", message); err != nil {
+			return err
+		}
+		r.Recorder.Event(gw, corev1.EventTypeNormal, "Defragmented", message)
+		if d.events != nil {
+			select {
+			case d.events <- event.GenericEvent{Object: gw}:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// totalPlacedGPUs sums the GPUs held by the workloads.
+func totalPlacedGPUs(workloads []*gpuv1alpha1.GPUWorkload) int64 {
+	var total int64
+	for _, gw := range workloads {
+		total += int64(placedGPUs(gw))
+	}
+	return total
+}
+
+// nodeGPUVendor returns the vendor of the GPUs a node advertises, or "" if it has none.
+func nodeGPUVendor(node *corev1.Node) string {
+	for _, vendor := range scheduling.DefaultVendorPreference {
+		if scheduling.VendorGPUs(node, vendor) > 0 {
+			return vendor
+		}
+	}
+	return ""
+}
+
+// findNode returns the node with the given name, or nil.
+func findNode(nodes []corev1.Node, name string) *corev1.Node {
+	if name == "" {
+		return nil
+	}
+	for i := range nodes {
+		if nodes[i].Name == name {
+			return &nodes[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// createPlacedWorkload returns a Running workload on the node together with its Job and pod.
+// The workload allows the defragmenter to move it.
+func createPlacedWorkload(name, node string, gpus int32) (*gpuv1alpha1.GPUWorkload, *batchv1.Job, *corev1.Pod) {
+	gw := createTestWorkload(name, gpus)
+	gw.Spec.AllowDefragmentation = true
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.AssignedNode = node
+	gw.Status.AllocatedGPUCount = gpus
+	gw.Status.JobName = name + "-job"
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: gw.Status.JobName, Namespace: gw.Namespace}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gw.Status.JobName + "-pod",
+			Namespace: gw.Namespace,
+			Labels:    map[string]string{"job-name": job.Name, "app": gw.Spec.ModelName},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: workloadContainerName,
+				Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					"nvidia.com/gpu": *resource.NewQuantity(int64(gpus), resource.DecimalSI),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	return gw, job, pod
+}
+
+func newDefragTestReconciler(t *testing.T, extra ...client.Object) *GPUWorkloadReconciler {
+	t.Helper()
+	nodeA := createGPUNode("node-a", 4)
+	nodeB := createGPUNode("node-b", 4)
+	wa, jobA, podA := createPlacedWorkload("wa", "node-a", 2)
+	wb, jobB, podB := createPlacedWorkload("wb", "node-b", 2)
+	objs := append([]client.Object{&nodeA, &nodeB, wa, jobA, podA, wb, jobB, podB}, extra...)
+	return newTestReconciler(t, objs...)
+}
+
+func getWorkload(t *testing.T, r *GPUWorkloadReconciler, name string) *gpuv1alpha1.GPUWorkload {
+	t.Helper()
+	gw := &gpuv1alpha1.GPUWorkload{}
+	if err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: name}, gw); err != nil {
+		t.Fatalf("unable to fetch GPUWorkload %s: %v", name, err)
+	}
+	return gw
+}
+
+func TestDefragmenter_ConsolidatesHalfFullNodes(t *testing.T) {
+	r := newDefragTestReconciler(t)
+	d := &defragmenter{reconciler: r, log: r.Log, mode: DefragAuto}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+
+	moved := getWorkload(t, r, "wa")
+	if moved.Status.Phase != gpuv1alpha1.PhasePending || moved.Status.Reason != "defragmented" {
+		t.Fatalf("Expected wa to be released for rescheduling, got phase %s reason %q", moved.Status.Phase, moved.Status.Reason)
+	}
+	if moved.Status.NominatedNode != "node-b" {
+		t.Errorf("Expected wa to be nominated onto node-b, got %q", moved.Status.NominatedNode)
+	}
+	err := r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "wa-job"}, &batchv1.Job{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("Expected wa's job to be deleted, got %v", err)
+	}
+	if stayed := getWorkload(t, r, "wb"); stayed.Status.Phase != gpuv1alpha1.PhaseRunning || stayed.Status.AssignedNode != "node-b" {
+		t.Errorf("Expected wb to keep running on node-b, got %s on %q", stayed.Status.Phase, stayed.Status.AssignedNode)
+	}
+
+	_, rescheduled := reconcileWorkload(t, r, moved)
+	if rescheduled.Status.AssignedNode != "node-b" || rescheduled.Status.NominatedNode != "" {
+		t.Errorf("Expected wa to be placed on its nominated node-b, got %q (nominated %q)",
+			rescheduled.Status.AssignedNode, rescheduled.Status.NominatedNode)
+	}
+}
+
+func TestDefragmenter_LeavesWorkloadsThatDoNotFit(t *testing.T) {
+	nodeA := createGPUNode("node-a", 4)
+	nodeB := createGPUNode("node-b", 4)
+	wa, jobA, podA := createPlacedWorkload("wa", "node-a", 3)
+	wb, jobB, podB := createPlacedWorkload("wb", "node-b", 3)
+	r := newTestReconciler(t, &nodeA, &nodeB, wa, jobA, podA, wb, jobB, podB)
+	d := &defragmenter{reconciler: r, log: r.Log, mode: DefragAuto}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	for _, name := range []string{"wa", "wb"} {
+		if gw := getWorkload(t, r, name); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			t.Errorf("Expected %s to keep running, got %s", name, gw.Status.Phase)
+		}
+	}
+}
+
+func TestDefragmenter_LeavesNodesWithWorkloadsThatDidNotOptIn(t *testing.T) {
+	r := newDefragTestReconciler(t)
+	for _, name := range []string{"wa", "wb"} {
+		gw := getWorkload(t, r, name)
+		gw.Spec.AllowDefragmentation = false
+		if err := r.Update(context.Background(), gw); err != nil {
+			t.Fatalf("unable to update GPUWorkload %s: %v", name, err)
+		}
+	}
+	d := &defragmenter{reconciler: r, log: r.Log, mode: DefragAuto}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	for _, name := range []string{"wa", "wb"} {
+		if gw := getWorkload(t, r, name); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			t.Errorf("Expected %s to keep running without allowDefragmentation, got %s", name, gw.Status.Phase)
+		}
+	}
+}
+
+func TestDefragmenter_CountsPodsHoldingGPUs(t *testing.T) {
+	// A GPU pod the controller did not create fills the rest of node-b
+	other := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: "node-b",
+			Containers: []corev1.Container{{
+				Name: "other",
+				Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					"nvidia.com/gpu": *resource.NewQuantity(2, resource.DecimalSI),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	r := newDefragTestReconciler(t, other)
+	d := &defragmenter{reconciler: r, log: r.Log, mode: DefragAuto}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if gw := getWorkload(t, r, "wa"); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Errorf("Expected wa to keep running, node-b has no free GPUs, got %s nominated %q", gw.Status.Phase, gw.Status.NominatedNode)
+	}
+}
+
+func TestDefragmenter_RespectsPodDisruptionBudgets(t *testing.T) {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-model", Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test-model"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+	}
+	r := newDefragTestReconciler(t, pdb)
+	d := &defragmenter{reconciler: r, log: r.Log, mode: DefragAuto}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	for _, name := range []string{"wa", "wb"} {
+		if gw := getWorkload(t, r, name); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			t.Errorf("Expected the disruption budget to keep %s running, got %s", name, gw.Status.Phase)
+		}
+	}
+}
+
+func TestDefragmenter_ManualModeOnlyDrainsAnnotatedNodes(t *testing.T) {
+	r := newDefragTestReconciler(t)
+	d := &defragmenter{reconciler: r, log: r.Log, mode: DefragManual}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if gw := getWorkload(t, r, "wa"); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Fatalf("Expected no moves without an annotated node, got wa %s", gw.Status.Phase)
+	}
+
+	node := &corev1.Node{}
+	if err := r.Get(context.Background(), client.ObjectKey{Name: "node-b"}, node); err != nil {
+		t.Fatalf("unable to fetch node: %v", err)
+	}
+	node.Annotations = map[string]string{DefragmentAnnotation: "true"}
+	if err := r.Update(context.Background(), node); err != nil {
+		t.Fatalf("unable to annotate node: %v", err)
+	}
+
+	if err := d.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if gw := getWorkload(t, r, "wb"); gw.Status.Phase != gpuv1alpha1.PhasePending || gw.Status.NominatedNode != "node-a" {
+		t.Errorf("Expected wb to be moved off the annotated node-b onto node-a, got %s nominated %q", gw.Status.Phase, gw.Status.NominatedNode)
+	}
+	if gw := getWorkload(t, r, "wa"); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Errorf("Expected wa to keep running, got %s", gw.Status.Phase)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// recordDryRunPlacement reports where a dry-run workload would be placed, with placement
+// being the workload as it was placed, and moves it to Simulated without creating its Jobs.
+func (r *GPUWorkloadReconciler) recordDryRunPlacement(ctx context.Context, log logr.Logger, gw, placement *gpuv1alpha1.GPUWorkload, strategy, cluster string, nodes []*corev1.Node) (ctrl.Result, error) {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhaseSimulated
+	gw.Status.AssignedNode = names[0]
+	gw.Status.ReplicaNodes = nil
+	if len(names) > 1 {
+		gw.Status.ReplicaNodes = names
+	}
+	gw.Status.Cluster = cluster
+	gw.Status.NominatedNode = ""
+	gw.Status.LastScheduleTime = &metav1.Time{Time: r.now()}
+	gw.Status.Reason = ""
+	gw.Status.LastBackoff = nil
+	gw.Status.AllocatedGPUCount = placement.RequestedGPUCount()
+	gw.Status.Strategy = strategy
+	gw.Status.GPUVendor = placement.Spec.GPUVendor
+	gw.Status.Message = fmt.Sprintf("Dry run: would be scheduled on node %s using %s strategy", strings.Join(names, ", "), strategy)
+	if cluster != "" {
+		gw.Status.Message = fmt.Sprintf("Dry run: would be scheduled on node %s of cluster %s using %s strategy", names[0], cluster, strategy)
+	}
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Computed dry-run placement", "nodes", names, "strategy", strategy)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "DryRunPlacement", gw.Status.Message)
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_DryRunComputesPlacementWithoutJobs(t *testing.T) {
+	gw := createTestWorkload("dry-run", 2)
+	gw.Spec.DryRun = true
+	small := createGPUNode("small", 2)
+	large := createGPUNode("large", 8)
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw, &small, &large)
+	r.Recorder = recorder
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseSimulated {
+		t.Fatalf("Expected Simulated, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.AssignedNode != large.Name || updated.Status.JobName != "" {
+		t.Errorf("Expected a placement on %s without a job, got %q/%q", large.Name, updated.Status.AssignedNode, updated.Status.JobName)
+	}
+	if event := recorder.find("DryRunPlacement"); event == nil || event.message != updated.Status.Message {
+		t.Errorf("Expected a DryRunPlacement event with the status message, got %+v", recorder.events)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Fatalf("Expected no jobs for a dry run, got %d", len(jobs))
+	}
+
+	// The dry run is not placed again
+	if _, updated = reconcileWorkload(t, r, updated); updated.Status.Phase != gpuv1alpha1.PhaseSimulated {
+		t.Errorf("Expected the workload to stay Simulated, got %s", updated.Status.Phase)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs after another reconcile, got %d", len(jobs))
+	}
+
+	// Clearing DryRun places the workload for real
+	updated.Spec.DryRun = false
+	if err := r.Update(context.Background(), updated); err != nil {
+		t.Fatalf("Failed to update workload: %v", err)
+	}
+	if _, updated = reconcileWorkload(t, r, updated); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled once DryRun is cleared, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 1 {
+		t.Errorf("Expected 1 job once DryRun is cleared, got %d", len(jobs))
+	}
+}
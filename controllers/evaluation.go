@@ -0,0 +1,231 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+	"github.com/reyisjones/GPU_Orchestrator/internal/sizing"
+)
+
+// maxEvaluationBodyBytes caps the size of the spec posted to the evaluation endpoint.
+const maxEvaluationBodyBytes = 1 << 20
+
+// Evaluation reports whether a hypothetical workload would be scheduled on the local
+// cluster right now, under each strategy.
+type Evaluation struct {
+	// GPUCount is the GPUs each replica requests, inferred from modelSizeGB when not given.
+	GPUCount int32 `json:"gpuCount"`
+
+	// Replicas is the number of replicas placed as a gang.
+	Replicas int32 `json:"replicas"`
+
+	// GPUNodes is the number of Ready GPU nodes matching the node selector.
+	GPUNodes int `json:"gpuNodes"`
+
+	// CandidateNodes is the number of those nodes with room for the pod's CPU and memory.
+	CandidateNodes int `json:"candidateNodes"`
+
+	// Strategies holds the outcome of each evaluated strategy.
+	Strategies []StrategyEvaluation `json:"strategies"`
+}
+
+// StrategyEvaluation is the outcome of placing a hypothetical workload with one strategy.
+type StrategyEvaluation struct {
+	Strategy    string   `json:"strategy"`
+	Schedulable bool     `json:"schedulable"`
+	Nodes       []string `json:"nodes,omitempty"`
+	GPUVendor   string   `json:"gpuVendor,omitempty"`
+	Reason      string   `json:"reason,omitempty"`
+	Message     string   `json:"message,omitempty"`
+}
+
+// EvaluationHandler returns an HTTP handler that evaluates a GPUWorkloadSpec posted as JSON
+// to /evaluate against the current capacity of the local cluster, under every strategy or
+// only those named by strategy query parameters, without creating anything. The namespace
+// query parameter sets the workload's namespace, default by default. Requests must carry
+// the token as a bearer token.
+func (r *GPUWorkloadReconciler) EvaluationHandler(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/evaluate", r.serveEvaluation)
+	return requireBearerToken(token, mux)
+}
+
+// requireBearerToken rejects requests whose Authorization header does not carry the token.
+// An empty token rejects every request.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		presented, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" || subtle.ConstantTimeCompare([]byte(presented), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// serveEvaluation decodes the posted spec, evaluates it and writes the Evaluation as JSON.
+func (r *GPUWorkloadReconciler) serveEvaluation(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	spec := gpuv1alpha1.GPUWorkloadSpec{}
+	decoder := json.NewDecoder(io.LimitReader(req.Body, maxEvaluationBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&spec); err != nil {
+		http.Error(w, fmt.Sprintf("invalid GPUWorkloadSpec: %v", err), http.StatusBadRequest)
+		return
+	}
+	namespace := req.URL.Query().Get("namespace")
+	if namespace == "" {
+		namespace = "default"
+	}
+	gw := &gpuv1alpha1.GPUWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: "evaluation", Namespace: namespace, UID: "evaluation"},
+		Spec:       spec,
+	}
+	gw.Default()
+	if _, err := gw.ValidateCreate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if gw.Spec.GPUCount == 0 {
+		gw.Status.InferredGPUCount = sizing.InferGPUCount(gw.Spec.ModelName, gw.Spec.ModelSizeGB, r.GPUMemoryGB, r.ModelGPUCounts)
+		if gw.Status.InferredGPUCount == 0 {
+			http.Error(w, "either gpuCount or modelSizeGB must be specified", http.StatusBadRequest)
+			return
+		}
+		if gw.Status.InferredGPUCount > gpuv1alpha1.MaxGPUCount {
+			http.Error(w, inferredGPUCountOutOfRange(gw.Status.InferredGPUCount), http.StatusBadRequest)
+			return
+		}
+	}
+
+	strategies := req.URL.Query()["strategy"]
+	if len(strategies) == 0 {
+		strategies = scheduling.StrategyNames
+	}
+	evaluation, err := r.evaluate(req.Context(), gw, strategies)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(evaluation); err != nil {
+		r.Log.Error(err, "unable to encode evaluation")
+	}
+}
+
+// evaluate places the workload with each of the named strategies the way Reconcile would on
+// the local cluster, from the same node list, bound pods and in-flight placements, without
+// recording anything.
+func (r *GPUWorkloadReconciler) evaluate(ctx context.Context, gw *gpuv1alpha1.GPUWorkload, strategies []string) (*Evaluation, error) {
+	if gpuCount := r.roundedGPUCount(gw.RequestedGPUCount()); gpuCount != gw.RequestedGPUCount() {
+		gw.Spec.GPUCount = gpuCount
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("unable to list nodes: %w", err)
+	}
+	gpuNodes := r.filterGPUNodes(nodes.Items)
+	pods, err := nodePods(ctx, r.Client, gpuNodes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list pods: %w", err)
+	}
+	if r.serializePlacements() {
+		if gpuNodes, err = r.withoutPlacedGPUs(ctx, "", gpuNodes, pods); err != nil {
+			return nil, fmt.Errorf("unable to account for placed workloads: %w", err)
+		}
+	}
+	gpuNodes = selectedNodes(gpuNodes, gw)
+	requests, err := r.effectiveRequests(ctx, r.Client, gw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compute effective resource requests: %w", err)
+	}
+	candidates, _ := fitNodes(gpuNodes, pods, requests, gpuResourceName(gw))
+
+	evaluation := &Evaluation{
+		GPUCount:       gw.RequestedGPUCount(),
+		Replicas:       replicaCount(gw),
+		GPUNodes:       len(gpuNodes),
+		CandidateNodes: len(candidates),
+	}
+	for _, name := range strategies {
+		evaluation.Strategies = append(evaluation.Strategies, r.evaluateStrategy(ctx, name, gw, gpuNodes, candidates, pods))
+	}
+	return evaluation, nil
+}
+
+// evaluateStrategy places the workload on the candidates with the named strategy.
+func (r *GPUWorkloadReconciler) evaluateStrategy(ctx context.Context, name string, gw *gpuv1alpha1.GPUWorkload, gpuNodes, candidates []corev1.Node, pods scheduling.NodePods) StrategyEvaluation {
+	result := StrategyEvaluation{Strategy: name}
+	if !containsString(scheduling.StrategyNames, name) {
+		result.Reason, result.Message = "unknown_strategy", fmt.Sprintf("unknown strategy %q", name)
+		return result
+	}
+	strategy, err := scheduling.Factory(name, r.Log, r.strategyOptions())
+	if err != nil {
+		result.Reason, result.Message = "evaluation_error", err.Error()
+		return result
+	}
+
+	switch name {
+	case "fairShare":
+		usage, err := r.namespaceUsage(ctx)
+		if err != nil {
+			result.Reason, result.Message = "evaluation_error", err.Error()
+			return result
+		}
+		ctx = scheduling.WithNamespaceUsage(ctx, usage)
+	case "spread":
+		placements, err := r.modelPlacements(ctx, gw)
+		if err != nil {
+			result.Reason, result.Message = "evaluation_error", err.Error()
+			return result
+		}
+		ctx = scheduling.WithModelPlacements(ctx, placements)
+	}
+
+	selected, vendor, err := r.chooseNodes(ctx, strategy, candidates, pods, gw, int(replicaCount(gw)))
+	if err != nil {
+		result.Reason, result.Message = schedulingFailure(err, len(gpuNodes), len(candidates))
+		return result
+	}
+	result.Schedulable = true
+	result.GPUVendor = vendor
+	for _, node := range selected {
+		result.Nodes = append(result.Nodes, node.Name)
+	}
+	return result
+}
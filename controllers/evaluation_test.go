@@ -0,0 +1,141 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// postEvaluation posts the spec to the evaluation endpoint with the token.
+func postEvaluation(t *testing.T, r *GPUWorkloadReconciler, token, query, spec string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/evaluate"+query, strings.NewReader(spec))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	r.EvaluationHandler("s3cret").ServeHTTP(rec, req)
+	return rec
+}
+
+func TestEvaluationHandler_EvaluatesEachStrategy(t *testing.T) {
+	small := createGPUNode("small", 4)
+	large := createGPUNode("large", 8)
+	r := newTestReconciler(t, &small, &large)
+
+	rec := postEvaluation(t, r, "s3cret", "?strategy=leastLoaded&strategy=binPack",
+		`{"modelName": "llama2", "gpuCount": 2}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var evaluation Evaluation
+	if err := json.Unmarshal(rec.Body.Bytes(), &evaluation); err != nil {
+		t.Fatalf("unable to decode evaluation: %v", err)
+	}
+	if evaluation.GPUCount != 2 || evaluation.GPUNodes != 2 || len(evaluation.Strategies) != 2 {
+		t.Fatalf("Unexpected evaluation: %+v", evaluation)
+	}
+	for _, tt := range []struct {
+		strategy, node string
+	}{
+		{"leastLoaded", "large"},
+		{"binPack", "small"},
+	} {
+		var result *StrategyEvaluation
+		for i := range evaluation.Strategies {
+			if evaluation.Strategies[i].Strategy == tt.strategy {
+				result = &evaluation.Strategies[i]
+			}
+		}
+		if result == nil || !result.Schedulable || len(result.Nodes) != 1 || result.Nodes[0] != tt.node {
+			t.Errorf("Expected %s to place the workload on %s, got %+v", tt.strategy, tt.node, result)
+		}
+	}
+
+	// Nothing is created or recorded
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs, got %d", len(jobs))
+	}
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(context.Background(), workloads); err != nil || len(workloads.Items) != 0 {
+		t.Errorf("Expected no workloads, got %v (%v)", workloads.Items, err)
+	}
+}
+
+func TestEvaluationHandler_ReportsUnschedulableWorkloads(t *testing.T) {
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, &node)
+
+	rec := postEvaluation(t, r, "s3cret", "", `{"modelName": "llama2", "gpuCount": 6}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var evaluation Evaluation
+	if err := json.Unmarshal(rec.Body.Bytes(), &evaluation); err != nil {
+		t.Fatalf("unable to decode evaluation: %v", err)
+	}
+	if len(evaluation.Strategies) != len(gpuv1alpha1.SchedulingStrategies) {
+		t.Errorf("Expected every strategy to be evaluated, got %d", len(evaluation.Strategies))
+	}
+	for _, result := range evaluation.Strategies {
+		if result.Schedulable || result.Reason == "" {
+			t.Errorf("Expected %s to report the workload unschedulable, got %+v", result.Strategy, result)
+		}
+	}
+}
+
+func TestEvaluationHandler_RejectsInvalidRequests(t *testing.T) {
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, &node)
+
+	tests := []struct {
+		name  string
+		token string
+		spec  string
+		code  int
+	}{
+		{"missing token", "", `{"modelName": "llama2", "gpuCount": 1}`, http.StatusUnauthorized},
+		{"wrong token", "guess", `{"modelName": "llama2", "gpuCount": 1}`, http.StatusUnauthorized},
+		{"malformed JSON", "s3cret", `{"modelName": `, http.StatusBadRequest},
+		{"unknown field", "s3cret", `{"modelName": "llama2", "gpus": 1}`, http.StatusBadRequest},
+		{"invalid spec", "s3cret", `{"gpuCount": 1}`, http.StatusBadRequest},
+		{"no GPU count", "s3cret", `{"modelName": "custom-model"}`, http.StatusBadRequest},
+		{"inferred GPU count out of range", "s3cret", `{"modelName": "custom-model", "modelSizeGB": 1000}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rec := postEvaluation(t, r, tt.token, "", tt.spec); rec.Code != tt.code {
+				t.Errorf("Expected status %d, got %d: %s", tt.code, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/evaluate", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	r.EvaluationHandler("s3cret").ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405 for GET, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// noGPUNodesRecheckInterval is how long a workload waits while the cluster has no ready GPU
+// nodes. Missing nodes usually have to be added by an autoscaler or an operator, so they are
+// checked for less eagerly than full nodes free up.
+const noGPUNodesRecheckInterval = 2 * time.Minute
+
+// recordFailedAttempt returns the workload to Pending after a failed scheduling attempt,
+// counting a retry and tallying the attempt under reason.
+func recordFailedAttempt(gw *gpuv1alpha1.GPUWorkload, reason, message string) {
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	gw.Status.Reason = reason
+	gw.Status.Message = message
+	gw.Status.RetryCount++
+	if gw.Status.FailureReasons == nil {
+		gw.Status.FailureReasons = map[string]int32{}
+	}
+	gw.Status.FailureReasons[reason]++
+	if m := metrics.GetMetrics(); m != nil {
+		m.RecordRetry()
+		m.RecordSchedulingFailure(reason)
+	}
+}
+
+// schedulingFailure returns the reason and status message for a strategy that placed no
+// workload on the given number of GPU nodes, of which candidates had room for the pod's CPU
+// and memory. GPU nodes left out for lack of CPU or memory are full too.
+func schedulingFailure(err error, gpuNodes, candidates int) (string, string) {
+	reason, ok := scheduling.FailureReason(err)
+	if !ok {
+		return "no_suitable_node", err.Error()
+	}
+	if reason == scheduling.FailureNoGPUNodes && candidates == 0 && gpuNodes > 0 {
+		reason = scheduling.FailureAllNodesFull
+	}
+	if reason == scheduling.FailureAllNodesFull {
+		return reason, fmt.Sprintf("All %d GPU nodes are at capacity: %v", gpuNodes, err)
+	}
+	return reason, fmt.Sprintf("No GPU nodes to schedule on: %v", err)
+}
+
+// dominantFailureReason returns the reason most scheduling attempts of the workload failed
+// for and how many did, preferring the alphabetically first reason on a tie. It returns an
+// empty reason when no failure was tallied.
+func dominantFailureReason(gw *gpuv1alpha1.GPUWorkload) (string, int32) {
+	var dominant string
+	var count int32
+	for reason, n := range gw.Status.FailureReasons {
+		if n > count || (n == count && reason < dominant) {
+			dominant, count = reason, n
+		}
+	}
+	return dominant, count
+}
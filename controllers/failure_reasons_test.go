@@ -0,0 +1,115 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_MaxRetriesReportsDominantFailureReason(t *testing.T) {
+	// The first attempt finds a node but cannot create its Job; another pod then fills the node
+	gw := createTestWorkload("mixed-failures", 2)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+	r.Client = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if _, ok := obj.(*batchv1.Job); ok {
+				return errors.New("admission webhook denied the request")
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	})
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Reason != "job_creation_failed" {
+		t.Fatalf("Expected reason job_creation_failed, got %q", updated.Status.Reason)
+	}
+	if err := r.Create(context.Background(), createGPUPod("other-team", node.Name, 4)); err != nil {
+		t.Fatalf("unable to create pod: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		_, updated = reconcileWorkload(t, r, updated)
+	}
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed {
+		t.Fatalf("Expected Failed, got %s", updated.Status.Phase)
+	}
+	if updated.Status.Reason != "all_nodes_full" {
+		t.Errorf("Expected the dominant reason all_nodes_full, got %q", updated.Status.Reason)
+	}
+	if want := "failed: all_nodes_full (2/3 attempts)"; updated.Status.Message != want {
+		t.Errorf("Expected message %q, got %q", want, updated.Status.Message)
+	}
+	if got := updated.Status.FailureReasons; got["job_creation_failed"] != 1 || got["all_nodes_full"] != 2 {
+		t.Errorf("Unexpected failure tally %v", got)
+	}
+}
+
+func TestDominantFailureReason(t *testing.T) {
+	gw := createTestWorkload("tally", 1)
+	if reason, count := dominantFailureReason(gw); reason != "" || count != 0 {
+		t.Errorf("Expected no reason without failures, got %q (%d)", reason, count)
+	}
+
+	gw.Status.FailureReasons = map[string]int32{"no_suitable_node": 2, "job_creation_failed": 2, "other": 1}
+	if reason, count := dominantFailureReason(gw); reason != "job_creation_failed" || count != 2 {
+		t.Errorf("Expected ties to go to the alphabetically first reason, got %q (%d)", reason, count)
+	}
+}
+
+func TestReconcile_DistinguishesFullNodesFromMissingNodes(t *testing.T) {
+	// Without GPU nodes the workload waits longer, without using up its retries
+	gw := createTestWorkload("no-nodes", 2)
+	r := newTestReconciler(t, gw)
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Reason != "no_gpu_nodes" || updated.Status.RetryCount != 0 {
+		t.Errorf("Expected no_gpu_nodes without a retry, got %q after %d retries", updated.Status.Reason, updated.Status.RetryCount)
+	}
+	if result.RequeueAfter != noGPUNodesRecheckInterval {
+		t.Errorf("Expected a requeue after %v, got %v", noGPUNodesRecheckInterval, result.RequeueAfter)
+	}
+
+	// GPU nodes that are all too small are full
+	gw = createTestWorkload("full", 4)
+	node := createGPUNode("node1", 2)
+	r = newTestReconciler(t, gw, &node)
+	_, updated = reconcileWorkload(t, r, gw)
+	if updated.Status.Reason != "all_nodes_full" || updated.Status.RetryCount != 1 {
+		t.Errorf("Expected all_nodes_full with a retry, got %q after %d retries", updated.Status.Reason, updated.Status.RetryCount)
+	}
+	if !strings.HasPrefix(updated.Status.Message, "All 1 GPU nodes are at capacity") {
+		t.Errorf("Unexpected message %q", updated.Status.Message)
+	}
+
+	// No node of the pinned vendor exists at all
+	gw = createTestWorkload("no-vendor", 1)
+	gw.Spec.GPUVendor = "amd"
+	r = newTestReconciler(t, gw, &node)
+	_, updated = reconcileWorkload(t, r, gw)
+	if updated.Status.Reason != "no_gpu_nodes" {
+		t.Errorf("Expected no_gpu_nodes without AMD nodes, got %q: %s", updated.Status.Reason, updated.Status.Message)
+	}
+}
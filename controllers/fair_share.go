@@ -0,0 +1,50 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// namespaceUsage returns the GPUs the placed workloads of each namespace hold, across clusters.
+func (r *GPUWorkloadReconciler) namespaceUsage(ctx context.Context) (scheduling.NamespaceUsage, error) {
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return nil, err
+	}
+	return namespaceGPUUsage(workloads.Items), nil
+}
+
+// namespaceGPUUsage sums the GPUs held by every replica of the Scheduled and Running
+// workloads by namespace. Workloads running their CPU fallback hold none.
+func namespaceGPUUsage(workloads []gpuv1alpha1.GPUWorkload) scheduling.NamespaceUsage {
+	usage := scheduling.NamespaceUsage{}
+	for i := range workloads {
+		gw := &workloads[i]
+		if gw.Status.Phase != gpuv1alpha1.PhaseScheduled && gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			continue
+		}
+		if gw.Status.CPUFallback {
+			continue
+		}
+		usage[gw.Namespace] += int64(placedGPUs(gw)) * int64(len(placedNodes(gw)))
+	}
+	return usage
+}
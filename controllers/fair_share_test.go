@@ -0,0 +1,69 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestNamespaceGPUUsage(t *testing.T) {
+	running := createRunningWorkload("running", "normal", "node1", 2)
+	gang := createRunningWorkload("gang", "normal", "node1", 2)
+	gang.Namespace = "team-b"
+	gang.Status.ReplicaNodes = []string{"node1", "node2", "node3"}
+	fallback := createRunningWorkload("fallback", "normal", "cpu-node", 4)
+	fallback.Status.CPUFallback = true
+	pending := createTestWorkload("pending", 8)
+	pending.Status.Phase = gpuv1alpha1.PhasePending
+
+	usage := namespaceGPUUsage([]gpuv1alpha1.GPUWorkload{*running, *gang, *fallback, *pending})
+	if len(usage) != 2 || usage["default"] != 2 || usage["team-b"] != 6 {
+		t.Errorf("Expected 2 GPUs in default and 6 in team-b, got %v", usage)
+	}
+}
+
+func TestReconcile_FairSharePacksNamespaceOverItsShare(t *testing.T) {
+	large := createGPUNode("large", 8)
+	small := createGPUNode("small", 4)
+	// default already holds 4 of the 12 GPUs and team-b holds 2, so default's share is 6
+	held := createRunningWorkload("held", "normal", "elsewhere", 4)
+	other := createRunningWorkload("other", "normal", "elsewhere", 2)
+	other.Namespace = "team-b"
+
+	gw := createTestWorkload("greedy", 3)
+	gw.Spec.SchedulingStrategy = "fairShare"
+	r := newTestReconciler(t, gw, held, other, &large, &small)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.AssignedNode != small.Name {
+		t.Errorf("Expected the over-share namespace to be packed onto the small node, got %q: %s",
+			updated.Status.AssignedNode, updated.Status.Message)
+	}
+
+	newcomer := createTestWorkload("newcomer", 3)
+	newcomer.Namespace = "team-c"
+	newcomer.Spec.SchedulingStrategy = "fairShare"
+	r = newTestReconciler(t, newcomer, held, other, &large, &small)
+
+	_, updated = reconcileWorkload(t, r, newcomer)
+	if updated.Status.AssignedNode != large.Name {
+		t.Errorf("Expected a namespace within its share on the least loaded node, got %q: %s",
+			updated.Status.AssignedNode, updated.Status.Message)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// setCompletionTime records when the workload reached Succeeded or Failed, and clears it
+// once the workload is back in progress.
+func (r *GPUWorkloadReconciler) setCompletionTime(gw *gpuv1alpha1.GPUWorkload) {
+	switch gw.Status.Phase {
+	case gpuv1alpha1.PhaseSucceeded, gpuv1alpha1.PhaseFailed:
+		if gw.Status.CompletionTime == nil {
+			gw.Status.CompletionTime = &metav1.Time{Time: r.now()}
+		}
+	default:
+		gw.Status.CompletionTime = nil
+	}
+}
+
+// finishedTTLRemaining returns how long until a finished workload is deleted, and false when
+// it is kept: it has no TTL or no recorded completion time.
+func (r *GPUWorkloadReconciler) finishedTTLRemaining(gw *gpuv1alpha1.GPUWorkload) (time.Duration, bool) {
+	if gw.Spec.TTLSecondsAfterFinished == nil || gw.Status.CompletionTime == nil {
+		return 0, false
+	}
+	ttl := time.Duration(*gw.Spec.TTLSecondsAfterFinished) * time.Second
+	return gw.Status.CompletionTime.Add(ttl).Sub(r.now()), true
+}
+
+// finishedResult returns the result of a reconcile that finished the workload, requeueing
+// it for its deletion when it has a TTL.
+func (r *GPUWorkloadReconciler) finishedResult(gw *gpuv1alpha1.GPUWorkload) ctrl.Result {
+	if remaining, ok := r.finishedTTLRemaining(gw); ok {
+		return ctrl.Result{RequeueAfter: max(remaining, 0)}
+	}
+	return ctrl.Result{}
+}
+
+// cleanUpFinished deletes a finished workload once its TTLSecondsAfterFinished has passed.
+// The finalizer deletes its Jobs as for any other deleted workload. Workloads that finished
+// before completion times were recorded start their TTL now.
+func (r *GPUWorkloadReconciler) cleanUpFinished(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	if gw.Spec.TTLSecondsAfterFinished == nil {
+		return ctrl.Result{}, nil
+	}
+	if gw.Status.CompletionTime == nil {
+		if err := r.updateStatus(ctx, gw); err != nil {
+			log.Error(err, "unable to update GPUWorkload status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	remaining, _ := r.finishedTTLRemaining(gw)
+	if remaining > 0 {
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Info("Deleting finished GPUWorkload after its TTL", "phase", gw.Status.Phase, "completionTime", gw.Status.CompletionTime)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "TTLExpired", "Deleting the finished workload after its ttlSecondsAfterFinished")
+	if err := r.Delete(ctx, gw, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		log.Error(err, "unable to delete finished GPUWorkload")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
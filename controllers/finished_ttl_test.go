@@ -0,0 +1,110 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_DeletesFinishedWorkloadAfterTTL(t *testing.T) {
+	gw := createTestWorkload("ttl", 1)
+	gw.Spec.TTLSecondsAfterFinished = int32Ptr(60)
+	job, pod := createFinishedJob(gw, batchv1.JobComplete, 0)
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = job.Name
+
+	r := newTestReconciler(t, gw, job, pod)
+	finished := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakePassiveClock(finished)
+	r.Clock = fakeClock
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseSucceeded {
+		t.Fatalf("Expected Succeeded, got %s", updated.Status.Phase)
+	}
+	if updated.Status.CompletionTime == nil || !updated.Status.CompletionTime.Time.Equal(finished) {
+		t.Errorf("Expected completion time %v, got %v", finished, updated.Status.CompletionTime)
+	}
+	if result.RequeueAfter != time.Minute {
+		t.Errorf("Expected a requeue at the 1m TTL, got %v", result.RequeueAfter)
+	}
+
+	fakeClock.SetTime(finished.Add(45 * time.Second))
+	result, updated = reconcileWorkload(t, r, updated)
+	if !updated.DeletionTimestamp.IsZero() || result.RequeueAfter != 15*time.Second {
+		t.Errorf("Expected the workload to be kept for another 15s, got deletion %v after %v", updated.DeletionTimestamp, result.RequeueAfter)
+	}
+
+	// Once the TTL passes the workload is deleted, and its finalizer deletes the Job
+	fakeClock.SetTime(finished.Add(time.Minute))
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.DeletionTimestamp.IsZero() {
+		t.Fatal("Expected the workload to be deleted after its TTL")
+	}
+	key := types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := r.Get(context.Background(), key, &gpuv1alpha1.GPUWorkload{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the workload to be gone, got %v", err)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected the job to be deleted with the workload, got %d jobs", len(jobs))
+	}
+}
+
+func TestReconcile_KeepsFinishedWorkloadWithoutTTL(t *testing.T) {
+	gw := createTestWorkload("no-ttl", 1)
+	job, pod := createFinishedJob(gw, batchv1.JobFailed, 1)
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = job.Name
+
+	r := newTestReconciler(t, gw, job, pod)
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.CompletionTime == nil {
+		t.Fatalf("Expected Failed with a completion time, got %s/%v", updated.Status.Phase, updated.Status.CompletionTime)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("Expected no requeue without a TTL, got %v", result.RequeueAfter)
+	}
+}
+
+func TestSetCompletionTime_ClearedWhenRetried(t *testing.T) {
+	r := newTestReconciler(t)
+	gw := createTestWorkload("retried", 1)
+	gw.Status.Phase = gpuv1alpha1.PhaseFailed
+	r.setCompletionTime(gw)
+	if gw.Status.CompletionTime == nil {
+		t.Fatal("Expected a completion time once Failed")
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	r.setCompletionTime(gw)
+	if gw.Status.CompletionTime != nil {
+		t.Errorf("Expected the completion time to be cleared when Pending again, got %v", gw.Status.CompletionTime)
+	}
+}
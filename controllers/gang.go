@@ -0,0 +1,112 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// replicaLabel is the Job label holding the replica index of a gang-scheduled workload.
+const replicaLabel = "gpu.warp.dev/replica"
+
+// replicaCount returns the number of replicas the workload runs, at least 1.
+func replicaCount(gw *gpuv1alpha1.GPUWorkload) int32 {
+	if gw.Spec.Replicas > 1 {
+		return gw.Spec.Replicas
+	}
+	return 1
+}
+
+// replicaJobName returns the name of the Job running the given replica in the workload's next
+// placement. The first placement keeps the name single-replica workloads have always used;
+// later ones carry the placement count, so Jobs of an earlier placement that are still being
+// deleted or have finished are never adopted.
+func replicaJobName(gw *gpuv1alpha1.GPUWorkload, replica int32) string {
+	name := fmt.Sprintf("%s-job-%s", gw.Name, gw.UID[:8])
+	if gw.Status.Placements > 0 {
+		name = fmt.Sprintf("%s-p%d", name, gw.Status.Placements)
+	}
+	if replica > 0 {
+		name = fmt.Sprintf("%s-r%d", name, replica)
+	}
+	return name
+}
+
+// placedNodes returns the nodes the workload's replicas were placed on.
+func placedNodes(gw *gpuv1alpha1.GPUWorkload) []string {
+	if len(gw.Status.ReplicaNodes) > 0 {
+		return gw.Status.ReplicaNodes
+	}
+	return []string{gw.Status.AssignedNode}
+}
+
+// createReplicaJobs creates the Job of each replica on its node in the named cluster. When a
+// Job cannot be created, the Jobs already created are deleted again so a gang never runs
+// partially.
+func (r *GPUWorkloadReconciler) createReplicaJobs(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, cluster string, nodes []*corev1.Node) ([]*batchv1.Job, error) {
+	jobs := make([]*batchv1.Job, 0, len(nodes))
+	for i, node := range nodes {
+		job, err := r.createJobForWorkload(gw, cluster, node, int32(i))
+		if err != nil {
+			if len(jobs) > 0 && !r.Simulate {
+				if cleanupErr := r.deleteJobs(ctx, gw, cluster, jobs); cleanupErr != nil {
+					log.Error(cleanupErr, "unable to delete the jobs of a partially created gang")
+				}
+			}
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// deleteJobs deletes the workload's Jobs in the named cluster. Jobs that are already gone are ignored.
+func (r *GPUWorkloadReconciler) deleteJobs(ctx context.Context, gw *gpuv1alpha1.GPUWorkload, cluster string, jobs []*batchv1.Job) error {
+	c, err := r.clusterClient(cluster)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if err := c.Delete(ctx, job, jobDeleteOptions(gw)...); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placedJobs returns references to the Jobs created for the workload's current placement,
+// one per replica.
+func placedJobs(gw *gpuv1alpha1.GPUWorkload) []*batchv1.Job {
+	if gw.Status.JobName == "" {
+		return nil
+	}
+	jobs := []*batchv1.Job{{ObjectMeta: metav1.ObjectMeta{Name: gw.Status.JobName, Namespace: gw.Namespace}}}
+	for i := 1; i < len(gw.Status.ReplicaNodes); i++ {
+		name := fmt.Sprintf("%s-r%d", gw.Status.JobName, i)
+		jobs = append(jobs, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: gw.Namespace}})
+	}
+	return jobs
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_GangSchedulesEveryReplica(t *testing.T) {
+	gw := createTestWorkload("gang", 2)
+	gw.Spec.Replicas = 3
+	node1, node2 := createGPUNode("node1", 4), createGPUNode("node2", 2)
+	r := newTestReconciler(t, gw, &node1, &node2)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if len(updated.Status.ReplicaNodes) != 3 || updated.Status.ReplicaNodes[0] != updated.Status.AssignedNode {
+		t.Errorf("Expected 3 replica nodes starting with the assigned node, got %v", updated.Status.ReplicaNodes)
+	}
+	if updated.Status.JobName != "gang-job-"+string(updated.UID[:8]) {
+		t.Errorf("Expected JobName to be replica 0's job, got %s", updated.Status.JobName)
+	}
+
+	jobs := listJobs(t, r)
+	if len(jobs) != 3 {
+		t.Fatalf("Expected a job per replica, got %d", len(jobs))
+	}
+	for _, job := range jobs {
+		index := job.Labels[replicaLabel]
+		env := map[string]string{}
+		for _, e := range job.Spec.Template.Spec.Containers[0].Env {
+			env[e.Name] = e.Value
+		}
+		if env["REPLICA_INDEX"] != index || env["REPLICA_COUNT"] != "3" {
+			t.Errorf("Job %s: expected REPLICA_INDEX %s and REPLICA_COUNT 3, got %v", job.Name, index, env)
+		}
+	}
+
+	// Releasing the placement deletes every replica's Job
+	if err := r.releasePlacement(context.Background(), logr.Discard(), updated, "test", "released"); err != nil {
+		t.Fatalf("releasePlacement() error = %v", err)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected every replica's job to be deleted, got %d", len(jobs))
+	}
+	if updated.Status.ReplicaNodes != nil {
+		t.Errorf("Expected replica nodes to be cleared, got %v", updated.Status.ReplicaNodes)
+	}
+}
+
+func TestReconcile_GangWaitsForEveryReplicaToFit(t *testing.T) {
+	gw := createTestWorkload("gang", 2)
+	gw.Spec.Replicas = 3
+	node1, node2 := createGPUNode("node1", 4), createGPUNode("node2", 1)
+	r := newTestReconciler(t, gw, &node1, &node2)
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "insufficient_gang_capacity" {
+		t.Errorf("Expected Pending with insufficient_gang_capacity, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if updated.Status.RetryCount != 1 || result.RequeueAfter == 0 {
+		t.Errorf("Expected a retry with backoff, got retry count %d and requeue after %v", updated.Status.RetryCount, result.RequeueAfter)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs for a partial gang, got %d", len(jobs))
+	}
+}
+
+func TestQuotaExceeded_CountsEveryReplica(t *testing.T) {
+	gw := createTestWorkload("gang", 2)
+	gw.Spec.Replicas = 3
+	r := newTestReconciler(t, gw, createGPUQuota("default", 4, 0))
+
+	if _, exceeded, err := r.quotaExceeded(context.Background(), "", gw); err != nil || !exceeded {
+		t.Errorf("Expected 3 replicas of 2 GPUs to exceed a quota of 4, got %v, %v", exceeded, err)
+	}
+}
+
+// createPlacedGang returns a Running two-replica gang whose Job of replica 0 is still active.
+func createPlacedGang(name string) (*gpuv1alpha1.GPUWorkload, *batchv1.Job) {
+	gw := createTestWorkload(name, 1)
+	gw.Spec.Replicas = 2
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = name + "-job"
+	gw.Status.AssignedNode = "node-a"
+	gw.Status.ReplicaNodes = []string{"node-a", "node-b"}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: gw.Status.JobName, Namespace: gw.Namespace}}
+	return gw, job
+}
+
+func TestReconcile_GangFailsWhenAnyReplicaJobFails(t *testing.T) {
+	gw, job := createPlacedGang("gang")
+	replica := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-r1", Namespace: gw.Namespace},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+		},
+	}
+
+	r := newTestReconciler(t, gw, job, replica)
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed {
+		t.Fatalf("Expected Failed once a replica's Job failed, got %s", updated.Status.Phase)
+	}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(job), &batchv1.Job{}); err == nil {
+		t.Error("Expected the Job of the active replica to be deleted to release its GPUs")
+	}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(replica), &batchv1.Job{}); err != nil {
+		t.Errorf("Expected the failed Job to be kept, got err = %v", err)
+	}
+}
+
+func TestReconcile_GangSucceedsOnceEveryReplicaCompletes(t *testing.T) {
+	gw, job := createPlacedGang("gang")
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	replica := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-r1", Namespace: gw.Namespace}}
+
+	r := newTestReconciler(t, gw, job, replica)
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Fatalf("Expected Running while a replica's Job is active, got %s", updated.Status.Phase)
+	}
+
+	replica.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := r.Status().Update(context.Background(), replica); err != nil {
+		t.Fatalf("unable to update job: %v", err)
+	}
+	if _, updated = reconcileWorkload(t, r, updated); updated.Status.Phase != gpuv1alpha1.PhaseSucceeded {
+		t.Errorf("Expected Succeeded once every replica's Job completed, got %s", updated.Status.Phase)
+	}
+}
@@ -19,23 +19,40 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/approval"
 	"github.com/reyisjones/GPU_Orchestrator/internal/backoff"
 	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/notification"
+	"github.com/reyisjones/GPU_Orchestrator/internal/queue"
 	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+	"github.com/reyisjones/GPU_Orchestrator/internal/sizing"
+	"github.com/reyisjones/GPU_Orchestrator/internal/timewindow"
 )
 
 const (
@@ -44,6 +61,15 @@ const (
 
 	// ownershipAnnotation marks which controller created a job
 	ownershipAnnotation = "gpu.warp.dev/created-by"
+
+	// approvalRecheckInterval is how often unapproved workloads ask for approval again
+	approvalRecheckInterval = 30 * time.Second
+
+	// quotaRecheckInterval is how often workloads held back by a namespace quota check it again
+	quotaRecheckInterval = 30 * time.Second
+
+	// defaultWorkloadImage is the placeholder image used when a workload does not specify one
+	defaultWorkloadImage = "python:3.11-slim"
 )
 
 // GPUWorkloadReconciler reconciles a GPUWorkload object
@@ -52,6 +78,208 @@ type GPUWorkloadReconciler struct {
 	Log      logr.Logger
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+
+	// APIReader reads directly from the API server, bypassing the cache, where a stale read
+	// would lead to a wrong decision. Defaults to the cached client when nil.
+	APIReader client.Reader
+
+	// GPUMemoryGB is the per-GPU memory assumed when inferring GPU counts from ModelSizeGB.
+	GPUMemoryGB int32
+
+	// ModelGPUCounts maps known model names to the GPU count they require.
+	ModelGPUCounts map[string]int32
+
+	// AllowControlPlaneNodes disables the built-in filter that keeps workloads off control-plane nodes.
+	AllowControlPlaneNodes bool
+
+	// IgnoredPressureConditions lists the node pressure conditions, of MemoryPressure,
+	// DiskPressure and PIDPressure, that do not keep workloads off a node reporting them.
+	IgnoredPressureConditions []corev1.NodeConditionType
+
+	// PendingResyncPeriod is the interval over which pending workloads are re-enqueued
+	// in jittered batches. Zero disables the periodic resync.
+	PendingResyncPeriod time.Duration
+
+	// PendingResyncBatchSize is the maximum number of pending workloads enqueued together.
+	PendingResyncBatchSize int
+
+	// StrategyBenchmarkPeriod is the interval at which every strategy is timed over the
+	// current GPU nodes and exported as a metric. Zero disables the self-benchmark.
+	StrategyBenchmarkPeriod time.Duration
+
+	// DefragMode enables the defragmenter, which moves workloads off annotated nodes and, in
+	// auto mode, consolidates lightly used nodes. Empty or DefragOff disables it.
+	DefragMode DefragMode
+
+	// DefragPeriod is the interval between defragmentation passes.
+	DefragPeriod time.Duration
+
+	// MigrationPeriod is the interval between passes moving Running workloads that allow
+	// migration to better nodes. Zero disables migration.
+	MigrationPeriod time.Duration
+
+	// MigrationMinImprovement is how many more GPUs a node must leave free around a workload
+	// than its current node for the workload to migrate to it. Defaults to 2.
+	MigrationMinImprovement int64
+
+	// CanaryStrategy is a strategy being rolled out. CanaryPercent of workloads, chosen by a
+	// hash of their UID, are scheduled with it instead of their own strategy.
+	CanaryStrategy string
+
+	// CanaryPercent is the percentage, 0 to 100, of workloads routed to CanaryStrategy.
+	CanaryPercent int
+
+	// StrategyOptions configures the scheduling strategies. The zero value keeps their defaults.
+	StrategyOptions scheduling.Options
+
+	// CapacityProvider reports the GPUs available on each node to the strategies, the
+	// rejection summary, the defragmenter and the debug endpoints. It overrides
+	// StrategyOptions.Capacity. Defaults to the nodes' allocatable GPUs when nil.
+	CapacityProvider scheduling.CapacityProvider
+
+	// RemoteClusters are secondary clusters workloads can be placed in through spec.cluster.
+	RemoteClusters []RemoteCluster
+
+	// MaintenanceWindow pauses new placements while the current time is inside it.
+	MaintenanceWindow timewindow.Schedule
+
+	// GPUVendorPreference is the order in which vendors are tried for workloads requesting
+	// the "auto" GPU vendor. Defaults to scheduling.DefaultVendorPreference when empty.
+	GPUVendorPreference []string
+
+	// NamespaceSelector restricts scheduling to namespaces whose labels match it,
+	// such as "gpu.warp.dev/approved=true". Nil or empty allows every namespace.
+	NamespaceSelector labels.Selector
+
+	// Approver gates scheduling on an external approval service. Nil disables the gate.
+	Approver approval.Approver
+
+	// Notifier is told when a workload's Job finishes. Nil disables notifications.
+	Notifier notification.Notifier
+
+	// NotificationLogLines is how many trailing lines of the workload container's logs,
+	// read from LogSource, notifications include. Zero includes none.
+	NotificationLogLines int64
+
+	// LogSource reads pod logs for notifications.
+	LogSource notification.LogSource
+
+	// RequireImageDigest forces imagePullPolicy Always on workload containers.
+	// The validating webhook rejects images that are not pinned by digest.
+	RequireImageDigest bool
+
+	// ImagePullFailureThreshold is how long a workload's pod may fail to pull its image
+	// before the workload is failed. Defaults to two minutes when zero.
+	ImagePullFailureThreshold time.Duration
+
+	// PhaseTransitionDelay is how long a Job must keep reporting that it finished before the
+	// workload moves to Succeeded or Failed, so a condition flapping during pod restarts does
+	// not flip the phase back and forth. Zero transitions as soon as the Job finishes.
+	PhaseTransitionDelay time.Duration
+
+	// SerializePlacements holds a global lock from node listing through job creation and
+	// counts GPUs allocated to placed workloads against node capacity, preventing concurrent
+	// workers from oversubscribing a node. It reduces scheduling throughput.
+	SerializePlacements bool
+
+	// placementMu is held during placement when SerializePlacements or PriorityOrdering is set,
+	// or MaxActiveJobs caps the Jobs.
+	placementMu sync.Mutex
+
+	// PriorityOrdering places pending workloads in queue order, by priority then creation
+	// time: a workload waits while one ahead of it fits on a node both may use. It implies
+	// SerializePlacements.
+	PriorityOrdering bool
+
+	// pendingQueue orders the workloads waiting to be placed when PriorityOrdering is set.
+	pendingQueue     *queue.Queue
+	pendingQueueOnce sync.Once
+
+	// queueEvents wakes the workload ahead of a queued one.
+	queueEvents chan event.GenericEvent
+
+	// PlacementDebounce is the minimum interval between two placement attempts of the same
+	// workload. Reconciles arriving sooner are requeued for the remainder instead of listing
+	// nodes again. Zero disables the debounce.
+	PlacementDebounce time.Duration
+
+	// pendingNamespaces tracks the namespaces reported by the pending workloads gauge.
+	pendingNamespaces pendingNamespaces
+
+	// debouncer tracks the last placement attempt of each workload for PlacementDebounce.
+	debouncer placementDebouncer
+
+	// latencies holds the recent node selection latencies of each strategy for the debug endpoint.
+	latencies schedulingLatencies
+
+	// PropagatedNodeLabels are the keys of the node labels copied into the status of the
+	// workloads placed on the node. Only these keys are copied, bounding the status size.
+	PropagatedNodeLabels []string
+
+	// Simulate builds Jobs without creating them and advances placed workloads through
+	// Running to Succeeded on a timer, for load-testing the controller without GPUs.
+	Simulate bool
+
+	// GPURequestRounding rounds the GPU count requested by each workload, e.g. up to pairs
+	// of GPUs. The zero value leaves GPU counts unchanged.
+	GPURequestRounding sizing.Rounding
+
+	// MemoryRequestRounding rounds the memory requested by each workload, in bytes. The zero
+	// value leaves memory requests unchanged.
+	MemoryRequestRounding sizing.Rounding
+
+	// CPUPerGPU and MemoryPerGPU are the CPU and memory requested per GPU by high and normal
+	// priority workloads that do not set their own. Zero values request 4 CPUs and 16Gi.
+	CPUPerGPU    resource.Quantity
+	MemoryPerGPU resource.Quantity
+
+	// MaxConcurrentPerModel is the most workloads of the same ModelName that may be Scheduled or
+	// Running at once; further workloads stay pending. Zero leaves models unlimited.
+	MaxConcurrentPerModel int32
+
+	// ModelConcurrencyLimits overrides MaxConcurrentPerModel for individual models. A limit of
+	// 0 leaves the model unlimited.
+	ModelConcurrencyLimits map[string]int32
+
+	// MaxActiveJobs is the most unfinished Jobs the controller may manage at once; further
+	// workloads stay pending with reason max_active_jobs. Zero leaves Jobs unlimited.
+	MaxActiveJobs int
+
+	// StuckPendingThreshold is how long a workload may stay pending without a placement before
+	// its StuckPending condition is set and a warning event is emitted, repeated each time it
+	// stays pending that much longer. Zero disables the condition.
+	StuckPendingThreshold time.Duration
+
+	// AllocationDriftPeriod is the interval between audits comparing the GPUs placed workloads
+	// are assumed to hold on each node with those its pods request. Zero disables the audit.
+	AllocationDriftPeriod time.Duration
+
+	// AllocationDriftThreshold is the drift, in GPUs, a node may show before a warning event
+	// is emitted for it.
+	AllocationDriftThreshold int64
+
+	// NodeMetricsPeriod is the interval at which the per-node GPU gauges are refreshed. They
+	// are otherwise only updated while a workload is being scheduled. Zero disables the refresh.
+	NodeMetricsPeriod time.Duration
+
+	// MinObservedNodes is the fewest GPU nodes the local cluster is expected to list. Workloads
+	// are held back while fewer are seen, such as during a partial API outage, rather than
+	// piled onto the few nodes that were listed. Zero disables the guard.
+	MinObservedNodes int
+
+	// GPUTolerationKey is the key of the GPU node taint every workload pod tolerates, in
+	// addition to the workload's own tolerations. Defaults to nvidia.com/gpu when empty.
+	GPUTolerationKey string
+
+	// DisableGPUToleration leaves workload pods with only the workload's own tolerations.
+	DisableGPUToleration bool
+
+	// TriggerAutoscaler creates placeholder pods for workloads no GPU node has room for, so
+	// the cluster-autoscaler provisions GPU nodes for them.
+	TriggerAutoscaler bool
+
+	// Clock provides the current time. Defaults to the real clock when nil.
+	Clock clock.PassiveClock
 }
 
 //+kubebuilder:rbac:groups=gpu.warp.dev,resources=gpuworkloads,verbs=get;list;watch;create;update;patch;delete
@@ -59,8 +287,13 @@ type GPUWorkloadReconciler struct {
 //+kubebuilder:rbac:groups=gpu.warp.dev,resources=gpuworkloads/finalizers,verbs=update
 //+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
-//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups="",resources=pods/log,verbs=get
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=node.k8s.io,resources=runtimeclasses,verbs=get;list;watch
 
 // Reconcile implements the reconciliation loop for GPUWorkload objects.
 // It watches GPUWorkload resources and:
@@ -76,8 +309,15 @@ func (r *GPUWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	gpuWorkload := &gpuv1alpha1.GPUWorkload{}
 	if err := r.Get(ctx, req.NamespacedName, gpuWorkload); err != nil {
 		log.Error(err, "unable to fetch GPUWorkload")
+		if apierrors.IsNotFound(err) {
+			r.debouncer.forget(req.NamespacedName)
+			r.recordPendingWorkloads(ctx, log, req.NamespacedName, nil)
+			r.syncSchedulingQueue(req.NamespacedName, nil)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	defer r.recordPendingWorkloads(ctx, log, req.NamespacedName, gpuWorkload)
+	defer r.syncSchedulingQueue(req.NamespacedName, gpuWorkload)
 
 	// Record metrics for reconciliation duration
 	defer func() {
@@ -93,15 +333,53 @@ func (r *GPUWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}()
 
-	// Skip if already scheduled successfully or permanently failed
-	if gpuWorkload.Status.Phase == gpuv1alpha1.PhaseScheduled || gpuWorkload.Status.Phase == gpuv1alpha1.PhaseRunning || gpuWorkload.Status.Phase == gpuv1alpha1.PhaseSucceeded {
-		log.V(1).Info("GPUWorkload already scheduled, skipping")
+	// Handle deletion with finalizer, whatever the phase or version of its status
+	if !gpuWorkload.ObjectMeta.DeletionTimestamp.IsZero() {
+		return r.handleDeletion(ctx, log, gpuWorkload)
+	}
+
+	// Reconcile status written by older controller versions before acting on it
+	if upgraded := upgradeLegacyStatus(gpuWorkload); upgraded {
+		log.Info("Upgraded legacy GPUWorkload status", "phase", gpuWorkload.Status.Phase)
+		if err := r.updateStatus(ctx, gpuWorkload); err != nil {
+			log.Error(err, "unable to update GPUWorkload status")
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{}, nil
 	}
 
-	// Handle deletion with finalizer
-	if !gpuWorkload.ObjectMeta.DeletionTimestamp.IsZero() {
-		return r.handleDeletion(ctx, log, gpuWorkload)
+	// Leave phases this controller version does not understand untouched
+	if gpuWorkload.Status.Phase != "" && !isKnownPhase(gpuWorkload.Status.Phase) {
+		log.Info("GPUWorkload has unrecognized phase, skipping", "phase", gpuWorkload.Status.Phase)
+		return ctrl.Result{}, nil
+	}
+
+	// Follow placed workloads through warmup until their Job finishes or their schedule closes
+	if gpuWorkload.Status.Phase == gpuv1alpha1.PhaseScheduled || gpuWorkload.Status.Phase == gpuv1alpha1.PhaseRunning {
+		return r.followPlacedWorkload(ctx, log, gpuWorkload)
+	}
+
+	// Give failed workloads another round of attempts once their auto-retry cooldown passes
+	if gpuWorkload.Status.Phase == gpuv1alpha1.PhaseFailed {
+		if result, retrying, err := r.autoRetry(ctx, log, gpuWorkload); retrying || err != nil {
+			return result, err
+		}
+	}
+
+	// Skip finished workloads, deleting them once their TTL has passed; a failed one would
+	// otherwise be placed again and fail again
+	if gpuWorkload.Status.Phase == gpuv1alpha1.PhaseSucceeded || gpuWorkload.Status.Phase == gpuv1alpha1.PhaseFailed {
+		log.V(1).Info("GPUWorkload already finished, skipping", "phase", gpuWorkload.Status.Phase)
+		return r.cleanUpFinished(ctx, log, gpuWorkload)
+	}
+
+	// A dry run is done once its placement is computed; clearing DryRun places the workload for real
+	if gpuWorkload.Status.Phase == gpuv1alpha1.PhaseSimulated {
+		if gpuWorkload.Spec.DryRun {
+			log.V(1).Info("GPUWorkload dry run already simulated, skipping")
+			return ctrl.Result{}, nil
+		}
+		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
 	}
 
 	// Add finalizer if not present
@@ -109,6 +387,9 @@ func (r *GPUWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		gpuWorkload.ObjectMeta.Finalizers = append(gpuWorkload.ObjectMeta.Finalizers, finalizerName)
 		if err := r.Update(ctx, gpuWorkload); err != nil {
 			log.Error(err, "unable to add finalizer")
+			if apierrors.IsConflict(err) {
+				recordReconcileError(metrics.ReconcileErrorConflict, err)
+			}
 			return ctrl.Result{}, err
 		}
 	}
@@ -117,123 +398,488 @@ func (r *GPUWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	if gpuWorkload.Status.Phase == "" {
 		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
 		gpuWorkload.Status.LastScheduleTime = &metav1.Time{Time: time.Now()}
-		if err := r.Status().Update(ctx, gpuWorkload); err != nil {
+		if err := r.updateStatus(ctx, gpuWorkload); err != nil {
 			log.Error(err, "unable to update GPUWorkload status")
 			return ctrl.Result{}, err
 		}
 		log.Info("Initialized GPUWorkload status", "phase", gpuWorkload.Status.Phase)
 	}
 
-	// Check if we should retry
-	maxRetries := int32(3) // default
-	if gpuWorkload.Spec.RetryPolicy != nil && gpuWorkload.Spec.RetryPolicy.MaxRetries > 0 {
-		maxRetries = gpuWorkload.Spec.RetryPolicy.MaxRetries
+	// Workloads admitted without the defaulting webhook get the same defaults, in memory only
+	gpuWorkload.Default()
+
+	// Objects applied against an older CRD or with --validate=false can bypass the GPUCount bounds
+	if count := gpuWorkload.Spec.GPUCount; count < 0 || count > gpuv1alpha1.MaxGPUCount {
+		return r.failInvalidSpec(ctx, log, gpuWorkload, "gpu_count_out_of_range",
+			fmt.Sprintf("gpuCount %d is out of range, must be between 1 and %d", count, gpuv1alpha1.MaxGPUCount))
+	}
+
+	// Workloads admitted without the validating webhook can carry values the Job cannot be built from
+	if err := validateSpec(gpuWorkload); err != nil {
+		return r.failInvalidSpec(ctx, log, gpuWorkload, "invalid_spec", err.Error())
 	}
 
+	// Infer the GPU count from the model size when it was not given explicitly
+	if gpuWorkload.Spec.GPUCount == 0 {
+		inferred := sizing.InferGPUCount(gpuWorkload.Spec.ModelName, gpuWorkload.Spec.ModelSizeGB, r.GPUMemoryGB, r.ModelGPUCounts)
+		if inferred == 0 {
+			return r.failInvalidSpec(ctx, log, gpuWorkload, "invalid_spec", "Either gpuCount or modelSizeGB must be specified")
+		}
+		// A large modelSizeGB or lookup entry is held to the same bounds as an explicit gpuCount
+		if inferred > gpuv1alpha1.MaxGPUCount {
+			return r.failInvalidSpec(ctx, log, gpuWorkload, "gpu_count_out_of_range", inferredGPUCountOutOfRange(inferred))
+		}
+		if gpuWorkload.Status.InferredGPUCount != inferred {
+			log.Info("Inferred GPU count from model size", "modelSizeGB", gpuWorkload.Spec.ModelSizeGB, "gpuCount", inferred)
+			gpuWorkload.Status.InferredGPUCount = inferred
+		}
+	}
+
+	// Stop waiting for a placement once the scheduling deadline has passed
+	if remaining, ok := r.schedulingDeadlineRemaining(gpuWorkload); ok && remaining <= 0 {
+		return r.failSchedulingDeadline(ctx, log, gpuWorkload)
+	}
+
+	// Check if we should retry
+	maxRetries := gpuWorkload.Spec.RetryPolicy.MaxRetries
 	if gpuWorkload.Status.RetryCount >= maxRetries {
-		gpuWorkload.Status.Phase = gpuv1alpha1.PhaseFailed
-		gpuWorkload.Status.Message = fmt.Sprintf("Failed to schedule after %d retries", maxRetries)
-		if err := r.Status().Update(ctx, gpuWorkload); err != nil {
+		if cpuFallbackAllowed(gpuWorkload) {
+			log.Info("Max retries exceeded, falling back to CPU", "retries", gpuWorkload.Status.RetryCount)
+			return r.scheduleOnCPU(ctx, log, gpuWorkload)
+		}
+		r.markFailed(gpuWorkload, gpuWorkload.Status.Reason, fmt.Sprintf("Failed to schedule after %d retries", maxRetries))
+		if reason, count := dominantFailureReason(gpuWorkload); reason != "" {
+			gpuWorkload.Status.Reason = reason
+			gpuWorkload.Status.Message = fmt.Sprintf("failed: %s (%d/%d attempts)", reason, count, gpuWorkload.Status.RetryCount)
+		}
+		if err := r.updateStatus(ctx, gpuWorkload); err != nil {
 			log.Error(err, "unable to update GPUWorkload status")
 			return ctrl.Result{}, err
 		}
 		log.Info("Max retries exceeded", "retries", gpuWorkload.Status.RetryCount, "maxRetries", maxRetries)
 		r.Recorder.Event(gpuWorkload, corev1.EventTypeWarning, "MaxRetriesExceeded", gpuWorkload.Status.Message)
-		return ctrl.Result{}, nil
+		return r.failedResult(gpuWorkload), nil
+	}
+
+	// Pause new placements during the maintenance window
+	if now := r.now(); r.MaintenanceWindow.Contains(now) {
+		log.Info("Inside maintenance window, deferring scheduling")
+		return r.deferScheduling(ctx, log, gpuWorkload, "maintenance_window",
+			"Scheduling is paused during the maintenance window", r.MaintenanceWindow.Remaining(now))
+	}
+
+	// Only schedule inside the workload's own time windows
+	schedule, err := workloadSchedule(gpuWorkload)
+	if err != nil {
+		return r.failInvalidSpec(ctx, log, gpuWorkload, "invalid_spec", fmt.Sprintf("Invalid schedule: %v", err))
+	}
+	if now := r.now(); schedule != nil && !schedule.Contains(now) {
+		log.Info("Outside workload schedule, deferring scheduling")
+		return r.deferScheduling(ctx, log, gpuWorkload, "outside_schedule",
+			fmt.Sprintf("Waiting for the workload schedule %q to open", gpuWorkload.Spec.Schedule.Windows), schedule.UntilOpen(now))
+	}
+
+	// Only schedule in namespaces carrying the required labels
+	if r.NamespaceSelector != nil && !r.NamespaceSelector.Empty() {
+		approved, err := r.namespaceApproved(ctx, gpuWorkload.Namespace)
+		if err != nil {
+			log.Error(err, "unable to fetch namespace")
+			return ctrl.Result{}, err
+		}
+		if !approved {
+			log.Info("Namespace not approved for GPU workloads, deferring scheduling")
+			return r.deferScheduling(ctx, log, gpuWorkload, "namespace_not_approved",
+				fmt.Sprintf("Namespace %s does not match the required labels %q", gpuWorkload.Namespace, r.NamespaceSelector.String()),
+				approvalRecheckInterval)
+		}
+	}
+
+	// Ask the external approval service before consuming GPUs
+	if r.Approver != nil {
+		approved, message, err := r.Approver.Approve(ctx, gpuWorkload)
+		if err != nil {
+			log.Error(err, "approval service unavailable")
+			message = fmt.Sprintf("Approval service unavailable: %v", err)
+		}
+		if !approved {
+			log.Info("Workload not approved, deferring scheduling", "message", message)
+			return r.deferScheduling(ctx, log, gpuWorkload, "awaiting_approval",
+				fmt.Sprintf("Awaiting approval: %s", message), approvalRecheckInterval)
+		}
+	}
+
+	// Coalesce bursts of events for the same workload into one placement attempt
+	if wait := r.debouncer.wait(req.NamespacedName, r.now(), r.PlacementDebounce); wait > 0 {
+		log.V(1).Info("Placement attempted recently, debouncing", "wait", wait)
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	// Serialize node selection and job creation across workers when requested, and so that
+	// concurrent placements cannot each see room under the active job cap
+	if r.serializePlacements() || r.MaxActiveJobs > 0 {
+		r.placementMu.Lock()
+		defer r.placementMu.Unlock()
+	}
+
+	// Cap how many copies of the same model run at once
+	if message, exceeded, err := r.modelConcurrencyExceeded(ctx, gpuWorkload); err != nil {
+		log.Error(err, "unable to count running workloads of the model")
+		return ctrl.Result{}, err
+	} else if exceeded {
+		log.Info("Model concurrency limit reached, deferring scheduling", "message", message)
+		return r.deferScheduling(ctx, log, gpuWorkload, "model_concurrency_limit", message, modelConcurrencyRecheckInterval)
+	}
+
+	// Cap the Jobs the controller manages as a safety limit
+	if message, exceeded, err := r.activeJobsExceeded(ctx, gpuWorkload); err != nil {
+		log.Error(err, "unable to count active jobs")
+		return ctrl.Result{}, err
+	} else if exceeded {
+		log.Info("Active job limit reached, deferring scheduling", "message", message)
+		return r.deferScheduling(ctx, log, gpuWorkload, "max_active_jobs", message, activeJobsRecheckInterval)
+	}
+
+	// Resolve the clusters the workload may run in; nodes are listed from the first and the
+	// others are only tried when none of its nodes fits
+	clusters, err := r.workloadClusters(gpuWorkload)
+	if err != nil {
+		log.Error(err, "unable to resolve workload cluster", "cluster", gpuWorkload.Spec.Cluster)
+		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
+		gpuWorkload.Status.Message = fmt.Sprintf("Cannot place workload: %v", err)
+		return r.requeueWithBackoff(ctx, gpuWorkload)
+	}
+	cluster := clusters[0]
+	nodeSource, err := r.clusterClient(cluster)
+	if err != nil {
+		log.Error(err, "unable to resolve workload cluster", "cluster", cluster)
+		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
+		gpuWorkload.Status.Message = fmt.Sprintf("Cannot place workload: %v", err)
+		return r.requeueWithBackoff(ctx, gpuWorkload)
 	}
 
 	// List available GPU nodes
 	nodes := &corev1.NodeList{}
-	if err := r.List(ctx, nodes); err != nil {
+	if err := nodeSource.List(ctx, nodes); err != nil {
 		log.Error(err, "unable to list nodes")
+		recordReconcileError(metrics.ReconcileErrorNodeList, err)
 		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
 		gpuWorkload.Status.Message = fmt.Sprintf("Error listing nodes: %v", err)
-		r.Status().Update(ctx, gpuWorkload)
-		return r.requeueWithBackoff(gpuWorkload)
+		return r.requeueWithBackoff(ctx, gpuWorkload)
+	}
+	if cluster == "" {
+		r.recordNodeGPUMetrics(ctx, log, nodes.Items)
+
+		// Don't trust a node list that is missing part of the cluster
+		if message, insufficient := r.insufficientNodeVisibility(nodes.Items); insufficient {
+			log.Info("Too few GPU nodes observed, deferring scheduling", "message", message)
+			return r.deferScheduling(ctx, log, gpuWorkload, "insufficient_node_visibility", message, nodeVisibilityRecheckInterval)
+		}
 	}
 
 	// Filter for GPU nodes that are Ready
-	var gpuNodes []corev1.Node
-	for _, node := range nodes.Items {
-		if isNodeReady(&node) && hasGPUs(&node) {
-			gpuNodes = append(gpuNodes, node)
+	gpuNodes := r.filterGPUNodes(nodes.Items)
+
+	// GPUs requested by the pods already bound to the nodes are not available
+	pods, err := nodePods(ctx, nodeSource, gpuNodes)
+	if err != nil {
+		log.Error(err, "unable to list pods")
+		recordReconcileError(metrics.ReconcileErrorNodeList, err)
+		return ctrl.Result{}, err
+	}
+	if r.serializePlacements() {
+		if gpuNodes, err = r.withoutPlacedGPUs(ctx, cluster, gpuNodes, pods); err != nil {
+			log.Error(err, "unable to account for placed workloads")
+			return ctrl.Result{}, err
 		}
 	}
+	if cluster == "" {
+		r.recordNodeAvailableGPUs(nodes.Items, gpuNodes, pods)
 
-	if len(gpuNodes) == 0 {
+		// Let the workloads ahead in the queue take the GPUs first
+		if ahead, queued := r.queuedBehind(gpuWorkload, gpuNodes, pods); queued {
+			log.Info("Workload queued behind another, deferring scheduling", "ahead", ahead.Key)
+			r.wakeQueued(ahead)
+			return r.deferScheduling(ctx, log, gpuWorkload, reasonQueued, queuedMessage(ahead), queuedRecheckInterval)
+		}
+	}
+	gpuNodes = selectedNodes(gpuNodes, gpuWorkload)
+
+	// Workloads that may burst go on to try the remote clusters
+	if len(gpuNodes) == 0 && len(clusters) == 1 {
 		log.Info("No GPU nodes available")
-		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
-		gpuWorkload.Status.Message = "No ready GPU nodes available"
-		r.Status().Update(ctx, gpuWorkload)
-		return r.requeueWithBackoff(gpuWorkload)
+		if r.cpuFallbackDue(gpuWorkload) {
+			return r.scheduleOnCPU(ctx, log, gpuWorkload)
+		}
+		r.Recorder.Event(gpuWorkload, corev1.EventTypeWarning, "NodesRejected", r.rejectionSummary(nodes.Items, gpuNodes, pods, nil, gpuWorkload))
+		if m := metrics.GetMetrics(); m != nil {
+			m.RecordSchedulingFailure(scheduling.FailureNoGPUNodes)
+		}
+		r.triggerScaleUp(ctx, log, gpuWorkload, cluster)
+		return r.deferScheduling(ctx, log, gpuWorkload, scheduling.FailureNoGPUNodes, "No ready GPU nodes available", noGPUNodesRecheckInterval)
 	}
 
 	log.Info("Found GPU nodes", "count", len(gpuNodes))
+	for i := range gpuNodes {
+		if ratio := scheduling.OvercommitRatio(&gpuNodes[i]); ratio > scheduling.MinOvercommitRatio {
+			log.Info("GPU over-commit active", "node", gpuNodes[i].Name, "ratio", ratio)
+		}
+	}
 
-	// Select scheduling strategy
+	// Select scheduling strategy. The webhook defaults it; workloads admitted without the
+	// webhook get the same default here.
 	strategyName := gpuWorkload.Spec.SchedulingStrategy
 	if strategyName == "" {
-		strategyName = "leastLoaded"
+		strategyName = gpuv1alpha1.DefaultSchedulingStrategy
+		if gpuWorkload.Spec.MIGProfile != "" {
+			strategyName = "migPartition"
+		}
+	}
+
+	// Route a share of workloads to the canary strategy while it is rolled out. MIG workloads
+	// keep their strategy, since only migPartition understands slices.
+	canary := r.CanaryStrategy != "" && gpuWorkload.Spec.MIGProfile == "" &&
+		scheduling.InCanary(string(gpuWorkload.UID), r.CanaryPercent)
+	if canary {
+		log.V(1).Info("Routing workload to canary strategy", "canary", r.CanaryStrategy, "stable", strategyName)
+		strategyName = r.CanaryStrategy
 	}
 
-	strategy, err := scheduling.Factory(strategyName, log)
+	strategy, err := scheduling.Factory(strategyName, log, r.strategyOptions())
 	if err != nil {
 		log.Error(err, "failed to create scheduling strategy", "strategy", strategyName)
+		recordReconcileError(metrics.ReconcileErrorStrategy, err)
 		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
 		gpuWorkload.Status.Message = fmt.Sprintf("Invalid scheduling strategy: %s", strategyName)
-		r.Status().Update(ctx, gpuWorkload)
+		if err := r.updateStatus(ctx, gpuWorkload); err != nil {
+			log.Error(err, "unable to update GPUWorkload status")
+			return ctrl.Result{}, err
+		}
 		return ctrl.Result{}, nil
 	}
 
-	// Choose a node using the strategy
-	selectedNode, err := strategy.ChooseNode(ctx, gpuNodes, gpuWorkload)
+	// The fairShare strategy weighs placements by the GPUs each namespace already holds
+	if strategy.Name() == "fairShare" {
+		usage, err := r.namespaceUsage(ctx)
+		if err != nil {
+			log.Error(err, "unable to compute namespace GPU usage")
+			return ctrl.Result{}, err
+		}
+		ctx = scheduling.WithNamespaceUsage(ctx, usage)
+	}
+
+	// The spread strategy keeps replicas of a model off the nodes already running it
+	if strategy.Name() == "spread" {
+		placements, err := r.modelPlacements(ctx, gpuWorkload)
+		if err != nil {
+			log.Error(err, "unable to list the placements of the workload's model")
+			return ctrl.Result{}, err
+		}
+		ctx = scheduling.WithModelPlacements(ctx, placements)
+	}
+
+	// Reduce the GPU request on retries when the workload allows a degraded allocation
+	placement := gpuWorkload
+	if gpuCount := degradedGPUCount(gpuWorkload); gpuCount != gpuWorkload.RequestedGPUCount() {
+		log.Info("Retrying with reduced GPU count", "gpuCount", gpuCount, "requested", gpuWorkload.RequestedGPUCount())
+		placement = gpuWorkload.DeepCopy()
+		placement.Spec.GPUCount = gpuCount
+	}
+
+	// Round the GPU request to the granularity the device plugins hand out
+	if gpuCount := r.roundedGPUCount(placement.RequestedGPUCount()); gpuCount != placement.RequestedGPUCount() {
+		log.Info("Rounded GPU count", "gpuCount", gpuCount, "requested", placement.RequestedGPUCount())
+		if placement == gpuWorkload {
+			placement = gpuWorkload.DeepCopy()
+		}
+		placement.Spec.GPUCount = gpuCount
+	}
+
+	// Leave out nodes without room for the pod's CPU and memory, including its RuntimeClass overhead
+	requests, err := r.effectiveRequests(ctx, nodeSource, placement)
 	if err != nil {
-		log.Info("Failed to select node", "error", err)
+		log.Error(err, "unable to compute effective resource requests")
 		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
 		gpuWorkload.Status.Message = err.Error()
-		gpuWorkload.Status.RetryCount++
-		if m := metrics.GetMetrics(); m != nil {
-			m.RecordRetry()
-			m.RecordSchedulingFailure("no_suitable_node")
+		return r.requeueWithBackoff(ctx, gpuWorkload)
+	}
+	candidates, unfit := fitNodes(gpuNodes, pods, requests, gpuResourceName(placement))
+
+	// Choose a node using the strategy, resolving the GPU vendor when it is left to the scheduler.
+	// A node nominated by the defragmenter is tried first, falling back to every candidate.
+	// Gangs are placed whole on the local cluster, so every replica fits before any starts.
+	var selectedNode *corev1.Node
+	var replicaNodes []*corev1.Node
+	var vendor string
+	replicas := replicaCount(gpuWorkload)
+	selectionStart := time.Now()
+	if replicas > 1 {
+		replicaNodes, vendor, err = r.chooseNodes(ctx, strategy, candidates, pods, placement, int(replicas))
+		if err == nil {
+			selectedNode = replicaNodes[0]
+		}
+	} else {
+		if nominated := findNode(candidates, gpuWorkload.Status.NominatedNode); nominated != nil {
+			selectedNode, vendor, err = r.chooseNode(ctx, strategy, []corev1.Node{*nominated}, pods, placement)
+		}
+		if selectedNode == nil {
+			selectedNode, vendor, err = r.chooseNode(ctx, strategy, candidates, pods, placement)
+		}
+	}
+	if err == nil {
+		r.latencies.observe(strategy.Name(), time.Since(selectionStart))
+	}
+	if err != nil && len(clusters) > 1 && replicas == 1 {
+		if remote, ok := r.placeInRemoteClusters(ctx, log, clusters, strategy, placement); ok {
+			log.Info("No local node fits, bursting to remote cluster", "cluster", remote.cluster)
+			cluster, selectedNode, vendor, err = remote.cluster, remote.node, remote.vendor, nil
+		}
+	}
+	if err == nil && vendor != placement.Spec.GPUVendor {
+		if placement == gpuWorkload {
+			placement = gpuWorkload.DeepCopy()
 		}
-		r.Status().Update(ctx, gpuWorkload)
-		return r.requeueWithBackoff(gpuWorkload)
+		placement.Spec.GPUVendor = vendor
+	}
+	if err != nil {
+		log.Info("Failed to select node", "error", err)
+		// Anything but a rejection of the candidates means the strategy itself failed
+		if _, ok := scheduling.FailureReason(err); !ok {
+			recordReconcileError(metrics.ReconcileErrorStrategy, err)
+		}
+		if preemptionAllowed(gpuWorkload) && cluster == "" {
+			victim, err := r.preemptionVictim(ctx, placement, candidates, pods)
+			if err != nil {
+				log.Error(err, "unable to look for workloads to preempt")
+				return ctrl.Result{}, err
+			}
+			if victim != nil {
+				return r.preempt(ctx, log, gpuWorkload, victim)
+			}
+		}
+		if r.cpuFallbackDue(gpuWorkload) {
+			return r.scheduleOnCPU(ctx, log, gpuWorkload)
+		}
+		reason, message := schedulingFailure(err, len(gpuNodes), len(candidates))
+		if reason == scheduling.FailureAllNodesFull || reason == scheduling.FailureNoGPUNodes {
+			r.triggerScaleUp(ctx, log, gpuWorkload, cluster)
+		}
+		if replicas > 1 {
+			reason = "insufficient_gang_capacity"
+		}
+		recordFailedAttempt(gpuWorkload, reason, message)
+		r.Recorder.Event(gpuWorkload, corev1.EventTypeWarning, "NodesRejected", r.rejectionSummary(nodes.Items, gpuNodes, pods, unfit, placement))
+		r.markUnplaced(gpuWorkload, gpuNodes, pods)
+		return r.requeueWithBackoff(ctx, gpuWorkload)
+	}
+
+	// Hold the workload back rather than have the Job rejected at admission by a namespace quota
+	if message, exceeded, err := r.quotaExceeded(ctx, cluster, placement); err != nil {
+		log.Error(err, "unable to check namespace resource quotas")
+		return ctrl.Result{}, err
+	} else if exceeded {
+		log.Info("Namespace GPU quota exceeded, deferring scheduling", "message", message)
+		r.Recorder.Event(gpuWorkload, corev1.EventTypeWarning, "NamespaceQuotaExceeded", message)
+		return r.deferScheduling(ctx, log, gpuWorkload, "namespace_quota_exceeded", message, quotaRecheckInterval)
+	}
+
+	if replicaNodes == nil {
+		replicaNodes = []*corev1.Node{selectedNode}
+	}
+	if gpuWorkload.Spec.DryRun {
+		return r.recordDryRunPlacement(ctx, log, gpuWorkload, placement, strategy.Name(), cluster, replicaNodes)
 	}
 
 	log.Info("Selected node for workload", "node", selectedNode.Name, "strategy", strategy.Name())
+	scheduling.RecordPlacement(selectedNode.Name)
+
+	// Free the GPUs the autoscaler placeholders may hold on new nodes before the Jobs need them
+	if r.TriggerAutoscaler && cluster == "" && !r.Simulate {
+		if err := r.cancelScaleUp(ctx, gpuWorkload); err != nil {
+			log.Error(err, "unable to delete autoscaler placeholder pods")
+			return ctrl.Result{}, err
+		}
+	}
 
-	// Create Job for the workload
-	job, err := r.createJobForWorkload(gpuWorkload, selectedNode)
+	// Create a Job for each replica of the workload
+	jobs, err := r.createReplicaJobs(ctx, log, placement, cluster, replicaNodes)
 	if err != nil {
 		log.Error(err, "failed to create job")
-		gpuWorkload.Status.Phase = gpuv1alpha1.PhasePending
-		gpuWorkload.Status.Message = fmt.Sprintf("Failed to create job: %v", err)
-		gpuWorkload.Status.RetryCount++
-		if m := metrics.GetMetrics(); m != nil {
-			m.RecordRetry()
-			m.RecordSchedulingFailure("job_creation_failed")
-		}
-		r.Status().Update(ctx, gpuWorkload)
-		return r.requeueWithBackoff(gpuWorkload)
+		recordReconcileError(metrics.ReconcileErrorJobCreate, err)
+		recordFailedAttempt(gpuWorkload, "job_creation_failed", fmt.Sprintf("Failed to create job: %v", err))
+		return r.requeueWithBackoff(ctx, gpuWorkload)
 	}
+	job := jobs[0]
 
 	// Update status to Scheduled
 	gpuWorkload.Status.Phase = gpuv1alpha1.PhaseScheduled
 	gpuWorkload.Status.AssignedNode = selectedNode.Name
-	gpuWorkload.Status.LastScheduleTime = &metav1.Time{Time: time.Now()}
+	gpuWorkload.Status.ReplicaNodes = nil
+	if replicas > 1 {
+		for _, node := range replicaNodes {
+			gpuWorkload.Status.ReplicaNodes = append(gpuWorkload.Status.ReplicaNodes, node.Name)
+		}
+	}
+	gpuWorkload.Status.Cluster = cluster
+	gpuWorkload.Status.NominatedNode = ""
+	gpuWorkload.Status.AssignedNodeGPUInfo = nodeGPUInfo(selectedNode)
+	gpuWorkload.Status.NodeLabels = propagatedNodeLabels(selectedNode, r.PropagatedNodeLabels)
+	gpuWorkload.Status.LastScheduleTime = &metav1.Time{Time: r.now()}
 	gpuWorkload.Status.JobName = job.Name
+	gpuWorkload.Status.Placements++
+	gpuWorkload.Status.ImagePullFailingSince = nil
+	gpuWorkload.Status.Reason = ""
+	gpuWorkload.Status.LastBackoff = nil
+	gpuWorkload.Status.AllocatedGPUCount = placement.RequestedGPUCount()
+	gpuWorkload.Status.AllocatedMemory = ""
+	if memory, ok := job.Spec.Template.Spec.Containers[0].Resources.Requests[corev1.ResourceMemory]; ok {
+		gpuWorkload.Status.AllocatedMemory = memory.String()
+	}
+	gpuWorkload.Status.ScheduledOnAttempt = gpuWorkload.Status.RetryCount
+	gpuWorkload.Status.Strategy = strategy.Name()
+	gpuWorkload.Status.CanaryStrategy = canary
+	gpuWorkload.Status.GPUVendor = placement.Spec.GPUVendor
+	gpuWorkload.Status.EstimatedCostPerHour = ""
+	if cost, ok := scheduling.EstimateCostPerHour(selectedNode, placement.RequestedGPUCount()); ok {
+		gpuWorkload.Status.EstimatedCostPerHour = strconv.FormatFloat(cost, 'f', 2, 64)
+	}
 	gpuWorkload.Status.Message = fmt.Sprintf("Successfully scheduled on node %s using %s strategy", selectedNode.Name, strategy.Name())
+	if cluster != "" {
+		gpuWorkload.Status.Message = fmt.Sprintf("Successfully scheduled on node %s of cluster %s using %s strategy", selectedNode.Name, cluster, strategy.Name())
+	}
+	if replicas > 1 {
+		gpuWorkload.Status.Message = fmt.Sprintf("Successfully scheduled %d replicas on nodes %s using %s strategy",
+			replicas, strings.Join(gpuWorkload.Status.ReplicaNodes, ", "), strategy.Name())
+	}
+	degraded := placement.RequestedGPUCount() < gpuWorkload.RequestedGPUCount()
+	if degraded {
+		gpuWorkload.Status.Reason = "degraded_allocation"
+		gpuWorkload.Status.Message = fmt.Sprintf("Scheduled on node %s with %d of %d requested GPUs using %s strategy",
+			selectedNode.Name, placement.RequestedGPUCount(), gpuWorkload.RequestedGPUCount(), strategy.Name())
+	}
 
-	if err := r.Status().Update(ctx, gpuWorkload); err != nil {
+	if err := r.updateStatus(ctx, gpuWorkload); err != nil {
 		log.Error(err, "unable to update GPUWorkload status")
 		return ctrl.Result{}, err
 	}
 
 	log.Info("GPUWorkload scheduled successfully", "node", selectedNode.Name, "job", job.Name)
+	r.debouncer.forget(req.NamespacedName)
 	r.Recorder.Event(gpuWorkload, corev1.EventTypeNormal, "Scheduled", gpuWorkload.Status.Message)
+	if degraded {
+		r.Recorder.Event(gpuWorkload, corev1.EventTypeWarning, "DegradedAllocation", gpuWorkload.Status.Message)
+	}
+	if cluster == "" {
+		for i, node := range replicaNodes {
+			r.Recorder.Eventf(node, corev1.EventTypeNormal, "GPUWorkloadPlaced",
+				"GPUWorkload %s/%s placed with %d GPUs (job %s)", gpuWorkload.Namespace, gpuWorkload.Name, placement.RequestedGPUCount(), jobs[i].Name)
+		}
+	}
 
 	if m := metrics.GetMetrics(); m != nil {
-		m.RecordSchedulingSuccess(strategy.Name())
+		m.RecordSchedulingSuccess(strategy.Name(), gpuWorkload.Labels)
+		m.RecordGPUsRequested(placement.RequestedGPUCount(), gpuWorkload.Labels)
+		m.RecordGPURequest(string(gpuResourceName(placement)))
+		m.RecordAttemptsToSchedule(gpuWorkload.Status.ScheduledOnAttempt)
 	}
 
 	return ctrl.Result{}, nil
@@ -242,15 +888,24 @@ func (r *GPUWorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 // handleDeletion handles cleanup when a GPUWorkload is deleted
 func (r *GPUWorkloadReconciler) handleDeletion(ctx context.Context, log logr.Logger, gpuWorkload *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
 	if containsString(gpuWorkload.ObjectMeta.Finalizers, finalizerName) {
-		// Delete associated job if it exists
-		if gpuWorkload.Status.JobName != "" {
-			job := &batchv1.Job{}
-			jobKey := types.NamespacedName{Name: gpuWorkload.Status.JobName, Namespace: gpuWorkload.Namespace}
-			if err := r.Get(ctx, jobKey, job); err == nil {
-				log.Info("Deleting associated job", "job", job.Name)
-				if err := r.Delete(ctx, job); err != nil && !client.IgnoreNotFound(err) != nil {
-					log.Error(err, "unable to delete job")
+		// Delete the associated jobs, one per replica, if they exist
+		c, err := r.clusterClient(gpuWorkload.Status.Cluster)
+		if err != nil {
+			log.Error(err, "unable to reach the job's cluster, leaving the job behind")
+		}
+		if c != nil {
+			for _, placed := range placedJobs(gpuWorkload) {
+				job := &batchv1.Job{}
+				// A Job that is already gone needs no cleanup; any other error keeps the finalizer
+				if err := c.Get(ctx, client.ObjectKeyFromObject(placed), job); client.IgnoreNotFound(err) != nil {
+					log.Error(err, "unable to get job")
 					return ctrl.Result{}, err
+				} else if err == nil {
+					log.Info("Deleting associated job", "job", job.Name)
+					if err := c.Delete(ctx, job, jobDeleteOptions(gpuWorkload)...); client.IgnoreNotFound(err) != nil {
+						log.Error(err, "unable to delete job")
+						return ctrl.Result{}, err
+					}
 				}
 			}
 		}
@@ -259,24 +914,77 @@ func (r *GPUWorkloadReconciler) handleDeletion(ctx context.Context, log logr.Log
 		gpuWorkload.ObjectMeta.Finalizers = removeString(gpuWorkload.ObjectMeta.Finalizers, finalizerName)
 		if err := r.Update(ctx, gpuWorkload); err != nil {
 			log.Error(err, "unable to remove finalizer")
+			if apierrors.IsConflict(err) {
+				recordReconcileError(metrics.ReconcileErrorConflict, err)
+			}
 			return ctrl.Result{}, err
 		}
 	}
 	return ctrl.Result{}, nil
 }
 
-// createJobForWorkload creates a Kubernetes Job for the GPUWorkload
-func (r *GPUWorkloadReconciler) createJobForWorkload(gw *gpuv1alpha1.GPUWorkload, node *corev1.Node) (*batchv1.Job, error) {
-	jobName := fmt.Sprintf("%s-job-%s", gw.Name, gw.UID[:8])
+// jobDeleteOptions deletes a workload's Job together with its pods, giving them the
+// workload's termination grace period to shut down.
+func jobDeleteOptions(gw *gpuv1alpha1.GPUWorkload) []client.DeleteOption {
+	opts := []client.DeleteOption{client.PropagationPolicy(metav1.DeletePropagationBackground)}
+	if gw.Spec.TerminationGracePeriodSeconds != nil {
+		opts = append(opts, client.GracePeriodSeconds(*gw.Spec.TerminationGracePeriodSeconds))
+	}
+	return opts
+}
+
+// defaultGPUTolerationKey is the taint key GPU nodes are commonly tainted with, e.g.
+// nvidia.com/gpu=present:NoSchedule.
+const defaultGPUTolerationKey = "nvidia.com/gpu"
 
-	// Check if job already exists
+// podTolerations returns the workload's tolerations plus one for the GPU node taint, whatever
+// its value, unless disabled or the workload already tolerates the taint key itself.
+func (r *GPUWorkloadReconciler) podTolerations(gw *gpuv1alpha1.GPUWorkload) []corev1.Toleration {
+	if r.DisableGPUToleration {
+		return gw.Spec.Tolerations
+	}
+	key := r.GPUTolerationKey
+	if key == "" {
+		key = defaultGPUTolerationKey
+	}
+	for _, toleration := range gw.Spec.Tolerations {
+		if toleration.Key == key {
+			return gw.Spec.Tolerations
+		}
+	}
+	tolerations := make([]corev1.Toleration, 0, len(gw.Spec.Tolerations)+1)
+	tolerations = append(tolerations, gw.Spec.Tolerations...)
+	return append(tolerations, corev1.Toleration{Key: key, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule})
+}
+
+// createJobForWorkload creates the Kubernetes Job running the given replica of the GPUWorkload
+// on the node of the named cluster, or a GPU-less Job running the CPU fallback image when node is nil.
+func (r *GPUWorkloadReconciler) createJobForWorkload(gw *gpuv1alpha1.GPUWorkload, cluster string, node *corev1.Node, replica int32) (*batchv1.Job, error) {
+	jobName := replicaJobName(gw, replica)
+	c, err := r.clusterClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// Adopt a Job already created for this placement, e.g. when the status update recording
+	// it failed, but never one that is going away or has already run
 	existingJob := &batchv1.Job{}
-	if err := r.Get(context.Background(), types.NamespacedName{Name: jobName, Namespace: gw.Namespace}, existingJob); err == nil {
+	if err := c.Get(context.Background(), types.NamespacedName{Name: jobName, Namespace: gw.Namespace}, existingJob); err == nil {
+		if finished, _ := jobFinished(existingJob); finished || !existingJob.DeletionTimestamp.IsZero() {
+			return nil, fmt.Errorf("job %s of an earlier placement still exists", jobName)
+		}
 		return existingJob, nil
 	}
 
-	// Create the Job spec with GPU resource requests
+	// Create the Job spec with GPU resource requests; by default the first failure is final
 	backoffLimit := int32(0)
+	if gw.Spec.JobBackoffLimit != nil {
+		backoffLimit = *gw.Spec.JobBackoffLimit
+	}
+	restartPolicy := corev1.RestartPolicyNever
+	if gw.Spec.RestartPolicy != "" {
+		restartPolicy = gw.Spec.RestartPolicy
+	}
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      jobName,
@@ -308,12 +1016,14 @@ func (r *GPUWorkloadReconciler) createJobForWorkload(gw *gpuv1alpha1.GPUWorkload
 					},
 				},
 				Spec: corev1.PodSpec{
-					RestartPolicy: corev1.RestartPolicyNever,
-					NodeName:      node.Name,
+					RestartPolicy: restartPolicy,
 					Containers: []corev1.Container{
 						{
-							Name:  "gpu-workload",
-							Image: fmt.Sprintf("python:3.11-slim"), // Placeholder image
+							Name:            workloadContainerName,
+							Image:           workloadImage(gw),
+							Command:         gw.Spec.Command,
+							Args:            gw.Spec.Args,
+							ImagePullPolicy: r.imagePullPolicy(),
 							Env: []corev1.EnvVar{
 								{
 									Name:  "MODEL_NAME",
@@ -321,17 +1031,10 @@ func (r *GPUWorkloadReconciler) createJobForWorkload(gw *gpuv1alpha1.GPUWorkload
 								},
 								{
 									Name:  "GPU_COUNT",
-									Value: fmt.Sprintf("%d", gw.Spec.GPUCount),
-								},
-							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceName("nvidia.com/gpu"): parseQuantity(fmt.Sprintf("%d", gw.Spec.GPUCount)),
-								},
-								Limits: corev1.ResourceList{
-									corev1.ResourceName("nvidia.com/gpu"): parseQuantity(fmt.Sprintf("%d", gw.Spec.GPUCount)),
+									Value: fmt.Sprintf("%d", gw.RequestedGPUCount()),
 								},
 							},
+							Resources: r.roundedResources(gw),
 						},
 					},
 				},
@@ -339,33 +1042,424 @@ func (r *GPUWorkloadReconciler) createJobForWorkload(gw *gpuv1alpha1.GPUWorkload
 		},
 	}
 
-	if err := r.Create(context.Background(), job); err != nil {
+	// Without a node the workload runs its CPU fallback image and requests no GPUs
+	podSpec := &job.Spec.Template.Spec
+	if gw.Spec.RuntimeClassName != "" {
+		podSpec.RuntimeClassName = &gw.Spec.RuntimeClassName
+	}
+	if gw.Spec.TerminationGracePeriodSeconds != nil {
+		podSpec.TerminationGracePeriodSeconds = gw.Spec.TerminationGracePeriodSeconds
+	}
+	podSpec.NodeSelector = gw.Spec.NodeSelector
+	podSpec.Tolerations = r.podTolerations(gw)
+	if replicas := replicaCount(gw); replicas > 1 {
+		index := fmt.Sprintf("%d", replica)
+		job.Labels[replicaLabel] = index
+		job.Spec.Template.Labels[replicaLabel] = index
+		podSpec.Containers[0].Env = append(podSpec.Containers[0].Env,
+			corev1.EnvVar{Name: "REPLICA_INDEX", Value: index},
+			corev1.EnvVar{Name: "REPLICA_COUNT", Value: fmt.Sprintf("%d", replicas)})
+	}
+	if node != nil {
+		podSpec.NodeName = node.Name
+		if product := node.Labels[gpuProductLabel]; product != "" {
+			podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, corev1.EnvVar{Name: "GPU_PRODUCT", Value: product})
+		}
+	} else {
+		podSpec.Containers[0].Image = gw.Spec.CPUFallbackImage
+		podSpec.Containers[0].Env[1].Value = "0"
+		podSpec.Containers[0].Resources = r.cpuFallbackResources(gw)
+	}
+
+	// The workload's own variables win over the controller's
+	podSpec.Containers[0].Env = mergeEnv(podSpec.Containers[0].Env, gw.Spec.Env)
+	podSpec.Volumes = gw.Spec.Volumes
+	podSpec.Containers[0].VolumeMounts = gw.Spec.VolumeMounts
+
+	// Owner references cannot point across clusters; remote Jobs are cleaned up by the finalizer
+	if cluster != "" {
+		job.OwnerReferences = nil
+	}
+
+	// In simulation mode the Job is returned without being created, so no pods run
+	if r.Simulate {
+		return job, nil
+	}
+
+	if err := c.Create(context.Background(), job); err != nil {
 		return nil, err
 	}
 
 	return job, nil
 }
 
-// requeueWithBackoff returns a requeue result with exponential backoff
-func (r *GPUWorkloadReconciler) requeueWithBackoff(gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+// mergeEnv returns the controller's environment variables followed by the workload's own,
+// leaving out the controller's variables the workload sets itself.
+func mergeEnv(builtin, user []corev1.EnvVar) []corev1.EnvVar {
+	if len(user) == 0 {
+		return builtin
+	}
+	overridden := make(map[string]bool, len(user))
+	for _, env := range user {
+		overridden[env.Name] = true
+	}
+	merged := make([]corev1.EnvVar, 0, len(builtin)+len(user))
+	for _, env := range builtin {
+		if !overridden[env.Name] {
+			merged = append(merged, env)
+		}
+	}
+	return append(merged, user...)
+}
+
+// upgradeLegacyStatus fills in status written by older controller versions so the
+// workload is not mistaken for a new one. A workload without a phase that already
+// has a Job or node assignment was placed before phases were tracked and must not
+// be scheduled again. It returns true if the status was modified.
+func upgradeLegacyStatus(gw *gpuv1alpha1.GPUWorkload) bool {
+	if gw.Status.Phase != "" {
+		return false
+	}
+	if gw.Status.JobName == "" && gw.Status.AssignedNode == "" {
+		return false
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	if gw.Status.LastScheduleTime == nil {
+		gw.Status.LastScheduleTime = &metav1.Time{Time: gw.CreationTimestamp.Time}
+	}
+	return true
+}
+
+// inferredGPUCountOutOfRange describes an inferred GPU count above MaxGPUCount.
+func inferredGPUCountOutOfRange(inferred int32) string {
+	return fmt.Sprintf("inferred gpuCount %d is out of range, must be between 1 and %d", inferred, gpuv1alpha1.MaxGPUCount)
+}
+
+// isKnownPhase reports whether the phase is one this controller version manages.
+func isKnownPhase(phase gpuv1alpha1.GPUWorkloadPhase) bool {
+	switch phase {
+	case gpuv1alpha1.PhasePending, gpuv1alpha1.PhaseScheduling, gpuv1alpha1.PhaseScheduled,
+		gpuv1alpha1.PhaseRunning, gpuv1alpha1.PhaseFailed, gpuv1alpha1.PhaseSucceeded, gpuv1alpha1.PhaseSimulated:
+		return true
+	}
+	return false
+}
+
+// filterGPUNodes returns the nodes that are Ready, expose GPUs, are neither cordoned nor
+// tainted, and are eligible to host workloads. See nodeRejection.
+func (r *GPUWorkloadReconciler) filterGPUNodes(nodes []corev1.Node) []corev1.Node {
+	var gpuNodes []corev1.Node
+	for _, node := range nodes {
+		if _, rejected := r.nodeRejection(&node); rejected {
+			continue
+		}
+		gpuNodes = append(gpuNodes, node)
+	}
+	return gpuNodes
+}
+
+// degradedGPUCount returns the GPU count to attempt on the current retry. Workloads that
+// allow degradation give up one GPU per failed attempt, down to MinGPUCount.
+func degradedGPUCount(gw *gpuv1alpha1.GPUWorkload) int32 {
+	requested := gw.RequestedGPUCount()
+	if !gw.Spec.DegradeOnRetry || gw.Spec.MinGPUCount <= 0 || gw.Spec.MinGPUCount >= requested {
+		return requested
+	}
+
+	gpuCount := requested - gw.Status.RetryCount
+	if gpuCount < gw.Spec.MinGPUCount {
+		gpuCount = gw.Spec.MinGPUCount
+	}
+	return gpuCount
+}
+
+// deferScheduling keeps the workload pending for the given reason without counting a
+// retry, and requeues it once the blocking condition is expected to clear.
+func (r *GPUWorkloadReconciler) deferScheduling(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, reason, message string, after time.Duration) (ctrl.Result, error) {
+	// Only a workload waiting for its turn keeps its place in the queue
+	if reason != reasonQueued {
+		r.dequeue(types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name})
+	}
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	gw.Status.Reason = reason
+	gw.Status.Message = message
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.capToSchedulingDeadline(gw, after)}, nil
+}
+
+// failInvalidSpec marks the workload as permanently Failed for the given reason because its
+// spec cannot be scheduled.
+func (r *GPUWorkloadReconciler) failInvalidSpec(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, reason, message string) (ctrl.Result, error) {
+	gw.Status.Phase = gpuv1alpha1.PhaseFailed
+	gw.Status.Reason = reason
+	gw.Status.Message = message
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(gw, corev1.EventTypeWarning, "InvalidSpec", message)
+	return ctrl.Result{}, nil
+}
+
+// namespaceApproved reports whether the namespace's labels match the NamespaceSelector.
+func (r *GPUWorkloadReconciler) namespaceApproved(ctx context.Context, name string) (bool, error) {
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		return false, err
+	}
+	return r.NamespaceSelector.Matches(labels.Set(namespace.Labels)), nil
+}
+
+// now returns the current time from the configured clock.
+func (r *GPUWorkloadReconciler) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+	return r.Clock.Now()
+}
+
+// capacity returns the configured CapacityProvider, defaulting to node allocatable GPUs.
+func (r *GPUWorkloadReconciler) capacity() scheduling.CapacityProvider {
+	switch {
+	case r.CapacityProvider != nil:
+		return r.CapacityProvider
+	case r.StrategyOptions.Capacity != nil:
+		return r.StrategyOptions.Capacity
+	}
+	return scheduling.AllocatableCapacity{}
+}
+
+// strategyOptions returns the strategy options with the reconciler's CapacityProvider.
+func (r *GPUWorkloadReconciler) strategyOptions() scheduling.Options {
+	opts := r.StrategyOptions
+	opts.Capacity = r.capacity()
+	return opts
+}
+
+// gpuResourceName returns the extended resource the workload requests: a MIG slice
+// resource when a MIG profile is set, otherwise whole NVIDIA GPUs.
+func gpuResourceName(gw *gpuv1alpha1.GPUWorkload) corev1.ResourceName {
+	if gw.Spec.MIGProfile != "" {
+		return scheduling.MIGResourceName(gw.Spec.MIGProfile)
+	}
+	if name, ok := scheduling.VendorResourceName(gw.Spec.GPUVendor); ok {
+		return name
+	}
+	return corev1.ResourceName("nvidia.com/gpu")
+}
+
+// chooseNode selects a node for the workload and returns the GPU vendor it was placed on.
+func (r *GPUWorkloadReconciler) chooseNode(ctx context.Context, strategy scheduling.Strategy, nodes []corev1.Node, pods scheduling.NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, string, error) {
+	selected, vendor, err := r.chooseNodes(ctx, strategy, nodes, pods, gw, 1)
+	if err != nil {
+		return nil, "", err
+	}
+	return selected[0], vendor, nil
+}
+
+// chooseNodes selects a node for each of the workload's replicas and returns the GPU vendor
+// they were placed on. Nodes whose GPUs have less than MinGPUMemoryGB are left out for every
+// strategy. A pinned vendor restricts the candidates to that vendor's nodes; "auto" tries
+// each vendor in preference order and settles on the first fitting every replica.
+func (r *GPUWorkloadReconciler) chooseNodes(ctx context.Context, strategy scheduling.Strategy, nodes []corev1.Node, pods scheduling.NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, string, error) {
+	if len(nodes) > 0 {
+		var err error
+		if nodes, err = scheduling.NodesWithGPUMemory(nodes, gw); err != nil {
+			return nil, "", err
+		}
+	}
+	choose := func(candidates []corev1.Node) ([]*corev1.Node, error) {
+		if replicas > 1 {
+			return strategy.ChooseNodes(ctx, candidates, pods, gw, replicas)
+		}
+		node, err := strategy.ChooseNode(ctx, candidates, pods, gw)
+		if err != nil {
+			return nil, err
+		}
+		return []*corev1.Node{node}, nil
+	}
+
+	switch gw.Spec.GPUVendor {
+	case "":
+		selected, err := choose(nodes)
+		return selected, "", err
+	case scheduling.VendorAuto:
+		preference := r.GPUVendorPreference
+		if len(preference) == 0 {
+			preference = scheduling.DefaultVendorPreference
+		}
+		var lastErr error
+		for _, vendor := range preference {
+			candidates := scheduling.FilterNodesByVendor(nodes, vendor)
+			if len(candidates) == 0 {
+				continue
+			}
+			selected, err := choose(candidates)
+			if err == nil {
+				return selected, vendor, nil
+			}
+			lastErr = err
+		}
+		if lastErr == nil {
+			lastErr = fmt.Errorf("%w: no nodes with GPUs from vendors %v", scheduling.ErrNoNodes, preference)
+		}
+		return nil, "", lastErr
+	default:
+		candidates := scheduling.FilterNodesByVendor(nodes, gw.Spec.GPUVendor)
+		if len(candidates) == 0 {
+			return nil, "", fmt.Errorf("%w: no nodes with %s GPUs", scheduling.ErrNoNodes, gw.Spec.GPUVendor)
+		}
+		selected, err := choose(candidates)
+		return selected, gw.Spec.GPUVendor, err
+	}
+}
+
+// workloadImage returns the image for the workload container.
+func workloadImage(gw *gpuv1alpha1.GPUWorkload) string {
+	if gw.Spec.Image != "" {
+		return gw.Spec.Image
+	}
+	return defaultWorkloadImage
+}
+
+// imagePullPolicy forces images to be pulled on every start when digest pinning is
+// required, so registry credentials are always re-checked.
+func (r *GPUWorkloadReconciler) imagePullPolicy() corev1.PullPolicy {
+	if r.RequireImageDigest {
+		return corev1.PullAlways
+	}
+	return ""
+}
+
+// requeueWithBackoff updates the workload status, recording the backoff before the next
+// scheduling attempt, and returns a result requeueing the workload after it. Decorrelated
+// backoff builds on the previous backoff recorded in status.
+func (r *GPUWorkloadReconciler) requeueWithBackoff(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
 	baseDuration := 30 * time.Second
-	if gw.Spec.RetryPolicy != nil && gw.Spec.RetryPolicy.BackoffSeconds > 0 {
-		baseDuration = time.Duration(gw.Spec.RetryPolicy.BackoffSeconds) * time.Second
+	var mode string
+	if gw.Spec.RetryPolicy != nil {
+		if gw.Spec.RetryPolicy.BackoffSeconds > 0 {
+			baseDuration = time.Duration(gw.Spec.RetryPolicy.BackoffSeconds) * time.Second
+		}
+		mode = gw.Spec.RetryPolicy.BackoffMode
+	}
+
+	var backoffDuration time.Duration
+	switch mode {
+	case gpuv1alpha1.BackoffDecorrelated:
+		var previous time.Duration
+		if gw.Status.LastBackoff != nil {
+			previous = gw.Status.LastBackoff.Duration
+		}
+		backoffDuration = backoff.NextBackoffDecorrelated(baseDuration, previous)
+	default:
+		backoffDuration = backoff.NextBackoff(baseDuration, int(gw.Status.RetryCount))
 	}
 
-	backoffDuration := backoff.NextBackoff(baseDuration, int(gw.Status.RetryCount))
-	return ctrl.Result{RequeueAfter: backoffDuration}, nil
+	gw.Status.LastBackoff = &metav1.Duration{Duration: backoffDuration}
+	if err := r.updateStatus(ctx, gw); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.capToSchedulingDeadline(gw, backoffDuration)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *GPUWorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.Recorder = mgr.GetEventRecorderFor("gpuworkload-controller")
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&gpuv1alpha1.GPUWorkload{}).
-		Owns(&batchv1.Job{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
-		Complete(r)
+	// Only spec changes of workloads trigger a reconcile, but every status change of their
+	// Jobs must, since Job updates never bump the Job's generation
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&gpuv1alpha1.GPUWorkload{}, ctrlbuilder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&batchv1.Job{})
+
+	if r.PendingResyncPeriod > 0 {
+		events := make(chan event.GenericEvent)
+		if err := mgr.Add(&pendingResync{
+			client:    mgr.GetClient(),
+			log:       r.Log.WithName("pending-resync"),
+			period:    r.PendingResyncPeriod,
+			batchSize: r.PendingResyncBatchSize,
+			events:    events,
+			rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		}); err != nil {
+			return err
+		}
+		builder = builder.WatchesRawSource(&source.Channel{Source: events}, &handler.EnqueueRequestForObject{})
+	}
+
+	if r.StrategyBenchmarkPeriod > 0 {
+		if err := mgr.Add(&strategyBenchmark{
+			client:  mgr.GetClient(),
+			log:     r.Log.WithName("strategy-benchmark"),
+			period:  r.StrategyBenchmarkPeriod,
+			filter:  r.filterGPUNodes,
+			options: r.strategyOptions(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if r.DefragMode != "" && r.DefragMode != DefragOff && r.DefragPeriod > 0 {
+		events := make(chan event.GenericEvent)
+		if err := mgr.Add(&defragmenter{
+			reconciler: r,
+			log:        r.Log.WithName("defragmenter"),
+			period:     r.DefragPeriod,
+			mode:       r.DefragMode,
+			events:     events,
+		}); err != nil {
+			return err
+		}
+		builder = builder.WatchesRawSource(&source.Channel{Source: events}, &handler.EnqueueRequestForObject{})
+	}
+
+	if r.MigrationPeriod > 0 {
+		events := make(chan event.GenericEvent)
+		if err := mgr.Add(&migrator{
+			reconciler:     r,
+			log:            r.Log.WithName("migrator"),
+			period:         r.MigrationPeriod,
+			minImprovement: r.MigrationMinImprovement,
+			events:         events,
+		}); err != nil {
+			return err
+		}
+		builder = builder.WatchesRawSource(&source.Channel{Source: events}, &handler.EnqueueRequestForObject{})
+	}
+
+	if r.AllocationDriftPeriod > 0 && !r.Simulate {
+		if err := mgr.Add(&allocationDriftAuditor{
+			reconciler: r,
+			log:        r.Log.WithName("allocation-drift"),
+			period:     r.AllocationDriftPeriod,
+			threshold:  r.AllocationDriftThreshold,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if r.NodeMetricsPeriod > 0 {
+		if err := mgr.Add(&nodeMetricsRecorder{
+			reconciler: r,
+			log:        r.Log.WithName("node-metrics"),
+			period:     r.NodeMetricsPeriod,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if r.PriorityOrdering {
+		events := make(chan event.GenericEvent, queueEventsBuffer)
+		r.queueEvents = events
+		builder = builder.WatchesRawSource(&source.Channel{Source: events}, &handler.EnqueueRequestForObject{})
+	}
+
+	return builder.Complete(r)
 }
 
 // Utility functions
@@ -393,12 +1487,6 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
-func parseQuantity(value string) corev1.ResourceQuantity {
-	q := corev1.ResourceQuantity{}
-	q.Set(int64(len(value)))
-	return q
-}
-
 func isNodeReady(node *corev1.Node) bool {
 	for _, condition := range node.Status.Conditions {
 		if condition.Type == corev1.NodeReady {
@@ -408,12 +1496,36 @@ func isNodeReady(node *corev1.Node) bool {
 	return false
 }
 
+// controlPlaneRoles are the node-role keys that identify control-plane nodes, as labels or taints.
+var controlPlaneRoles = []string{
+	"node-role.kubernetes.io/control-plane",
+	"node-role.kubernetes.io/master",
+}
+
+func isControlPlaneNode(node *corev1.Node) bool {
+	for _, role := range controlPlaneRoles {
+		if _, exists := node.Labels[role]; exists {
+			return true
+		}
+		for _, taint := range node.Spec.Taints {
+			if taint.Key == role {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func hasGPUs(node *corev1.Node) bool {
-	// Check for nvidia.com/gpu resource
-	if quantity, ok := node.Status.Allocatable[corev1.ResourceName("nvidia.com/gpu")]; ok && quantity.Value() > 0 {
-		return true
+	// Check for the GPU resource of every supported vendor
+	for _, vendor := range scheduling.DefaultVendorPreference {
+		if scheduling.VendorGPUs(node, vendor) > 0 {
+			return true
+		}
 	}
-	if quantity, ok := node.Status.Capacity[corev1.ResourceName("nvidia.com/gpu")]; ok && quantity.Value() > 0 {
+
+	// Check for MIG partitions, advertised by annotation or as allocatable MIG resources
+	if len(scheduling.GetMIGSlices(node)) > 0 {
 		return true
 	}
 
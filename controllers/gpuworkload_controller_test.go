@@ -0,0 +1,887 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/approval"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+	"github.com/reyisjones/GPU_Orchestrator/internal/timewindow"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add client-go scheme: %v", err)
+	}
+	if err := gpuv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to add gpu scheme: %v", err)
+	}
+	return scheme
+}
+
+func newTestReconciler(t *testing.T, objs ...client.Object) *GPUWorkloadReconciler {
+	scheme := newTestScheme(t)
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&gpuv1alpha1.GPUWorkload{}).
+		Build()
+
+	return &GPUWorkloadReconciler{
+		Client:   fakeClient,
+		Log:      logr.Discard(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+}
+
+// recordedEvent is an event captured by capturingRecorder, including its target object.
+type recordedEvent struct {
+	object    runtime.Object
+	eventType string
+	reason    string
+	message   string
+}
+
+// capturingRecorder is an EventRecorder that keeps the object each event was emitted against.
+type capturingRecorder struct {
+	events []recordedEvent
+}
+
+var _ record.EventRecorder = &capturingRecorder{}
+
+func (c *capturingRecorder) Event(object runtime.Object, eventType, reason, message string) {
+	c.events = append(c.events, recordedEvent{object, eventType, reason, message})
+}
+
+func (c *capturingRecorder) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	c.Event(object, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (c *capturingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventType, reason, messageFmt string, args ...interface{}) {
+	c.Eventf(object, eventType, reason, messageFmt, args...)
+}
+
+// find returns the first captured event with the given reason.
+func (c *capturingRecorder) find(reason string) *recordedEvent {
+	for i := range c.events {
+		if c.events[i].reason == reason {
+			return &c.events[i]
+		}
+	}
+	return nil
+}
+
+func createTestWorkload(name string, gpuCount int32) *gpuv1alpha1.GPUWorkload {
+	return &gpuv1alpha1.GPUWorkload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "default",
+			UID:        types.UID(name + "-0123456789"),
+			Finalizers: []string{finalizerName},
+		},
+		Spec: gpuv1alpha1.GPUWorkloadSpec{
+			ModelName: "test-model",
+			GPUCount:  gpuCount,
+		},
+	}
+}
+
+func reconcileWorkload(t *testing.T, r *GPUWorkloadReconciler, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, *gpuv1alpha1.GPUWorkload) {
+	t.Helper()
+	key := types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	updated := &gpuv1alpha1.GPUWorkload{}
+	if err := r.Get(context.Background(), key, updated); err != nil {
+		t.Fatalf("unable to fetch GPUWorkload: %v", err)
+	}
+	return result, updated
+}
+
+func listJobs(t *testing.T, r *GPUWorkloadReconciler) []batchv1.Job {
+	t.Helper()
+	jobs := &batchv1.JobList{}
+	if err := r.List(context.Background(), jobs); err != nil {
+		t.Fatalf("unable to list jobs: %v", err)
+	}
+	return jobs.Items
+}
+
+func createGPUNode(name string, gpuCount int64) corev1.Node {
+	quantity := *resource.NewQuantity(gpuCount, resource.DecimalSI)
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceName("nvidia.com/gpu"): quantity,
+			},
+			Capacity: corev1.ResourceList{
+				corev1.ResourceName("nvidia.com/gpu"): quantity,
+			},
+			Conditions: []corev1.NodeCondition{
+				{
+					Type:   corev1.NodeReady,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
+	}
+}
+
+func nodeNames(nodes []corev1.Node) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+func TestFilterGPUNodes_ExcludesControlPlaneByDefault(t *testing.T) {
+	labeled := createGPUNode("cp-labeled", 4)
+	labeled.Labels = map[string]string{"node-role.kubernetes.io/control-plane": ""}
+
+	tainted := createGPUNode("master-tainted", 4)
+	tainted.Spec.Taints = []corev1.Taint{
+		{Key: "node-role.kubernetes.io/master", Effect: corev1.TaintEffectNoSchedule},
+	}
+
+	nodes := []corev1.Node{labeled, tainted, createGPUNode("worker", 2)}
+
+	r := &GPUWorkloadReconciler{}
+	filtered := r.filterGPUNodes(nodes)
+	if len(filtered) != 1 || filtered[0].Name != "worker" {
+		t.Errorf("Expected only worker to be eligible, got %v", nodeNames(filtered))
+	}
+
+	r.AllowControlPlaneNodes = true
+	filtered = r.filterGPUNodes(nodes)
+	if len(filtered) != 3 {
+		t.Errorf("Expected all nodes to be eligible when control-plane nodes are allowed, got %v", nodeNames(filtered))
+	}
+}
+
+func TestReconcile_LegacyStatusIsNotRescheduled(t *testing.T) {
+	legacy := createTestWorkload("legacy", 1)
+	legacy.Status = gpuv1alpha1.GPUWorkloadStatus{
+		AssignedNode: "node1",
+		JobName:      "legacy-job-legacy-0",
+	}
+	node := createGPUNode("node2", 8)
+
+	r := newTestReconciler(t, legacy, &node)
+	_, updated := reconcileWorkload(t, r, legacy)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected legacy workload to be marked Scheduled, got %q", updated.Status.Phase)
+	}
+	if updated.Status.AssignedNode != "node1" {
+		t.Errorf("Expected assigned node to be preserved, got %q", updated.Status.AssignedNode)
+	}
+
+	// A second reconcile must not place the workload again
+	_, updated = reconcileWorkload(t, r, legacy)
+	if updated.Status.AssignedNode != "node1" {
+		t.Errorf("Expected legacy workload to stay on node1, got %q", updated.Status.AssignedNode)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no new jobs for legacy workload, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_UnknownPhaseIsLeftUntouched(t *testing.T) {
+	gw := createTestWorkload("future", 1)
+	gw.Status.Phase = gpuv1alpha1.GPUWorkloadPhase("Hibernating")
+	node := createGPUNode("node1", 8)
+
+	r := newTestReconciler(t, gw, &node)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != "Hibernating" {
+		t.Errorf("Expected unknown phase to be preserved, got %q", updated.Status.Phase)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs for workload with unknown phase, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_InferredGPUCountOutOfRangeFails(t *testing.T) {
+	// 1000GB at the default 80GB per GPU needs 13 GPUs
+	gw := createTestWorkload("huge-model", 0)
+	gw.Spec.ModelName = "custom-model"
+	gw.Spec.ModelSizeGB = 1000
+	node := createGPUNode("node1", 16)
+
+	r := newTestReconciler(t, gw, &node)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "gpu_count_out_of_range" {
+		t.Fatalf("Expected Failed with reason gpu_count_out_of_range, got %q/%q", updated.Status.Phase, updated.Status.Reason)
+	}
+	if !strings.Contains(updated.Status.Message, "inferred gpuCount 13") {
+		t.Errorf("Expected the message to name the inferred count, got %q", updated.Status.Message)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no Job, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_DeletesWorkloadsWithUnmanagedStatus(t *testing.T) {
+	future := createTestWorkload("future", 1)
+	future.Status.Phase = gpuv1alpha1.GPUWorkloadPhase("Hibernating")
+	future.Status.JobName = "future-job"
+
+	legacy := createTestWorkload("legacy", 1)
+	legacy.Status = gpuv1alpha1.GPUWorkloadStatus{AssignedNode: "node1", JobName: "legacy-job"}
+
+	for _, gw := range []*gpuv1alpha1.GPUWorkload{future, legacy} {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: gw.Status.JobName, Namespace: gw.Namespace}}
+		r := newTestReconciler(t, gw, job)
+		if err := r.Delete(context.Background(), gw); err != nil {
+			t.Fatalf("unable to delete %s: %v", gw.Name, err)
+		}
+
+		key := types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}
+		if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("%s: Reconcile() error = %v", gw.Name, err)
+		}
+		if err := r.Get(context.Background(), key, &gpuv1alpha1.GPUWorkload{}); !apierrors.IsNotFound(err) {
+			t.Errorf("%s: expected the workload to be deleted in one reconcile, got %v", gw.Name, err)
+		}
+		if jobs := listJobs(t, r); len(jobs) != 0 {
+			t.Errorf("%s: expected its Job to be deleted, got %d", gw.Name, len(jobs))
+		}
+	}
+}
+
+func TestReconcile_GPUCountOutOfRangeFails(t *testing.T) {
+	// The fake client does not apply the CRD's validation, like a --validate=false apply
+	gw := createTestWorkload("too-many", 16)
+	node := createGPUNode("node1", 16)
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw, &node)
+	r.Recorder = recorder
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "gpu_count_out_of_range" {
+		t.Fatalf("Expected Failed with reason gpu_count_out_of_range, got %q/%q", updated.Status.Phase, updated.Status.Reason)
+	}
+	if !strings.Contains(updated.Status.Message, "16") {
+		t.Errorf("Expected the message to name the requested count, got %q", updated.Status.Message)
+	}
+	if recorder.find("InvalidSpec") == nil {
+		t.Errorf("Expected an InvalidSpec event, got %+v", recorder.events)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs for an out-of-range GPU count, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_MaintenanceWindowPausesScheduling(t *testing.T) {
+	window, err := timewindow.Parse("Sat 02:00-06:00")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	gw := createTestWorkload("maintenance", 1)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+	r.MaintenanceWindow = window
+
+	// Saturday 03:00 UTC is inside the window
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, time.January, 4, 3, 0, 0, 0, time.UTC))
+	r.Clock = fakeClock
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "maintenance_window" {
+		t.Errorf("Expected Pending with reason maintenance_window, got %q/%q", updated.Status.Phase, updated.Status.Reason)
+	}
+	if result.RequeueAfter != 3*time.Hour {
+		t.Errorf("Expected requeue at window end (3h), got %v", result.RequeueAfter)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs during maintenance window, got %d", len(jobs))
+	}
+
+	// Saturday 07:00 UTC is after the window
+	fakeClock.SetTime(time.Date(2025, time.January, 4, 7, 0, 0, 0, time.UTC))
+	_, updated = reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.Reason != "" {
+		t.Errorf("Expected Scheduled after maintenance window, got %q/%q", updated.Status.Phase, updated.Status.Reason)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 1 {
+		t.Errorf("Expected one job after maintenance window, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_EmitsNodeScopedPlacementEvent(t *testing.T) {
+	gw := createTestWorkload("placed", 2)
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw, &node)
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+
+	reconcileWorkload(t, r, gw)
+
+	event := recorder.find("GPUWorkloadPlaced")
+	if event == nil {
+		t.Fatalf("Expected a GPUWorkloadPlaced event, got %+v", recorder.events)
+	}
+	target, ok := event.object.(*corev1.Node)
+	if !ok || target.Name != "node1" {
+		t.Errorf("Expected GPUWorkloadPlaced event on node1, got %T %v", event.object, event.object)
+	}
+	if event.eventType != corev1.EventTypeNormal {
+		t.Errorf("Expected Normal event, got %s", event.eventType)
+	}
+}
+
+func TestReconcile_DegradesGPUCountOnRetry(t *testing.T) {
+	gw := createTestWorkload("degrade", 4)
+	gw.Spec.DegradeOnRetry = true
+	gw.Spec.MinGPUCount = 2
+	node := createGPUNode("node1", 2)
+
+	r := newTestReconciler(t, gw, &node)
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+
+	// 4 and then 3 GPUs never fit on a 2-GPU node
+	for attempt := 1; attempt <= 2; attempt++ {
+		_, updated := reconcileWorkload(t, r, gw)
+		if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.RetryCount != int32(attempt) {
+			t.Fatalf("Attempt %d: expected Pending with %d retries, got %q/%d", attempt, attempt, updated.Status.Phase, updated.Status.RetryCount)
+		}
+	}
+
+	// The third attempt is reduced to the 2-GPU floor and fits
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled with a degraded allocation, got %q: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.ScheduledOnAttempt != 2 {
+		t.Errorf("Expected placement to be recorded on attempt 2, got %d", updated.Status.ScheduledOnAttempt)
+	}
+	if updated.Status.AllocatedGPUCount != 2 || updated.Status.Reason != "degraded_allocation" {
+		t.Errorf("Expected degraded allocation of 2 GPUs, got %d (%q)", updated.Status.AllocatedGPUCount, updated.Status.Reason)
+	}
+	if event := recorder.find("DegradedAllocation"); event == nil || event.eventType != corev1.EventTypeWarning {
+		t.Errorf("Expected a DegradedAllocation warning event, got %+v", recorder.events)
+	}
+}
+
+func TestDegradedGPUCount(t *testing.T) {
+	gw := createTestWorkload("degrade", 4)
+	gw.Status.RetryCount = 5
+	if got := degradedGPUCount(gw); got != 4 {
+		t.Errorf("Expected full count without DegradeOnRetry, got %d", got)
+	}
+
+	gw.Spec.DegradeOnRetry = true
+	gw.Spec.MinGPUCount = 2
+	for retries, expected := range []int32{4, 3, 2, 2} {
+		gw.Status.RetryCount = int32(retries)
+		if got := degradedGPUCount(gw); got != expected {
+			t.Errorf("degradedGPUCount() after %d retries = %d, want %d", retries, got, expected)
+		}
+	}
+}
+
+func TestCreateJobForWorkload_RequireImageDigestForcesPullAlways(t *testing.T) {
+	gw := createTestWorkload("pinned", 1)
+	gw.Spec.Image = "ghcr.io/acme/llama@sha256:3b1f6c0f1c7f7c4e5b2c8d9a0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b"
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw)
+	r.RequireImageDigest = true
+
+	job, err := r.createJobForWorkload(gw, "", &node, 0)
+	if err != nil {
+		t.Fatalf("createJobForWorkload() error = %v", err)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != gw.Spec.Image {
+		t.Errorf("Expected image %q, got %q", gw.Spec.Image, container.Image)
+	}
+	if container.ImagePullPolicy != corev1.PullAlways {
+		t.Errorf("Expected imagePullPolicy Always, got %q", container.ImagePullPolicy)
+	}
+}
+
+func TestCreateJobForWorkload_BackoffLimitAndRestartPolicy(t *testing.T) {
+	node := createGPUNode("node1", 4)
+
+	gw := createTestWorkload("strict", 1)
+	r := newTestReconciler(t, gw)
+	job, err := r.createJobForWorkload(gw, "", &node, 0)
+	if err != nil {
+		t.Fatalf("createJobForWorkload() error = %v", err)
+	}
+	if *job.Spec.BackoffLimit != 0 || job.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyNever {
+		t.Errorf("Expected backoffLimit 0 and restartPolicy Never by default, got %d and %s",
+			*job.Spec.BackoffLimit, job.Spec.Template.Spec.RestartPolicy)
+	}
+
+	gw = createTestWorkload("tolerant", 1)
+	gw.Spec.JobBackoffLimit = int32Ptr(2)
+	gw.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	job, err = r.createJobForWorkload(gw, "", &node, 0)
+	if err != nil {
+		t.Fatalf("createJobForWorkload() error = %v", err)
+	}
+	if *job.Spec.BackoffLimit != 2 || job.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyOnFailure {
+		t.Errorf("Expected backoffLimit 2 and restartPolicy OnFailure, got %d and %s",
+			*job.Spec.BackoffLimit, job.Spec.Template.Spec.RestartPolicy)
+	}
+}
+
+func TestCreateJobForWorkload_AddsEnvAndVolumes(t *testing.T) {
+	gw := createTestWorkload("weights", 1)
+	gw.Spec.Env = []corev1.EnvVar{
+		{Name: "MODEL_NAME", Value: "meta-llama/Llama-2-7b"},
+		{Name: "HF_TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "hf"}, Key: "token"}}},
+	}
+	gw.Spec.Volumes = []corev1.Volume{{Name: "weights", VolumeSource: corev1.VolumeSource{
+		PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "llama-weights", ReadOnly: true}}}}
+	gw.Spec.VolumeMounts = []corev1.VolumeMount{{Name: "weights", MountPath: "/models", ReadOnly: true}}
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw)
+	job, err := r.createJobForWorkload(gw, "", &node, 0)
+	if err != nil {
+		t.Fatalf("createJobForWorkload() error = %v", err)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if !reflect.DeepEqual(podSpec.Volumes, gw.Spec.Volumes) {
+		t.Errorf("Expected volumes %v, got %v", gw.Spec.Volumes, podSpec.Volumes)
+	}
+	container := podSpec.Containers[0]
+	if !reflect.DeepEqual(container.VolumeMounts, gw.Spec.VolumeMounts) {
+		t.Errorf("Expected volume mounts %v, got %v", gw.Spec.VolumeMounts, container.VolumeMounts)
+	}
+	env := map[string]corev1.EnvVar{}
+	for _, e := range container.Env {
+		if _, ok := env[e.Name]; ok {
+			t.Errorf("Expected %s to be set once", e.Name)
+		}
+		env[e.Name] = e
+	}
+	if env["MODEL_NAME"].Value != "meta-llama/Llama-2-7b" {
+		t.Errorf("Expected the workload's MODEL_NAME to win, got %q", env["MODEL_NAME"].Value)
+	}
+	if env["HF_TOKEN"].ValueFrom == nil || env["GPU_COUNT"].Value != "1" {
+		t.Errorf("Expected HF_TOKEN from its secret next to GPU_COUNT, got %v", container.Env)
+	}
+}
+
+func TestCreateJobForWorkload_UsesSpecImageAndCommand(t *testing.T) {
+	gw := createTestWorkload("custom-image", 1)
+	gw.Spec.Image = "ghcr.io/acme/llama-serve:1.4"
+	gw.Spec.Command = []string{"python", "-m", "serve"}
+	gw.Spec.Args = []string{"--port", "8000"}
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw)
+	job, err := r.createJobForWorkload(gw, "", &node, 0)
+	if err != nil {
+		t.Fatalf("createJobForWorkload() error = %v", err)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Name != workloadContainerName || container.Image != gw.Spec.Image {
+		t.Errorf("Expected container %s to run %q, got %s running %q", workloadContainerName, gw.Spec.Image, container.Name, container.Image)
+	}
+	if !reflect.DeepEqual(container.Command, gw.Spec.Command) || !reflect.DeepEqual(container.Args, gw.Spec.Args) {
+		t.Errorf("Expected command %v with args %v, got %v with %v", gw.Spec.Command, gw.Spec.Args, container.Command, container.Args)
+	}
+}
+
+func TestCreateJobForWorkload_EmptyImageFallsBackToPlaceholder(t *testing.T) {
+	gw := createTestWorkload("placeholder", 1)
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw)
+	job, err := r.createJobForWorkload(gw, "", &node, 0)
+	if err != nil {
+		t.Fatalf("createJobForWorkload() error = %v", err)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != defaultWorkloadImage || container.Command != nil {
+		t.Errorf("Expected the placeholder image with its own entrypoint, got %q with command %v", container.Image, container.Command)
+	}
+}
+
+func TestReconcile_TerminationGracePeriodFlowsToPodAndDeletion(t *testing.T) {
+	gw := createTestWorkload("graceful", 1)
+	gw.Spec.TerminationGracePeriodSeconds = int64Ptr(120)
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw, &node)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	if grace := jobs[0].Spec.Template.Spec.TerminationGracePeriodSeconds; grace == nil || *grace != 120 {
+		t.Errorf("Expected the pod termination grace period to be 120s, got %v", grace)
+	}
+
+	var deleteOpts *client.DeleteOptions
+	r.Client = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if _, ok := obj.(*batchv1.Job); ok {
+				deleteOpts = (&client.DeleteOptions{}).ApplyOptions(opts)
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+	})
+
+	// The workload is placed, so deletion must not wait for its Job to finish
+	if err := r.Delete(context.Background(), updated); err != nil {
+		t.Fatalf("unable to delete workload: %v", err)
+	}
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(gw)}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	if deleteOpts == nil {
+		t.Fatal("Expected the workload's Job to be deleted")
+	}
+	if deleteOpts.GracePeriodSeconds == nil || *deleteOpts.GracePeriodSeconds != 120 {
+		t.Errorf("Expected the Job to be deleted with a 120s grace period, got %v", deleteOpts.GracePeriodSeconds)
+	}
+	if deleteOpts.PropagationPolicy == nil || *deleteOpts.PropagationPolicy != metav1.DeletePropagationBackground {
+		t.Errorf("Expected the Job's pods to be deleted with it, got propagation %v", deleteOpts.PropagationPolicy)
+	}
+	err := r.Get(context.Background(), client.ObjectKeyFromObject(gw), &gpuv1alpha1.GPUWorkload{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the finalizer to be removed and the workload deleted, got err = %v", err)
+	}
+}
+
+// deleteWithJobError places a workload, then deletes it with Job deletions failing with err.
+// It returns the workload, nil once it is gone, and the reconcile error.
+func deleteWithJobError(t *testing.T, err error) (*gpuv1alpha1.GPUWorkload, error) {
+	t.Helper()
+	gw := createTestWorkload("deleted", 1)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	r.Client = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			if _, ok := obj.(*batchv1.Job); ok {
+				return err
+			}
+			return c.Delete(ctx, obj, opts...)
+		},
+	})
+	if err := r.Delete(context.Background(), updated); err != nil {
+		t.Fatalf("unable to delete workload: %v", err)
+	}
+	_, reconcileErr := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(gw)})
+
+	remaining := &gpuv1alpha1.GPUWorkload{}
+	if err := r.Get(context.Background(), client.ObjectKeyFromObject(gw), remaining); apierrors.IsNotFound(err) {
+		return nil, reconcileErr
+	} else if err != nil {
+		t.Fatalf("unable to fetch GPUWorkload: %v", err)
+	}
+	return remaining, reconcileErr
+}
+
+func TestReconcile_JobDeletionErrorKeepsFinalizer(t *testing.T) {
+	remaining, err := deleteWithJobError(t, apierrors.NewServiceUnavailable("etcd leader changed"))
+	if err == nil {
+		t.Error("Expected the Job deletion error to be returned for a requeue")
+	}
+	if remaining == nil || !containsString(remaining.Finalizers, finalizerName) {
+		t.Errorf("Expected the finalizer to be kept while the Job cannot be deleted, got %+v", remaining)
+	}
+}
+
+func TestReconcile_JobAlreadyGoneRemovesFinalizer(t *testing.T) {
+	remaining, err := deleteWithJobError(t, apierrors.NewNotFound(batchv1.Resource("jobs"), "deleted-job"))
+	if err != nil {
+		t.Errorf("Expected a missing Job to be treated as deleted, got %v", err)
+	}
+	if remaining != nil {
+		t.Errorf("Expected the finalizer to be removed and the workload deleted, got %+v", remaining)
+	}
+}
+
+func TestReconcile_ApprovalGate(t *testing.T) {
+	approved := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !approved {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	gw := createTestWorkload("approval", 1)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+	r.Approver = approval.NewHTTPApprover(server.URL)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "awaiting_approval" {
+		t.Errorf("Expected Pending with reason awaiting_approval, got %q/%q", updated.Status.Phase, updated.Status.Reason)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs before approval, got %d", len(jobs))
+	}
+
+	approved = true
+	_, updated = reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected Scheduled after approval, got %q", updated.Status.Phase)
+	}
+}
+
+func TestReconcile_RecordsScheduledOnAttempt(t *testing.T) {
+	gw := createTestWorkload("attempts", 2)
+	small := createGPUNode("small", 1)
+	r := newTestReconciler(t, gw, &small)
+
+	// The only node is too small, so the first attempt fails
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.RetryCount != 1 {
+		t.Fatalf("Expected Pending with 1 retry, got %q/%d", updated.Status.Phase, updated.Status.RetryCount)
+	}
+
+	node := createGPUNode("large", 4)
+	if err := r.Create(context.Background(), &node); err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+
+	_, updated = reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %q: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.ScheduledOnAttempt != 1 {
+		t.Errorf("Expected ScheduledOnAttempt 1, got %d", updated.Status.ScheduledOnAttempt)
+	}
+}
+
+// createVendorGPUNode creates a Ready node exposing GPUs under the given vendor resource.
+func createVendorGPUNode(name string, resourceName corev1.ResourceName, gpuCount int64) corev1.Node {
+	node := createGPUNode(name, gpuCount)
+	quantity := *resource.NewQuantity(gpuCount, resource.DecimalSI)
+	node.Status.Allocatable = corev1.ResourceList{resourceName: quantity}
+	node.Status.Capacity = corev1.ResourceList{resourceName: quantity}
+	return node
+}
+
+func TestReconcile_AutoGPUVendorPicksAvailableVendor(t *testing.T) {
+	gw := createTestWorkload("auto-vendor", 2)
+	gw.Spec.GPUVendor = "auto"
+	amdNode := createVendorGPUNode("amd-node", "amd.com/gpu", 8)
+
+	r := newTestReconciler(t, gw, &amdNode)
+	r.GPUVendorPreference = []string{"nvidia", "amd"}
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %q: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.AssignedNode != "amd-node" || updated.Status.GPUVendor != "amd" {
+		t.Errorf("Expected placement on amd-node with vendor amd, got %s/%s", updated.Status.AssignedNode, updated.Status.GPUVendor)
+	}
+
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	requests := jobs[0].Spec.Template.Spec.Containers[0].Resources.Requests
+	if _, ok := requests["amd.com/gpu"]; !ok {
+		t.Errorf("Expected the job to request amd.com/gpu, got %v", requests)
+	}
+}
+
+func TestReconcile_PinnedGPUVendorIgnoresOtherVendors(t *testing.T) {
+	gw := createTestWorkload("pinned-vendor", 2)
+	gw.Spec.GPUVendor = "nvidia"
+	amdNode := createVendorGPUNode("amd-node", "amd.com/gpu", 8)
+
+	r := newTestReconciler(t, gw, &amdNode)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending {
+		t.Errorf("Expected Pending without NVIDIA capacity, got %q", updated.Status.Phase)
+	}
+}
+
+func TestReconcile_NamespaceSelector(t *testing.T) {
+	tests := []struct {
+		name            string
+		namespaceLabels map[string]string
+		expectedPhase   gpuv1alpha1.GPUWorkloadPhase
+		expectedReason  string
+	}{
+		{"approved namespace", map[string]string{"gpu.warp.dev/approved": "true"}, gpuv1alpha1.PhaseScheduled, ""},
+		{"unlabeled namespace", nil, gpuv1alpha1.PhasePending, "namespace_not_approved"},
+		{"rejected namespace", map[string]string{"gpu.warp.dev/approved": "false"}, gpuv1alpha1.PhasePending, "namespace_not_approved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: tt.namespaceLabels}}
+			gw := createTestWorkload("governed", 1)
+			node := createGPUNode("node1", 4)
+
+			r := newTestReconciler(t, gw, &node, namespace)
+			r.NamespaceSelector = labels.SelectorFromSet(labels.Set{"gpu.warp.dev/approved": "true"})
+
+			_, updated := reconcileWorkload(t, r, gw)
+			if updated.Status.Phase != tt.expectedPhase || updated.Status.Reason != tt.expectedReason {
+				t.Errorf("Expected %q/%q, got %q/%q", tt.expectedPhase, tt.expectedReason, updated.Status.Phase, updated.Status.Reason)
+			}
+		})
+	}
+}
+
+func TestReconcile_RecordsCanaryStrategyPath(t *testing.T) {
+	node := createGPUNode("node1", 8)
+	stable := createTestWorkload("stable", 1)
+	canary := createTestWorkload("canary", 1)
+
+	r := newTestReconciler(t, stable, canary, &node)
+	r.CanaryStrategy = "random"
+
+	r.CanaryPercent = 0
+	_, updated := reconcileWorkload(t, r, stable)
+	if updated.Status.Strategy != "leastLoaded" || updated.Status.CanaryStrategy {
+		t.Errorf("Expected the stable strategy at 0%%, got %q (canary %v)", updated.Status.Strategy, updated.Status.CanaryStrategy)
+	}
+
+	r.CanaryPercent = 100
+	_, updated = reconcileWorkload(t, r, canary)
+	if updated.Status.Strategy != "random" || !updated.Status.CanaryStrategy {
+		t.Errorf("Expected the canary strategy at 100%%, got %q (canary %v)", updated.Status.Strategy, updated.Status.CanaryStrategy)
+	}
+}
+
+func TestReconcile_DecorrelatedBackoffBuildsOnPreviousBackoff(t *testing.T) {
+	gw := createTestWorkload("decorrelated", 4)
+	gw.Spec.RetryPolicy = &gpuv1alpha1.RetryPolicy{MaxRetries: 10, BackoffSeconds: 10, BackoffMode: gpuv1alpha1.BackoffDecorrelated}
+	node := createGPUNode("node1", 2)
+	r := newTestReconciler(t, gw, &node)
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.LastBackoff == nil || updated.Status.LastBackoff.Duration != result.RequeueAfter {
+		t.Fatalf("Expected status to record the backoff of %v, got %v", result.RequeueAfter, updated.Status.LastBackoff)
+	}
+	if result.RequeueAfter < 10*time.Second || result.RequeueAfter > 30*time.Second {
+		t.Errorf("Expected the first backoff between 10s and 30s, got %v", result.RequeueAfter)
+	}
+
+	// The next backoff is drawn up to three times the previous one, not from the attempt number
+	updated.Status.LastBackoff = &metav1.Duration{Duration: 80 * time.Second}
+	if err := r.Status().Update(context.Background(), updated); err != nil {
+		t.Fatalf("unable to update workload status: %v", err)
+	}
+	result, updated = reconcileWorkload(t, r, updated)
+	if result.RequeueAfter < 10*time.Second || result.RequeueAfter > 240*time.Second {
+		t.Errorf("Expected a backoff between 10s and 240s, got %v", result.RequeueAfter)
+	}
+	if updated.Status.LastBackoff.Duration != result.RequeueAfter {
+		t.Errorf("Expected status to record the backoff of %v, got %v", result.RequeueAfter, updated.Status.LastBackoff)
+	}
+
+	// Scheduling clears the backoff
+	node.Status.Allocatable[corev1.ResourceName("nvidia.com/gpu")] = *resource.NewQuantity(8, resource.DecimalSI)
+	if err := r.Status().Update(context.Background(), &node); err != nil {
+		t.Fatalf("unable to update node: %v", err)
+	}
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.LastBackoff != nil {
+		t.Errorf("Expected Scheduled with no backoff, got %s with %v", updated.Status.Phase, updated.Status.LastBackoff)
+	}
+}
+
+func TestReconcile_ExponentialBackoffIsDefault(t *testing.T) {
+	gw := createTestWorkload("exponential", 4)
+	gw.Spec.RetryPolicy = &gpuv1alpha1.RetryPolicy{MaxRetries: 10, BackoffSeconds: 10}
+	gw.Status.RetryCount = 2
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	node := createGPUNode("node1", 2)
+	r := newTestReconciler(t, gw, &node)
+
+	// The third failure waits 10s * 2^3 plus up to 10% jitter
+	result, updated := reconcileWorkload(t, r, gw)
+	if result.RequeueAfter < 80*time.Second || result.RequeueAfter > 88*time.Second {
+		t.Errorf("Expected an exponential backoff between 80s and 88s, got %v", result.RequeueAfter)
+	}
+	if updated.Status.LastBackoff == nil || updated.Status.LastBackoff.Duration != result.RequeueAfter {
+		t.Errorf("Expected status to record the backoff of %v, got %v", result.RequeueAfter, updated.Status.LastBackoff)
+	}
+}
+
+func TestReconcile_MinGPUMemoryAppliesToEveryStrategy(t *testing.T) {
+	gw := createTestWorkload("large-model", 1)
+	gw.Spec.SchedulingStrategy = "random"
+	gw.Spec.MinGPUMemoryGB = 40
+	small := createGPUNode("small-memory", 8)
+	small.Labels = map[string]string{scheduling.GPUMemoryLabel: "24576"}
+	large := createGPUNode("large-memory", 2)
+	large.Labels = map[string]string{scheduling.GPUMemoryLabel: "81920"}
+	r := newTestReconciler(t, gw, &small, &large)
+
+	if _, updated := reconcileWorkload(t, r, gw); updated.Status.AssignedNode != "large-memory" {
+		t.Errorf("Expected the workload on large-memory, got %q", updated.Status.AssignedNode)
+	}
+}
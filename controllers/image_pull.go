@@ -0,0 +1,154 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// defaultImagePullFailureThreshold is how long a pod may fail to pull its image before the
+// workload is failed, when ImagePullFailureThreshold is unset.
+const defaultImagePullFailureThreshold = 2 * time.Minute
+
+// imagePullWaitingReasons are the container waiting reasons meaning the image cannot be pulled.
+var imagePullWaitingReasons = map[string]bool{
+	"ImagePullBackOff":  true,
+	"ErrImagePull":      true,
+	"InvalidImageName":  true,
+	"ErrImageNeverPull": true,
+}
+
+// imagePullFailure describes a container whose image cannot be pulled.
+type imagePullFailure struct {
+	image   string
+	reason  string
+	message string
+}
+
+// findImagePullFailure returns the first container of the pods waiting on an image that cannot be pulled.
+func findImagePullFailure(pods []corev1.Pod) (imagePullFailure, bool) {
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodPending {
+			continue
+		}
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			waiting := status.State.Waiting
+			if waiting == nil || !imagePullWaitingReasons[waiting.Reason] {
+				continue
+			}
+			return imagePullFailure{image: status.Image, reason: waiting.Reason, message: waiting.Message}, true
+		}
+	}
+	return imagePullFailure{}, false
+}
+
+// hasPendingPod reports whether any of the pods is Pending.
+func hasPendingPod(pods []corev1.Pod) bool {
+	for i := range pods {
+		if pods[i].Status.Phase == corev1.PodPending {
+			return true
+		}
+	}
+	return false
+}
+
+// failOnImagePull fails a Scheduled workload whose pods have been unable to pull an image for
+// longer than the threshold, counted from when the failure was first seen and recorded in
+// ImagePullFailingSince, deleting the Jobs of every replica so the GPUs are released. It
+// requeues for the rest of the threshold while the pull keeps failing, and returns false
+// when it did neither. Pod events are not watched, so while a pod is Pending the returned
+// result still polls for a pull that starts failing.
+func (r *GPUWorkloadReconciler) failOnImagePull(ctx context.Context, log logr.Logger, c client.Client, gw *gpuv1alpha1.GPUWorkload, jobs []*batchv1.Job) (ctrl.Result, bool, error) {
+	var pods []corev1.Pod
+	for _, job := range jobs {
+		jobPods, err := r.workloadPods(ctx, c, job)
+		if err != nil {
+			log.Error(err, "unable to read workload pod status")
+			return ctrl.Result{}, true, err
+		}
+		pods = append(pods, jobPods...)
+	}
+
+	threshold := r.ImagePullFailureThreshold
+	if threshold <= 0 {
+		threshold = defaultImagePullFailureThreshold
+	}
+	failure, found := findImagePullFailure(pods)
+	if !found {
+		if gw.Status.ImagePullFailingSince != nil {
+			gw.Status.ImagePullFailingSince = nil
+			if err := r.updateStatus(ctx, gw); err != nil {
+				log.Error(err, "unable to update GPUWorkload status")
+				return ctrl.Result{}, true, err
+			}
+		}
+		if !hasPendingPod(pods) {
+			return ctrl.Result{}, false, nil
+		}
+		return ctrl.Result{RequeueAfter: podStartPollInterval}, false, nil
+	}
+	if gw.Status.ImagePullFailingSince == nil {
+		gw.Status.ImagePullFailingSince = &metav1.Time{Time: r.now()}
+		if err := r.updateStatus(ctx, gw); err != nil {
+			log.Error(err, "unable to update GPUWorkload status")
+			return ctrl.Result{}, true, err
+		}
+	}
+	if remaining := gw.Status.ImagePullFailingSince.Add(threshold).Sub(r.now()); remaining > 0 {
+		log.V(1).Info("Workload image cannot be pulled yet", "image", failure.image, "reason", failure.reason, "remaining", remaining)
+		return ctrl.Result{RequeueAfter: remaining}, true, nil
+	}
+
+	if err := r.deleteJobs(ctx, gw, gw.Status.Cluster, jobs); err != nil {
+		log.Error(err, "unable to delete the workload's jobs")
+		return ctrl.Result{}, true, err
+	}
+
+	r.markFailed(gw, "image_pull_failed", fmt.Sprintf("Image %q could not be pulled (%s)", failure.image, failure.reason))
+	gw.Status.ImagePullFailingSince = nil
+	if failure.message != "" {
+		gw.Status.Message += ": " + failure.message
+	}
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, true, err
+	}
+
+	log.Info("GPUWorkload failed, image cannot be pulled", "image", failure.image, "reason", failure.reason, "job", gw.Status.JobName)
+	r.Recorder.Event(gw, corev1.EventTypeWarning, "ImagePullFailed", gw.Status.Message)
+	return r.failedResult(gw), true, nil
+}
+
+// soonerRequeue returns whichever result requeues first, ignoring results that do not requeue.
+func soonerRequeue(a, b ctrl.Result) ctrl.Result {
+	if a.RequeueAfter <= 0 || (b.RequeueAfter > 0 && b.RequeueAfter < a.RequeueAfter) {
+		return b
+	}
+	return a
+}
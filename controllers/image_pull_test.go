@@ -0,0 +1,190 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// createImagePullBackOffJob returns a Job for the workload whose pod, scheduled at
+// scheduledAt, cannot pull its image. The controller has seen the failure since scheduledAt.
+func createImagePullBackOffJob(gw *gpuv1alpha1.GPUWorkload, scheduledAt time.Time) (*batchv1.Job, *corev1.Pod) {
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: gw.Name + "-job", Namespace: gw.Namespace}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-abcde",
+			Namespace: gw.Namespace,
+			Labels:    map[string]string{"job-name": job.Name},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{{
+				Type:               corev1.PodScheduled,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(scheduledAt),
+			}},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  workloadContainerName,
+				Image: "registry.example.com/llama:missing",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "ImagePullBackOff",
+					Message: "Back-off pulling image",
+				}},
+			}},
+		},
+	}
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.JobName = job.Name
+	gw.Status.ImagePullFailingSince = &metav1.Time{Time: scheduledAt}
+	return job, pod
+}
+
+func TestReconcile_ImagePullBackOffFailsWorkload(t *testing.T) {
+	scheduledAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("bad-image", 1)
+	job, pod := createImagePullBackOffJob(gw, scheduledAt)
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw, job, pod)
+	r.Recorder = recorder
+	r.Clock = clocktesting.NewFakePassiveClock(scheduledAt.Add(3 * time.Minute))
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "image_pull_failed" {
+		t.Fatalf("Expected Failed with reason image_pull_failed, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if !strings.Contains(updated.Status.Message, "registry.example.com/llama:missing") {
+		t.Errorf("Expected the message to name the image, got %q", updated.Status.Message)
+	}
+	if event := recorder.find("ImagePullFailed"); event == nil || event.eventType != corev1.EventTypeWarning {
+		t.Errorf("Expected an ImagePullFailed warning event, got %+v", recorder.events)
+	}
+
+	err := r.Get(context.Background(), client.ObjectKeyFromObject(job), &batchv1.Job{})
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("Expected the Job to be deleted to release its GPUs, got err = %v", err)
+	}
+}
+
+func TestReconcile_ImagePullThresholdCountsFromFirstSeenFailure(t *testing.T) {
+	scheduledAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("slow-start", 1)
+	job, pod := createImagePullBackOffJob(gw, scheduledAt)
+	gw.Status.ImagePullFailingSince = nil
+
+	// The pod waited an hour for other reasons before its pull started failing
+	r := newTestReconciler(t, gw, job, pod)
+	now := scheduledAt.Add(time.Hour)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled when the failure is first seen, got %s", updated.Status.Phase)
+	}
+	if since := updated.Status.ImagePullFailingSince; since == nil || !since.Time.Equal(now) {
+		t.Errorf("Expected the failure to be recorded as seen at %v, got %v", now, since)
+	}
+	if result.RequeueAfter != defaultImagePullFailureThreshold {
+		t.Errorf("Expected a requeue after the full threshold, got %v", result.RequeueAfter)
+	}
+}
+
+func TestReconcile_ImagePullFailureDeletesEveryReplicaJob(t *testing.T) {
+	scheduledAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("bad-gang", 1)
+	job, pod := createImagePullBackOffJob(gw, scheduledAt)
+	gw.Status.ReplicaNodes = []string{"node-a", "node-b"}
+	replica := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-r1", Namespace: gw.Namespace}}
+
+	r := newTestReconciler(t, gw, job, replica, pod)
+	r.Clock = clocktesting.NewFakePassiveClock(scheduledAt.Add(3 * time.Minute))
+
+	if _, updated := reconcileWorkload(t, r, gw); updated.Status.Phase != gpuv1alpha1.PhaseFailed {
+		t.Fatalf("Expected Failed, got %s", updated.Status.Phase)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected the Jobs of every replica to be deleted, got %d left", len(jobs))
+	}
+}
+
+func TestReconcile_ImagePullBackOffWithinThreshold(t *testing.T) {
+	scheduledAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("pulling", 1)
+	job, pod := createImagePullBackOffJob(gw, scheduledAt)
+
+	r := newTestReconciler(t, gw, job, pod)
+	r.ImagePullFailureThreshold = 5 * time.Minute
+	r.Clock = clocktesting.NewFakePassiveClock(scheduledAt.Add(time.Minute))
+
+	result, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled within the threshold, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter != 4*time.Minute {
+		t.Errorf("Expected requeue after the remaining 4m of the threshold, got %v", result.RequeueAfter)
+	}
+}
+
+func TestReconcile_PendingPodPollsForImagePullFailure(t *testing.T) {
+	scheduledAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("pulling", 1)
+	job, pod := createImagePullBackOffJob(gw, scheduledAt)
+	gw.Status.ImagePullFailingSince = nil
+	pod.Status.ContainerStatuses[0].State.Waiting.Reason = "ContainerCreating"
+
+	r := newTestReconciler(t, gw, job, pod)
+	r.Clock = clocktesting.NewFakePassiveClock(scheduledAt.Add(30 * time.Second))
+
+	// Pod events are not watched, so the check must come back while the pod is Pending
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled while the pod is created, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter != podStartPollInterval {
+		t.Errorf("Expected a requeue after %v, got %v", podStartPollInterval, result.RequeueAfter)
+	}
+
+	// The pull starts failing in the meantime; the threshold counts from the next poll
+	pod.Status.ContainerStatuses[0].State.Waiting.Reason = "ErrImagePull"
+	if err := r.Status().Update(context.Background(), pod); err != nil {
+		t.Fatalf("unable to update pod: %v", err)
+	}
+	r.Clock = clocktesting.NewFakePassiveClock(scheduledAt.Add(2 * time.Minute))
+	if _, updated = reconcileWorkload(t, r, updated); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled when the failure is first seen, got %s", updated.Status.Phase)
+	}
+
+	r.Clock = clocktesting.NewFakePassiveClock(scheduledAt.Add(4 * time.Minute))
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "image_pull_failed" {
+		t.Errorf("Expected Failed with reason image_pull_failed, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+}
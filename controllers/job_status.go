@@ -0,0 +1,294 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// podStartPollInterval is how often a Scheduled workload whose Job is active checks whether
+// its pod has started running.
+const podStartPollInterval = 10 * time.Second
+
+// syncJobStatus follows a placed workload's Jobs: it moves the workload to Running once its
+// pod has warmed up, to Failed as soon as the Job of any replica fails or its image cannot
+// be pulled, and to Succeeded once the Jobs of every replica have completed. It runs on
+// every status change of the Jobs, which the controller watches as owned objects.
+func (r *GPUWorkloadReconciler) syncJobStatus(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	if gw.Status.JobName == "" {
+		return ctrl.Result{}, nil
+	}
+
+	c, err := r.clusterClient(gw.Status.Cluster)
+	if err != nil {
+		log.Error(err, "unable to reach the job's cluster")
+		return ctrl.Result{RequeueAfter: remoteJobPollInterval}, nil
+	}
+
+	jobs := placedJobs(gw)
+	for _, job := range jobs {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+			return r.failMissingJob(ctx, log, gw, job.Name)
+		}
+	}
+	job := jobs[0]
+
+	completed := 0
+	message := ""
+	for _, replicaJob := range jobs {
+		finished, jobSucceeded := jobFinished(replicaJob)
+		if !finished {
+			continue
+		}
+		if wait := r.phaseTransitionWait(replicaJob); wait > 0 {
+			log.V(1).Info("Waiting for the job's finished condition to hold", "job", replicaJob.Name, "remaining", wait)
+			return pollRemoteJob(gw, ctrl.Result{RequeueAfter: wait}), nil
+		}
+		succeeded, outcome, err := r.workloadOutcome(ctx, c, gw, replicaJob, jobSucceeded)
+		if err != nil {
+			log.Error(err, "unable to read workload exit code")
+			return ctrl.Result{}, err
+		}
+		if !succeeded {
+			// The other replicas cannot finish the work without this one, so free their GPUs
+			if err := r.deleteJobs(ctx, gw, gw.Status.Cluster, unfinishedJobs(jobs)); err != nil {
+				log.Error(err, "unable to delete the workload's jobs")
+				return ctrl.Result{}, err
+			}
+			return r.finishWorkload(ctx, log, c, gw, replicaJob, false, outcome)
+		}
+		if message == "" {
+			message = outcome
+		}
+		completed++
+	}
+	if completed == len(jobs) {
+		return r.finishWorkload(ctx, log, c, gw, job, true, message)
+	}
+
+	if gw.Status.Phase == gpuv1alpha1.PhaseScheduled {
+		pullResult, handled, err := r.failOnImagePull(ctx, log, c, gw, jobs)
+		if handled {
+			return pullResult, err
+		}
+		result, err := r.syncWarmup(ctx, log, c, gw, job)
+		if gw.Status.Phase == gpuv1alpha1.PhaseScheduled {
+			result = soonerRequeue(result, pullResult)
+		}
+		return pollRemoteJob(gw, result), err
+	}
+	return pollRemoteJob(gw, ctrl.Result{}), nil
+}
+
+// unfinishedJobs returns the Jobs that have neither completed nor failed.
+func unfinishedJobs(jobs []*batchv1.Job) []*batchv1.Job {
+	var unfinished []*batchv1.Job
+	for _, job := range jobs {
+		if finished, _ := jobFinished(job); !finished {
+			unfinished = append(unfinished, job)
+		}
+	}
+	return unfinished
+}
+
+// finishWorkload moves the workload to Succeeded or Failed once its Jobs have decided the
+// outcome, with job the Job that decided it.
+func (r *GPUWorkloadReconciler) finishWorkload(ctx context.Context, log logr.Logger, c client.Reader, gw *gpuv1alpha1.GPUWorkload, job *batchv1.Job, succeeded bool, message string) (ctrl.Result, error) {
+	eventType, reason := corev1.EventTypeNormal, "Succeeded"
+	gw.Status.Phase = gpuv1alpha1.PhaseSucceeded
+	gw.Status.Reason = ""
+	gw.Status.Message = message
+	if !succeeded {
+		eventType, reason = corev1.EventTypeWarning, "Failed"
+		r.markFailed(gw, "workload_failed", message)
+	}
+
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("GPUWorkload finished", "phase", gw.Status.Phase, "job", job.Name)
+	r.recordNodeOutcome(gw, succeeded)
+	r.notifyCompletion(ctx, log, c, gw, job)
+	r.Recorder.Event(gw, eventType, reason, message)
+	if !succeeded {
+		return r.failedResult(gw), nil
+	}
+	return r.finishedResult(gw), nil
+}
+
+// failMissingJob fails a placed workload one of whose Jobs was deleted by someone else, so
+// it does not stay Scheduled or Running forever. A Job the cache has not seen yet is waited for.
+func (r *GPUWorkloadReconciler) failMissingJob(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, jobName string) (ctrl.Result, error) {
+	if gw.Status.Cluster == "" {
+		err := r.apiReader().Get(ctx, types.NamespacedName{Name: jobName, Namespace: gw.Namespace}, &batchv1.Job{})
+		if err == nil {
+			return ctrl.Result{RequeueAfter: podStartPollInterval}, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	r.markFailed(gw, "job_missing", fmt.Sprintf("Job %s no longer exists", jobName))
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("GPUWorkload failed, its job no longer exists", "job", jobName)
+	r.Recorder.Event(gw, corev1.EventTypeWarning, "JobMissing", gw.Status.Message)
+	return r.failedResult(gw), nil
+}
+
+// apiReader returns the reader bypassing the cache, or the cached client when none is set.
+func (r *GPUWorkloadReconciler) apiReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// recordNodeOutcome counts the finished workload against the local nodes it ran on, for
+// the reliabilityWeighted and healthScore strategies.
+func (r *GPUWorkloadReconciler) recordNodeOutcome(gw *gpuv1alpha1.GPUWorkload, succeeded bool) {
+	tracker := r.StrategyOptions.ReliabilityWeighted.Tracker
+	if tracker == nil || gw.Status.Cluster != "" || gw.Status.CPUFallback {
+		return
+	}
+	for _, node := range placedNodes(gw) {
+		if node != "" {
+			tracker.RecordOutcome(node, succeeded)
+		}
+	}
+}
+
+// syncWarmup moves a Scheduled workload to Running once its pod has been running for
+// the workload's warmup period, requeueing until then.
+func (r *GPUWorkloadReconciler) syncWarmup(ctx context.Context, log logr.Logger, c client.Reader, gw *gpuv1alpha1.GPUWorkload, job *batchv1.Job) (ctrl.Result, error) {
+	startedAt, running, err := r.workloadStartTime(ctx, c, job)
+	if err != nil {
+		log.Error(err, "unable to read workload pod status")
+		return ctrl.Result{}, err
+	}
+	if !running {
+		// Pods are not watched, so poll while the Job's pod is still starting
+		if job.Status.Active > 0 {
+			return ctrl.Result{RequeueAfter: podStartPollInterval}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+
+	warmup := time.Duration(0)
+	if gw.Spec.WarmupSeconds != nil {
+		warmup = time.Duration(*gw.Spec.WarmupSeconds) * time.Second
+	}
+	if remaining := startedAt.Add(warmup).Sub(r.now()); remaining > 0 {
+		log.V(1).Info("Workload warming up", "remaining", remaining)
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.Message = fmt.Sprintf("Workload running on node %s", gw.Status.AssignedNode)
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("GPUWorkload running", "job", job.Name)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "Running", gw.Status.Message)
+	return ctrl.Result{}, nil
+}
+
+// jobFinished reports whether the Job has finished and, if so, whether it completed successfully.
+func jobFinished(job *batchv1.Job) (finished bool, succeeded bool) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, true
+		case batchv1.JobFailed:
+			return true, false
+		}
+	}
+	return false, false
+}
+
+// phaseTransitionWait returns how much longer the finished Job's condition must hold before
+// the workload follows it, per PhaseTransitionDelay.
+func (r *GPUWorkloadReconciler) phaseTransitionWait(job *batchv1.Job) time.Duration {
+	if r.PhaseTransitionDelay <= 0 {
+		return 0
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue || (condition.Type != batchv1.JobComplete && condition.Type != batchv1.JobFailed) {
+			continue
+		}
+		if condition.LastTransitionTime.IsZero() {
+			return 0
+		}
+		return condition.LastTransitionTime.Add(r.PhaseTransitionDelay).Sub(r.now())
+	}
+	return 0
+}
+
+// workloadPods lists the pods created for the Job in the cluster c reads from.
+func (r *GPUWorkloadReconciler) workloadPods(ctx context.Context, c client.Reader, job *batchv1.Job) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// workloadStartTime returns when the workload container of a running Job pod started.
+func (r *GPUWorkloadReconciler) workloadStartTime(ctx context.Context, c client.Reader, job *batchv1.Job) (time.Time, bool, error) {
+	pods, err := r.workloadPods(ctx, c, job)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == workloadContainerName && status.State.Running != nil {
+				return status.State.Running.StartedAt.Time, true, nil
+			}
+		}
+	}
+	return time.Time{}, false, nil
+}
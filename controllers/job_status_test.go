@@ -0,0 +1,357 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// createFinishedJob returns a Job for the workload with the given terminal condition
+// and a pod whose workload container exited with exitCode.
+func createFinishedJob(gw *gpuv1alpha1.GPUWorkload, condition batchv1.JobConditionType, exitCode int32) (*batchv1.Job, *corev1.Pod) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: gw.Name + "-job", Namespace: gw.Namespace},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: condition, Status: corev1.ConditionTrue}},
+		},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-abcde",
+			Namespace: gw.Namespace,
+			Labels:    map[string]string{"job-name": job.Name},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: workloadContainerName,
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+				},
+			}},
+		},
+	}
+	return job, pod
+}
+
+func TestSetupWithManager_JobStatusUpdatesReachReconcile(t *testing.T) {
+	gw := createTestWorkload("watched", 1)
+	job, pod := createFinishedJob(gw, batchv1.JobComplete, 0)
+	job.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: gpuv1alpha1.GroupVersion.String(),
+		Kind:       "GPUWorkload",
+		Name:       gw.Name,
+		UID:        gw.UID,
+		Controller: boolPtr(true),
+	}}
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.JobName = job.Name
+
+	// Run the controller as registered by SetupWithManager, fed by fake informers
+	r := newTestReconciler(t, gw, job, pod)
+	informers := &informertest.FakeInformers{Scheme: r.Scheme}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range r.Scheme.AllKnownTypes() {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+	mgr, err := ctrl.NewManager(&rest.Config{Host: "http://127.0.0.1:1"}, ctrl.Options{
+		Scheme:  r.Scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		NewCache: func(*rest.Config, cache.Options) (cache.Cache, error) {
+			return informers, nil
+		},
+		NewClient: func(*rest.Config, client.Options) (client.Client, error) {
+			return r.Client, nil
+		},
+		MapperProvider: func(*rest.Config, *http.Client) (meta.RESTMapper, error) {
+			return mapper, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create manager: %v", err)
+	}
+	if err := r.SetupWithManager(mgr); err != nil {
+		t.Fatalf("SetupWithManager() error = %v", err)
+	}
+	r.Recorder = record.NewFakeRecorder(100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if _, err := informers.FakeInformerFor(ctx, &gpuv1alpha1.GPUWorkload{}); err != nil {
+		t.Fatalf("unable to get workload informer: %v", err)
+	}
+	jobInformer, err := informers.FakeInformerFor(ctx, &batchv1.Job{})
+	if err != nil {
+		t.Fatalf("unable to get job informer: %v", err)
+	}
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("manager stopped: %v", err)
+		}
+	}()
+
+	// The Job finishing is a status update that leaves its generation unchanged
+	running := job.DeepCopy()
+	running.Status = batchv1.JobStatus{Active: 1}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		jobInformer.Update(running, job)
+		updated := &gpuv1alpha1.GPUWorkload{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(gw), updated); err != nil {
+			t.Fatalf("unable to fetch GPUWorkload: %v", err)
+		}
+		if updated.Status.Phase == gpuv1alpha1.PhaseSucceeded {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the Job update to move the workload to Succeeded, still %s", updated.Status.Phase)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestReconcile_FailedJobIsNotRescheduled(t *testing.T) {
+	gw := createTestWorkload("failed-job", 1)
+	node := createGPUNode("node1", 4)
+	job, pod := createFinishedJob(gw, batchv1.JobFailed, 1)
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = job.Name
+	gw.Status.AssignedNode = node.Name
+
+	r := newTestReconciler(t, gw, &node, job, pod)
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed {
+		t.Fatalf("Expected Failed once the Job failed, got %s", updated.Status.Phase)
+	}
+
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.JobName != job.Name {
+		t.Errorf("Expected the workload to stay Failed with its Job, got %s/%q", updated.Status.Phase, updated.Status.JobName)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 1 {
+		t.Errorf("Expected no new Job to be created, got %d jobs", len(jobs))
+	}
+}
+
+func TestReconcile_RunningJobKeepsPhase(t *testing.T) {
+	gw := createTestWorkload("running", 1)
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "running-job", Namespace: gw.Namespace}}
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.JobName = job.Name
+
+	r := newTestReconciler(t, gw, job)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected phase to stay Scheduled while the Job runs, got %s", updated.Status.Phase)
+	}
+}
+
+func TestReconcile_DeletedJobFailsWorkload(t *testing.T) {
+	gw := createTestWorkload("deleted-job", 1)
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = "deleted-job-job"
+	gw.Status.AssignedNode = "node1"
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw)
+	r.Recorder = recorder
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "job_missing" {
+		t.Errorf("Expected Failed with reason job_missing, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if event := recorder.find("JobMissing"); event == nil {
+		t.Errorf("Expected a JobMissing event, got %+v", recorder.events)
+	}
+}
+
+func TestReconcile_JobMissingFromCacheIsWaitedFor(t *testing.T) {
+	gw := createTestWorkload("lagging", 1)
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "lagging-job", Namespace: gw.Namespace}}
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.JobName = job.Name
+
+	// The cache has not seen the Job yet while the API server already has it
+	r := newTestReconciler(t, gw)
+	r.APIReader = newTestReconciler(t, job).Client
+	result, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected to stay Scheduled while the cache catches up, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter == 0 {
+		t.Error("Expected a requeue while the cache catches up")
+	}
+}
+
+func TestReconcile_StartingPodIsPolled(t *testing.T) {
+	gw := createTestWorkload("starting", 1)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "starting-job", Namespace: gw.Namespace},
+		Status:     batchv1.JobStatus{Active: 1},
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "starting-job-abcde", Namespace: gw.Namespace, Labels: map[string]string{"job-name": job.Name}},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.JobName = job.Name
+
+	// Pod events are not watched, so the Running transition relies on the requeue
+	r := newTestReconciler(t, gw, job, pod)
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled while the pod starts, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter != podStartPollInterval {
+		t.Errorf("Expected a requeue after %v while the pod starts, got %v", podStartPollInterval, result.RequeueAfter)
+	}
+}
+
+func TestReconcile_WarmupDelaysRunning(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clk := clocktesting.NewFakePassiveClock(start.Add(30 * time.Second))
+
+	gw := createTestWorkload("warmup", 1)
+	gw.Spec.WarmupSeconds = int32Ptr(60)
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "warmup-job", Namespace: gw.Namespace}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "warmup-job-abcde", Namespace: gw.Namespace, Labels: map[string]string{"job-name": job.Name}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  workloadContainerName,
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(start)}},
+			}},
+		},
+	}
+	gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gw.Status.JobName = job.Name
+
+	r := newTestReconciler(t, gw, job, pod)
+	r.Clock = clk
+
+	// 30s into a 60s warmup the workload stays Scheduled and is requeued for the rest
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled during warmup, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter != 30*time.Second {
+		t.Errorf("Expected requeue after the remaining 30s of warmup, got %v", result.RequeueAfter)
+	}
+
+	clk.SetTime(start.Add(60 * time.Second))
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Errorf("Expected Running once warmup elapsed, got %s", updated.Status.Phase)
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestReconcile_FinishedJobsFeedNodeReliability(t *testing.T) {
+	tracker := scheduling.NewReliabilityTracker()
+	for _, condition := range []batchv1.JobConditionType{batchv1.JobFailed, batchv1.JobComplete} {
+		gw := createTestWorkload("outcome", 1)
+		job, pod := createFinishedJob(gw, condition, 0)
+		gw.Status.Phase = gpuv1alpha1.PhaseRunning
+		gw.Status.JobName = job.Name
+		gw.Status.AssignedNode = "node1"
+
+		r := newTestReconciler(t, gw, job, pod)
+		r.StrategyOptions.ReliabilityWeighted.Tracker = tracker
+		reconcileWorkload(t, r, gw)
+	}
+
+	// One failure and one success leave node1 at the rate of a node without history
+	if rate := tracker.SuccessRate("node1"); rate != 0.5 {
+		t.Errorf("Expected a success rate of 0.5, got %v", rate)
+	}
+	tracker.RecordOutcome("node1", true)
+	if rate := tracker.SuccessRate("node1"); rate != 0.6 {
+		t.Errorf("Expected a success rate of 0.6 after another success, got %v", rate)
+	}
+}
+
+func TestReconcile_PhaseTransitionDelayIgnoresTransientJobFailure(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("flapping", 1)
+	job, pod := createFinishedJob(gw, batchv1.JobFailed, 1)
+	job.Status.Conditions[0].LastTransitionTime = metav1.NewTime(now.Add(-5 * time.Second))
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = job.Name
+
+	r := newTestReconciler(t, gw, job, pod)
+	r.Clock = clocktesting.NewFakePassiveClock(now)
+	r.PhaseTransitionDelay = 30 * time.Second
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Fatalf("Expected a brief Job failure to keep the workload Running, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter != 25*time.Second {
+		t.Errorf("Expected a requeue once the failure held for 30s, got %v", result.RequeueAfter)
+	}
+
+	// The Job recovers before the delay elapses
+	job.Status.Conditions = nil
+	job.Status.Active = 1
+	if err := r.Status().Update(context.Background(), job); err != nil {
+		t.Fatalf("Failed to update job: %v", err)
+	}
+	r.Clock = clocktesting.NewFakePassiveClock(now.Add(30 * time.Second))
+	if _, updated = reconcileWorkload(t, r, updated); updated.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Fatalf("Expected the workload to stay Running once the Job recovered, got %s", updated.Status.Phase)
+	}
+
+	// A failure that holds for the delay fails the workload
+	job.Status.Conditions = []batchv1.JobCondition{{
+		Type: batchv1.JobFailed, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(now.Add(30 * time.Second)),
+	}}
+	if err := r.Status().Update(context.Background(), job); err != nil {
+		t.Fatalf("Failed to update job: %v", err)
+	}
+	r.Clock = clocktesting.NewFakePassiveClock(now.Add(time.Minute))
+	if _, updated = reconcileWorkload(t, r, updated); updated.Status.Phase != gpuv1alpha1.PhaseFailed {
+		t.Errorf("Expected a lasting Job failure to fail the workload, got %s", updated.Status.Phase)
+	}
+}
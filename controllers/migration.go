@@ -0,0 +1,196 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// defaultMigrationMinImprovement is how many more GPUs a node must leave free around a
+// workload than its current node for the workload to migrate to it.
+const defaultMigrationMinImprovement = 2
+
+// migrator periodically moves Running workloads that allow migration off crowded nodes. A
+// workload was often placed on a busy node because nothing better was free at the time; when
+// another node would leave at least minImprovement more GPUs free around it, the workload is
+// returned to Pending with that node nominated. Each pass moves at most one workload, and
+// none whose eviction a PodDisruptionBudget forbids. It implements manager.Runnable.
+type migrator struct {
+	reconciler     *GPUWorkloadReconciler
+	log            logr.Logger
+	period         time.Duration
+	minImprovement int64
+	events         chan<- event.GenericEvent
+}
+
+// Start runs the migration loop until the context is cancelled.
+func (m *migrator) Start(ctx context.Context) error {
+	ticker := time.NewTicker(m.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := m.runOnce(ctx); err != nil {
+			m.log.Error(err, "migration pass aborted")
+		}
+	}
+}
+
+// runOnce migrates the first workload, by name, that has a sufficiently better node.
+func (m *migrator) runOnce(ctx context.Context) error {
+	r := m.reconciler
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return err
+	}
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return err
+	}
+
+	eligible := r.filterGPUNodes(nodes.Items)
+	withFree, err := r.withoutPlacedGPUs(ctx, "", eligible, nil)
+	if err != nil {
+		return err
+	}
+	free := make(map[string]int64, len(withFree))
+	for i := range withFree {
+		free[withFree[i].Name] = r.capacity().AvailableGPUs(&withFree[i])
+	}
+
+	candidates := migrationCandidates(workloads.Items)
+	for _, gw := range candidates {
+		target, improvement, ok := m.betterNode(gw, eligible, free)
+		if !ok {
+			continue
+		}
+		moves := []defragMove{{workload: gw, target: target}}
+		blocked, err := r.disruptionBlocked(ctx, moves)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			m.log.Info("Not migrating workload, a PodDisruptionBudget forbids evicting it",
+				"gpuworkload", client.ObjectKeyFromObject(gw), "target", target)
+			continue
+		}
+		return m.migrate(ctx, gw, target, improvement)
+	}
+	return nil
+}
+
+// migrationCandidates returns the Running workloads that allow migration, ordered by
+// namespace and name. Workloads on remote clusters, on CPU, using MIG slices or spanning
+// several nodes are left alone.
+func migrationCandidates(workloads []gpuv1alpha1.GPUWorkload) []*gpuv1alpha1.GPUWorkload {
+	var candidates []*gpuv1alpha1.GPUWorkload
+	for i := range workloads {
+		gw := &workloads[i]
+		if !gw.Spec.AllowMigration || gw.DeletionTimestamp != nil || gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			continue
+		}
+		if gw.Status.AssignedNode == "" || gw.Status.Cluster != "" || gw.Status.CPUFallback ||
+			gw.Spec.MIGProfile != "" || len(gw.Status.ReplicaNodes) > 1 {
+			continue
+		}
+		candidates = append(candidates, gw)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Namespace != candidates[j].Namespace {
+			return candidates[i].Namespace < candidates[j].Namespace
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	return candidates
+}
+
+// betterNode returns the fitting node that would leave the most GPUs free around the
+// workload, and how many more that is than its current node leaves. It returns false when
+// no node improves on the current one by at least minImprovement GPUs.
+func (m *migrator) betterNode(gw *gpuv1alpha1.GPUWorkload, eligible []corev1.Node, free map[string]int64) (string, int64, bool) {
+	current := findNode(eligible, gw.Status.AssignedNode)
+	if current == nil {
+		return "", 0, false
+	}
+	gpus := int64(placedGPUs(gw))
+
+	best, bestFree := "", int64(-1)
+	for i := range eligible {
+		target := &eligible[i]
+		if target.Name == current.Name || target.Annotations[DefragmentAnnotation] == "true" {
+			continue
+		}
+		if nodeGPUVendor(target) != nodeGPUVendor(current) || !matchesNodeSelector(target, gw) {
+			continue
+		}
+		left := free[target.Name] - gpus
+		if left < 0 {
+			continue
+		}
+		if left > bestFree || (left == bestFree && target.Name < best) {
+			best, bestFree = target.Name, left
+		}
+	}
+
+	minImprovement := m.minImprovement
+	if minImprovement < 1 {
+		minImprovement = defaultMigrationMinImprovement
+	}
+	improvement := bestFree - free[current.Name]
+	if best == "" || improvement < minImprovement {
+		return "", 0, false
+	}
+	return best, improvement, true
+}
+
+// migrate releases the workload for rescheduling onto the target. Its Job is deleted with the
+// workload's termination grace period, so it can shut down before being placed again.
+func (m *migrator) migrate(ctx context.Context, gw *gpuv1alpha1.GPUWorkload, target string, improvement int64) error {
+	r := m.reconciler
+	log := m.log.WithValues("gpuworkload", client.ObjectKeyFromObject(gw), "target", target)
+	source := gw.Status.AssignedNode
+
+	gw.Status.NominatedNode = target
+	message := fmt.Sprintf("Migrating from node %s to %s, which leaves %d more GPUs free", source, target, improvement)
+	if err := r.releasePlacement(ctx, log, gw, "migrated", message); err != nil {
+		return err
+	}
+	log.Info("Migrating workload to a better node", "source", source, "improvement", improvement)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "Migrated", message)
+	if m.events != nil {
+		select {
+		case m.events <- event.GenericEvent{Object: gw}:
+		case <-ctx.Done():
+		}
+	}
+	return nil
+}
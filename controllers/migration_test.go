@@ -0,0 +1,142 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// newMigrationTestReconciler returns a reconciler with a full 4-GPU node-a running the
+// migratable "mover" next to "neighbour", and the given extra objects.
+func newMigrationTestReconciler(t *testing.T, extra ...client.Object) *GPUWorkloadReconciler {
+	t.Helper()
+	nodeA := createGPUNode("node-a", 4)
+	mover, jobM, podM := createPlacedWorkload("mover", "node-a", 2)
+	mover.Spec.AllowMigration = true
+	neighbour, jobN, podN := createPlacedWorkload("neighbour", "node-a", 2)
+	objs := append([]client.Object{&nodeA, mover, jobM, podM, neighbour, jobN, podN}, extra...)
+	return newTestReconciler(t, objs...)
+}
+
+func TestMigrator_MovesToBetterNode(t *testing.T) {
+	nodeB := createGPUNode("node-b", 8)
+	r := newMigrationTestReconciler(t, &nodeB)
+	m := &migrator{reconciler: r, log: r.Log, minImprovement: 2}
+
+	if err := m.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	moved := getWorkload(t, r, "mover")
+	if moved.Status.Phase != gpuv1alpha1.PhasePending || moved.Status.Reason != "migrated" {
+		t.Fatalf("Expected mover to be released for migration, got phase %s reason %q", moved.Status.Phase, moved.Status.Reason)
+	}
+	if moved.Status.NominatedNode != "node-b" {
+		t.Errorf("Expected mover to be nominated onto node-b, got %q", moved.Status.NominatedNode)
+	}
+	if stayed := getWorkload(t, r, "neighbour"); stayed.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Errorf("Expected neighbour, which does not allow migration, to keep running, got %s", stayed.Status.Phase)
+	}
+
+	_, rescheduled := reconcileWorkload(t, r, moved)
+	if rescheduled.Status.AssignedNode != "node-b" {
+		t.Errorf("Expected mover to be placed on node-b, got %q", rescheduled.Status.AssignedNode)
+	}
+}
+
+func TestMigrator_RequiresMinimumImprovement(t *testing.T) {
+	tests := []struct {
+		name           string
+		nodeBGPUs      int64
+		minImprovement int64
+		expectMove     bool
+	}{
+		{"improvement above threshold", 8, 2, true},
+		{"improvement at threshold", 4, 2, true},
+		{"improvement below threshold", 4, 3, false},
+		{"target without room", 1, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodeB := createGPUNode("node-b", tt.nodeBGPUs)
+			r := newMigrationTestReconciler(t, &nodeB)
+			m := &migrator{reconciler: r, log: r.Log, minImprovement: tt.minImprovement}
+
+			if err := m.runOnce(context.Background()); err != nil {
+				t.Fatalf("runOnce() error = %v", err)
+			}
+			moved := getWorkload(t, r, "mover").Status.Phase == gpuv1alpha1.PhasePending
+			if moved != tt.expectMove {
+				t.Errorf("Expected migration %v, got %v", tt.expectMove, moved)
+			}
+		})
+	}
+}
+
+func TestMigrator_RespectsPodDisruptionBudgets(t *testing.T) {
+	nodeB := createGPUNode("node-b", 8)
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-model", Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test-model"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+	}
+	r := newMigrationTestReconciler(t, &nodeB, pdb)
+	m := &migrator{reconciler: r, log: r.Log}
+
+	if err := m.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if gw := getWorkload(t, r, "mover"); gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Errorf("Expected the disruption budget to keep mover running, got %s", gw.Status.Phase)
+	}
+}
+
+func TestMigrationCandidates(t *testing.T) {
+	running := func(name string, mutate func(gw *gpuv1alpha1.GPUWorkload)) gpuv1alpha1.GPUWorkload {
+		gw, _, _ := createPlacedWorkload(name, "node-a", 1)
+		gw.Spec.AllowMigration = true
+		mutate(gw)
+		return *gw
+	}
+	workloads := []gpuv1alpha1.GPUWorkload{
+		running("b-eligible", func(gw *gpuv1alpha1.GPUWorkload) {}),
+		running("a-eligible", func(gw *gpuv1alpha1.GPUWorkload) {}),
+		running("opted-out", func(gw *gpuv1alpha1.GPUWorkload) { gw.Spec.AllowMigration = false }),
+		running("scheduled", func(gw *gpuv1alpha1.GPUWorkload) { gw.Status.Phase = gpuv1alpha1.PhaseScheduled }),
+		running("remote", func(gw *gpuv1alpha1.GPUWorkload) { gw.Status.Cluster = "burst" }),
+		running("mig", func(gw *gpuv1alpha1.GPUWorkload) { gw.Spec.MIGProfile = "1g.10gb" }),
+		running("gang", func(gw *gpuv1alpha1.GPUWorkload) { gw.Status.ReplicaNodes = []string{"node-a", "node-b"} }),
+	}
+
+	candidates := migrationCandidates(workloads)
+	if len(candidates) != 2 || candidates[0].Name != "a-eligible" || candidates[1].Name != "b-eligible" {
+		names := []string{}
+		for _, gw := range candidates {
+			names = append(names, gw.Name)
+		}
+		t.Errorf("Expected [a-eligible b-eligible], got %v", names)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// modelConcurrencyRecheckInterval is how often workloads held back by their model's
+// concurrency limit check it again.
+const modelConcurrencyRecheckInterval = 30 * time.Second
+
+// ParseModelConcurrencyLimits parses per-model concurrency limits of the form
+// "llama2-70b=2,mixtral=4" into a map of model name to limit. A limit of 0 lifts the
+// global limit for the model.
+func ParseModelConcurrencyLimits(value string) (map[string]int32, error) {
+	limits := map[string]int32{}
+	if strings.TrimSpace(value) == "" {
+		return limits, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		name, limitStr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid model concurrency entry %q, expected model=limit", entry)
+		}
+		limit, err := strconv.ParseInt(limitStr, 10, 32)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid concurrency limit %q for model %q", limitStr, name)
+		}
+		limits[name] = int32(limit)
+	}
+	return limits, nil
+}
+
+// modelConcurrencyLimit returns how many workloads of the model may be placed at once,
+// or 0 when they are not limited.
+func (r *GPUWorkloadReconciler) modelConcurrencyLimit(model string) int32 {
+	if limit, ok := r.ModelConcurrencyLimits[model]; ok {
+		return limit
+	}
+	return r.MaxConcurrentPerModel
+}
+
+// modelConcurrencyExceeded reports whether the workload's model already has as many placed
+// workloads, Scheduled or Running, as its concurrency limit allows, with a message for the
+// workload status.
+func (r *GPUWorkloadReconciler) modelConcurrencyExceeded(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) (string, bool, error) {
+	limit := r.modelConcurrencyLimit(gw.Spec.ModelName)
+	if limit <= 0 {
+		return "", false, nil
+	}
+
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return "", false, err
+	}
+	var running int32
+	for i := range workloads.Items {
+		other := &workloads.Items[i]
+		if other.UID == gw.UID || other.Spec.ModelName != gw.Spec.ModelName {
+			continue
+		}
+		if other.Status.Phase == gpuv1alpha1.PhaseScheduled || other.Status.Phase == gpuv1alpha1.PhaseRunning {
+			running++
+		}
+	}
+	if running < limit {
+		return "", false, nil
+	}
+	return fmt.Sprintf("%d workloads of model %s are already running, the limit is %d", running, gw.Spec.ModelName, limit), true, nil
+}
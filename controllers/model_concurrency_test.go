@@ -0,0 +1,127 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// placedModelWorkload returns a workload of the model already placed on node1 in the given phase.
+func placedModelWorkload(name, model string, phase gpuv1alpha1.GPUWorkloadPhase) *gpuv1alpha1.GPUWorkload {
+	gw := createTestWorkload(name, 1)
+	gw.Spec.ModelName = model
+	gw.Status = gpuv1alpha1.GPUWorkloadStatus{Phase: phase, AssignedNode: "node1", JobName: name + "-job"}
+	return gw
+}
+
+func TestReconcile_ModelConcurrencyLimitKeepsExcessPending(t *testing.T) {
+	scheduled := placedModelWorkload("llama-1", "llama2", gpuv1alpha1.PhaseScheduled)
+	running := placedModelWorkload("llama-2", "llama2", gpuv1alpha1.PhaseRunning)
+	finished := placedModelWorkload("llama-3", "llama2", gpuv1alpha1.PhaseSucceeded)
+	excess := createTestWorkload("llama-4", 1)
+	excess.Spec.ModelName = "llama2"
+	otherModel := createTestWorkload("mixtral-1", 1)
+	otherModel.Spec.ModelName = "mixtral"
+	node := createGPUNode("node1", 8)
+
+	r := newTestReconciler(t, scheduled, running, finished, excess, otherModel, &node)
+	r.MaxConcurrentPerModel = 2
+
+	result, updated := reconcileWorkload(t, r, excess)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "model_concurrency_limit" {
+		t.Errorf("Expected Pending with reason model_concurrency_limit, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if result.RequeueAfter != modelConcurrencyRecheckInterval {
+		t.Errorf("Expected requeue after %v, got %v", modelConcurrencyRecheckInterval, result.RequeueAfter)
+	}
+	if updated.Status.RetryCount != 0 {
+		t.Errorf("Expected the limit not to count as a retry, got %d", updated.Status.RetryCount)
+	}
+
+	// Other models are counted separately
+	_, updated = reconcileWorkload(t, r, otherModel)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected the mixtral workload to be scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 1 {
+		t.Errorf("Expected only the mixtral job to be created, got %d jobs", len(jobs))
+	}
+}
+
+func TestReconcile_ModelConcurrencyLimitFreesUpWhenWorkloadFinishes(t *testing.T) {
+	running := placedModelWorkload("llama-1", "llama2", gpuv1alpha1.PhaseRunning)
+	waiting := createTestWorkload("llama-2", 1)
+	waiting.Spec.ModelName = "llama2"
+	node := createGPUNode("node1", 8)
+
+	r := newTestReconciler(t, running, waiting, &node)
+	r.MaxConcurrentPerModel = 1
+
+	if _, updated := reconcileWorkload(t, r, waiting); updated.Status.Reason != "model_concurrency_limit" {
+		t.Fatalf("Expected the second workload to wait, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+
+	running.Status.Phase = gpuv1alpha1.PhaseSucceeded
+	if err := r.Status().Update(context.Background(), running); err != nil {
+		t.Fatalf("unable to update workload status: %v", err)
+	}
+	if _, updated := reconcileWorkload(t, r, waiting); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected the waiting workload to be scheduled once the first finished, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+}
+
+func TestReconcile_PerModelConcurrencyLimitOverridesGlobal(t *testing.T) {
+	running := placedModelWorkload("llama-1", "llama2", gpuv1alpha1.PhaseRunning)
+	limited := createTestWorkload("llama-2", 1)
+	limited.Spec.ModelName = "llama2"
+	unlimited := createTestWorkload("embedder-1", 1)
+	unlimited.Spec.ModelName = "embedder"
+	runningEmbedder := placedModelWorkload("embedder-0", "embedder", gpuv1alpha1.PhaseRunning)
+	node := createGPUNode("node1", 8)
+
+	r := newTestReconciler(t, running, limited, unlimited, runningEmbedder, &node)
+	r.MaxConcurrentPerModel = 5
+	r.ModelConcurrencyLimits = map[string]int32{"llama2": 1, "embedder": 0}
+
+	if _, updated := reconcileWorkload(t, r, limited); updated.Status.Reason != "model_concurrency_limit" {
+		t.Errorf("Expected llama2 to be held at its own limit of 1, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+
+	r.MaxConcurrentPerModel = 1
+	if _, updated := reconcileWorkload(t, r, unlimited); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected the unlimited embedder to be scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+}
+
+func TestParseModelConcurrencyLimits(t *testing.T) {
+	limits, err := ParseModelConcurrencyLimits("llama2-70b=2, embedder=0")
+	if err != nil {
+		t.Fatalf("ParseModelConcurrencyLimits() error = %v", err)
+	}
+	if len(limits) != 2 || limits["llama2-70b"] != 2 || limits["embedder"] != 0 {
+		t.Errorf("Unexpected limits: %v", limits)
+	}
+
+	for _, value := range []string{"llama2", "=2", "llama2=-1", "llama2=many"} {
+		if _, err := ParseModelConcurrencyLimits(value); err == nil {
+			t.Errorf("Expected an error for %q", value)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// Node labels describing GPUs, as published by NVIDIA GPU feature discovery, plus the
+// orchestrator's own NVLink topology label.
+const (
+	gpuProductLabel     = "nvidia.com/gpu.product"
+	gpuCountLabel       = "nvidia.com/gpu.count"
+	gpuMemoryLabel      = "nvidia.com/gpu.memory"
+	migStrategyLabel    = "nvidia.com/mig.strategy"
+	nvlinkTopologyLabel = "gpu-orchestrator/nvlink-topology"
+)
+
+// nodeGPUInfo summarizes the GPUs of a node from its labels and annotations. The count
+// falls back to the node's allocatable GPUs when feature discovery does not label it.
+func nodeGPUInfo(node *corev1.Node) *gpuv1alpha1.NodeGPUInfo {
+	info := &gpuv1alpha1.NodeGPUInfo{
+		Product:        node.Labels[gpuProductLabel],
+		MIGStrategy:    node.Labels[migStrategyLabel],
+		NVLinkTopology: node.Labels[nvlinkTopologyLabel],
+	}
+
+	if count, err := strconv.ParseInt(node.Labels[gpuCountLabel], 10, 32); err == nil && count > 0 {
+		info.Count = int32(count)
+	} else {
+		info.Count = int32(allocatableGPUs(node))
+	}
+	if memory, err := strconv.ParseInt(node.Labels[gpuMemoryLabel], 10, 64); err == nil && memory > 0 {
+		info.MemoryMB = memory
+	}
+	if slices := scheduling.GetMIGSlices(node); len(slices) > 0 {
+		info.MIGSlices = slices
+	}
+	return info
+}
+
+// propagatedNodeLabels returns the node's labels among keys, or nil when it has none of them.
+func propagatedNodeLabels(node *corev1.Node, keys []string) map[string]string {
+	var propagated map[string]string
+	for _, key := range keys {
+		value, ok := node.Labels[key]
+		if !ok {
+			continue
+		}
+		if propagated == nil {
+			propagated = map[string]string{}
+		}
+		propagated[key] = value
+	}
+	return propagated
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+func TestReconcile_RecordsAssignedNodeGPUInfo(t *testing.T) {
+	gw := createTestWorkload("info", 2)
+	node := createGPUNode("a100-node", 8)
+	node.Labels = map[string]string{
+		gpuProductLabel:     "NVIDIA-A100-SXM4-80GB",
+		gpuCountLabel:       "8",
+		gpuMemoryLabel:      "81920",
+		migStrategyLabel:    "mixed",
+		nvlinkTopologyLabel: "NV12",
+	}
+	node.Annotations = map[string]string{scheduling.MIGSlicesAnnotation: "1g.10gb=7"}
+
+	r := newTestReconciler(t, gw, &node)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	want := &gpuv1alpha1.NodeGPUInfo{
+		Product:        "NVIDIA-A100-SXM4-80GB",
+		Count:          8,
+		MemoryMB:       81920,
+		MIGStrategy:    "mixed",
+		MIGSlices:      map[string]int64{"1g.10gb": 7},
+		NVLinkTopology: "NV12",
+	}
+	if !reflect.DeepEqual(updated.Status.AssignedNodeGPUInfo, want) {
+		t.Errorf("Expected assigned node GPU info %+v, got %+v", want, updated.Status.AssignedNodeGPUInfo)
+	}
+
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected one job, got %d", len(jobs))
+	}
+	found := false
+	for _, env := range jobs[0].Spec.Template.Spec.Containers[0].Env {
+		if env.Name == "GPU_PRODUCT" && env.Value == "NVIDIA-A100-SXM4-80GB" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected the job to expose the GPU product to the workload")
+	}
+}
+
+func TestNodeGPUInfo_FallsBackToAllocatableCount(t *testing.T) {
+	node := createGPUNode("unlabeled", 4)
+	info := nodeGPUInfo(&node)
+	if info.Count != 4 || info.Product != "" || info.MIGSlices != nil {
+		t.Errorf("Expected only the allocatable count for an unlabeled node, got %+v", info)
+	}
+
+	var empty corev1.Node
+	if info := nodeGPUInfo(&empty); info.Count != 0 {
+		t.Errorf("Expected no GPUs for a node without GPUs, got %+v", info)
+	}
+}
+
+func TestReconcile_PropagatesConfiguredNodeLabels(t *testing.T) {
+	gw := createTestWorkload("labels", 1)
+	node := createGPUNode("zoned-node", 4)
+	node.Labels = map[string]string{
+		"topology.kubernetes.io/zone":      "us-east-1a",
+		"node.kubernetes.io/instance-type": "p4d.24xlarge",
+		"kubernetes.io/hostname":           "zoned-node",
+	}
+
+	r := newTestReconciler(t, gw, &node)
+	r.PropagatedNodeLabels = []string{"topology.kubernetes.io/zone", "node.kubernetes.io/instance-type", gpuProductLabel}
+	_, updated := reconcileWorkload(t, r, gw)
+
+	want := map[string]string{
+		"topology.kubernetes.io/zone":      "us-east-1a",
+		"node.kubernetes.io/instance-type": "p4d.24xlarge",
+	}
+	if !reflect.DeepEqual(updated.Status.NodeLabels, want) {
+		t.Errorf("Expected node labels %v, got %v", want, updated.Status.NodeLabels)
+	}
+}
+
+func TestReconcile_NoNodeLabelsPropagatedByDefault(t *testing.T) {
+	gw := createTestWorkload("no-labels", 1)
+	node := createGPUNode("zoned-node", 4)
+	node.Labels = map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}
+
+	r := newTestReconciler(t, gw, &node)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.NodeLabels != nil {
+		t.Errorf("Expected no node labels, got %v", updated.Status.NodeLabels)
+	}
+}
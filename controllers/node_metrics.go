@@ -0,0 +1,152 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// nodeMetricsRecorder periodically refreshes the per-node GPU gauges, which scheduling
+// otherwise only updates when it places a workload, so they follow workloads that finish
+// or are deleted while nothing is pending. It implements manager.Runnable.
+type nodeMetricsRecorder struct {
+	reconciler *GPUWorkloadReconciler
+	log        logr.Logger
+	period     time.Duration
+}
+
+// Start runs the refresh loop until the context is cancelled.
+func (n *nodeMetricsRecorder) Start(ctx context.Context) error {
+	ticker := time.NewTicker(n.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := n.runOnce(ctx); err != nil {
+			n.log.Error(err, "unable to refresh node GPU metrics")
+		}
+	}
+}
+
+// runOnce publishes the allocation and available GPUs of every local GPU node.
+func (n *nodeMetricsRecorder) runOnce(ctx context.Context) error {
+	r := n.reconciler
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return err
+	}
+	r.recordNodeGPUMetrics(ctx, n.log, nodes.Items)
+
+	gpuNodes := r.filterGPUNodes(nodes.Items)
+	pods, err := nodePods(ctx, r.Client, gpuNodes)
+	if err != nil {
+		return err
+	}
+	if r.serializePlacements() {
+		if gpuNodes, err = r.withoutPlacedGPUs(ctx, "", gpuNodes, pods); err != nil {
+			return err
+		}
+	}
+	r.recordNodeAvailableGPUs(nodes.Items, gpuNodes, pods)
+	return nil
+}
+
+// recordNodeGPUMetrics publishes the per-node GPU allocation gauges.
+func (r *GPUWorkloadReconciler) recordNodeGPUMetrics(ctx context.Context, log logr.Logger, nodes []corev1.Node) {
+	m := metrics.GetMetrics()
+	if m == nil {
+		return
+	}
+
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		log.Error(err, "unable to list GPUWorkloads for node metrics")
+		return
+	}
+	m.UpdateNodeGPUAllocation(nodeGPUAllocation(nodes, placedIn(workloads.Items, "")))
+}
+
+// nodeGPUAllocation computes the allocatable and requested GPUs of every GPU node.
+// Non-GPU nodes are left out to bound metric cardinality.
+func nodeGPUAllocation(nodes []corev1.Node, workloads []gpuv1alpha1.GPUWorkload) map[string]metrics.NodeGPUAllocation {
+	allocation := make(map[string]metrics.NodeGPUAllocation)
+	for i := range nodes {
+		if !hasGPUs(&nodes[i]) {
+			continue
+		}
+		allocation[nodes[i].Name] = metrics.NodeGPUAllocation{Allocatable: allocatableGPUs(&nodes[i])}
+	}
+
+	for i := range workloads {
+		gw := &workloads[i]
+		if gw.Status.Phase != gpuv1alpha1.PhaseScheduled && gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			continue
+		}
+		for _, node := range placedNodes(gw) {
+			a, ok := allocation[node]
+			if !ok {
+				continue
+			}
+			a.Requested += int64(placedGPUs(gw))
+			allocation[node] = a
+		}
+	}
+	return allocation
+}
+
+// placedGPUs returns the GPUs a placed workload holds on its node.
+func placedGPUs(gw *gpuv1alpha1.GPUWorkload) int32 {
+	if gw.Status.AllocatedGPUCount > 0 {
+		return gw.Status.AllocatedGPUCount
+	}
+	return gw.RequestedGPUCount()
+}
+
+// recordNodeAvailableGPUs publishes the GPUs the strategies see as available on each evaluated
+// GPU node, after the GPUs of the pods bound to it. Listed GPU nodes that were filtered out,
+// such as NotReady or cordoned ones, have none available. Series of nodes that leave the
+// cluster are removed along with their allocation gauges.
+func (r *GPUWorkloadReconciler) recordNodeAvailableGPUs(nodes, evaluated []corev1.Node, pods scheduling.NodePods) {
+	m := metrics.GetMetrics()
+	if m == nil {
+		return
+	}
+
+	capacity := pods.Capacity(r.capacity())
+	available := make(map[string]int64, len(evaluated))
+	for i := range evaluated {
+		available[evaluated[i].Name] = capacity.AvailableGPUs(&evaluated[i])
+	}
+	for i := range nodes {
+		if hasGPUs(&nodes[i]) {
+			m.SetNodeAvailableGPUs(nodes[i].Name, float64(available[nodes[i].Name]))
+		}
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+func TestNodeGPUAllocation(t *testing.T) {
+	cpuNode := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cpu"}}
+	nodes := []corev1.Node{createGPUNode("node1", 8), createGPUNode("node2", 4), cpuNode}
+
+	scheduled := *createTestWorkload("scheduled", 4)
+	scheduled.Status = gpuv1alpha1.GPUWorkloadStatus{Phase: gpuv1alpha1.PhaseScheduled, AssignedNode: "node1", AllocatedGPUCount: 2}
+
+	running := *createTestWorkload("running", 3)
+	running.Status = gpuv1alpha1.GPUWorkloadStatus{Phase: gpuv1alpha1.PhaseRunning, AssignedNode: "node1"}
+
+	finished := *createTestWorkload("finished", 4)
+	finished.Status = gpuv1alpha1.GPUWorkloadStatus{Phase: gpuv1alpha1.PhaseSucceeded, AssignedNode: "node2", AllocatedGPUCount: 4}
+
+	got := nodeGPUAllocation(nodes, []gpuv1alpha1.GPUWorkload{scheduled, running, finished})
+
+	want := map[string]metrics.NodeGPUAllocation{
+		"node1": {Allocatable: 8, Requested: 5},
+		"node2": {Allocatable: 4, Requested: 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected allocation for %d GPU nodes, got %v", len(want), got)
+	}
+	for node, expected := range want {
+		if got[node] != expected {
+			t.Errorf("Node %s: expected %+v, got %+v", node, expected, got[node])
+		}
+	}
+}
+
+// gpuRequestsFor reads warp_gpuworkload_gpu_requests_total for a resource from the registry.
+func gpuRequestsFor(t *testing.T, resource string) float64 {
+	t.Helper()
+	families, err := ctrlmetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("unable to gather metrics: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != metrics.GPURequestsTotalName {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "resource" && label.GetValue() == resource {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+func TestReconcile_RecordsRequestedGPUResource(t *testing.T) {
+	migNode := createGPUNode("mig-node", 8)
+	migNode.Annotations = map[string]string{scheduling.MIGSlicesAnnotation: "1g.5gb=7"}
+
+	tests := []struct {
+		name     string
+		vendor   string
+		profile  string
+		node     corev1.Node
+		resource string
+	}{
+		{"default vendor", "", "", createGPUNode("nvidia-node", 4), "nvidia.com/gpu"},
+		{"pinned amd", "amd", "", createVendorGPUNode("amd-node", "amd.com/gpu", 4), "amd.com/gpu"},
+		{"auto resolves to intel", "auto", "", createVendorGPUNode("intel-node", "gpu.intel.com/i915", 4), "gpu.intel.com/i915"},
+		{"mig profile", "", "1g.5gb", migNode, "nvidia.com/mig-1g.5gb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := createTestWorkload("gpu-request", 1)
+			gw.Spec.GPUVendor = tt.vendor
+			gw.Spec.MIGProfile = tt.profile
+			node := tt.node
+
+			r := newTestReconciler(t, gw, &node)
+			before := gpuRequestsFor(t, tt.resource)
+			_, updated := reconcileWorkload(t, r, gw)
+			if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+				t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+			}
+
+			if got := gpuRequestsFor(t, tt.resource) - before; got != 1 {
+				t.Errorf("Expected one request recorded for %s, got %v", tt.resource, got)
+			}
+		})
+	}
+}
+
+func TestReconcile_RecordsNodeAvailableGPUs(t *testing.T) {
+	gw := createTestWorkload("available-gpus", 1)
+	busy := createGPUNode("busy-node", 8)
+	idle := createGPUNode("idle-node", 4)
+	notReady := createGPUNode("not-ready-node", 4)
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	other := createGPUPod("other-team", "busy-node", 6)
+
+	r := newTestReconciler(t, gw, &busy, &idle, &notReady, other)
+	defer metrics.GetMetrics().UpdateNodeGPUAllocation(nil)
+	reconcileWorkload(t, r, gw)
+
+	available := func(node string) float64 {
+		return testutil.ToFloat64(metrics.GetMetrics().NodeAvailableGPUs.WithLabelValues(node))
+	}
+	if got := available("busy-node"); got != 2 {
+		t.Errorf("Expected 2 available GPUs on busy-node after the pod's 6, got %v", got)
+	}
+	if got := available("idle-node"); got != 4 {
+		t.Errorf("Expected 4 available GPUs on idle-node, got %v", got)
+	}
+	if got := available("not-ready-node"); got != 0 {
+		t.Errorf("Expected no available GPUs on the NotReady node, got %v", got)
+	}
+}
+
+func TestNodeMetricsRecorder_TracksFinishedWorkloads(t *testing.T) {
+	node := createGPUNode("node1", 8)
+	gw := createTestWorkload("finishing", 2)
+	gw.Status = gpuv1alpha1.GPUWorkloadStatus{Phase: gpuv1alpha1.PhaseRunning, AssignedNode: "node1", AllocatedGPUCount: 2}
+
+	r := newTestReconciler(t, gw, &node)
+	defer metrics.GetMetrics().UpdateNodeGPUAllocation(nil)
+	recorder := &nodeMetricsRecorder{reconciler: r, log: r.Log}
+	requested := func() float64 {
+		return testutil.ToFloat64(metrics.GetMetrics().NodeGPURequested.WithLabelValues("node1"))
+	}
+
+	if err := recorder.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if got := requested(); got != 2 {
+		t.Fatalf("Expected 2 requested GPUs on node1, got %v", got)
+	}
+
+	// No workload is pending, so only the recorder notices the GPUs were released
+	gw.Status.Phase = gpuv1alpha1.PhaseSucceeded
+	if err := r.Status().Update(context.Background(), gw); err != nil {
+		t.Fatalf("unable to update GPUWorkload status: %v", err)
+	}
+	if err := recorder.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+	if got := requested(); got != 0 {
+		t.Errorf("Expected no requested GPUs on node1 once the workload finished, got %v", got)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// maxRejectedNodesInEvent caps how many nodes the rejection summary event lists.
+const maxRejectedNodesInEvent = 10
+
+// nodeRejection reports why a node is excluded from GPU scheduling, or false when it is eligible.
+func (r *GPUWorkloadReconciler) nodeRejection(node *corev1.Node) (scheduling.RejectionReason, bool) {
+	switch {
+	case !hasGPUs(node):
+		return scheduling.RejectionNoGPUs, true
+	case !isNodeReady(node):
+		return scheduling.RejectionNotReady, true
+	case r.isNodeUnderPressure(node):
+		return scheduling.RejectionUnderPressure, true
+	case node.Spec.Unschedulable:
+		return scheduling.RejectionCordoned, true
+	case !r.AllowControlPlaneNodes && isControlPlaneNode(node):
+		return scheduling.RejectionControlPlane, true
+	case hasBlockingTaint(node):
+		return scheduling.RejectionTainted, true
+	}
+	return "", false
+}
+
+// hasBlockingTaint reports whether a node carries a taint the workload's pod cannot live with.
+// Pods are bound with NodeName, so NoSchedule taints are not enforced by the scheduler, but
+// NoExecute taints still evict them and the autoscaler's deletion taint means the node is going away.
+func hasBlockingTaint(node *corev1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectNoExecute || taint.Key == autoscalerDeletionTaint {
+			return true
+		}
+	}
+	return false
+}
+
+// pressureConditions are the node conditions that, when true, get new pods evicted.
+var pressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+}
+
+// isNodeUnderPressure reports whether the node reports a pressure condition that is not ignored.
+func (r *GPUWorkloadReconciler) isNodeUnderPressure(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue || !slices.Contains(pressureConditions, condition.Type) {
+			continue
+		}
+		if !slices.Contains(r.IgnoredPressureConditions, condition.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePressureConditions parses a comma-separated list of node pressure conditions.
+func ParsePressureConditions(value string) ([]corev1.NodeConditionType, error) {
+	var conditions []corev1.NodeConditionType
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		condition := corev1.NodeConditionType(name)
+		if !slices.Contains(pressureConditions, condition) {
+			return nil, fmt.Errorf("unknown node pressure condition %q", name)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// matchesNodeSelector reports whether the node carries every label of the workload's node selector.
+func matchesNodeSelector(node *corev1.Node, gw *gpuv1alpha1.GPUWorkload) bool {
+	return labels.SelectorFromSet(gw.Spec.NodeSelector).Matches(labels.Set(node.Labels))
+}
+
+// selectedNodes returns the nodes matching the workload's node selector.
+func selectedNodes(nodes []corev1.Node, gw *gpuv1alpha1.GPUWorkload) []corev1.Node {
+	if len(gw.Spec.NodeSelector) == 0 {
+		return nodes
+	}
+	var selected []corev1.Node
+	for _, node := range nodes {
+		if matchesNodeSelector(&node, gw) {
+			selected = append(selected, node)
+		}
+	}
+	return selected
+}
+
+// rejectionSummary explains, per node, why none of the nodes could host the workload, in the
+// style of the scheduler's "0/N nodes are available" message. Eligible nodes are judged on the
+// capacity in gpuNodes, which may be reduced by in-flight placements, less the GPUs of their
+// pods, and unfit holds the eligible nodes lacking CPU or memory. At most maxRejectedNodesInEvent nodes are named;
+// nodes without GPUs are only counted.
+func (r *GPUWorkloadReconciler) rejectionSummary(nodes, gpuNodes []corev1.Node, pods scheduling.NodePods, unfit map[string]string, gw *gpuv1alpha1.GPUWorkload) string {
+	eligible := make(map[string]*corev1.Node, len(gpuNodes))
+	for i := range gpuNodes {
+		eligible[gpuNodes[i].Name] = &gpuNodes[i]
+	}
+
+	sorted := make([]corev1.Node, len(nodes))
+	copy(sorted, nodes)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	capacity := pods.Capacity(r.capacity())
+	var entries []string
+	withoutGPUs := 0
+	for i := range sorted {
+		node := &sorted[i]
+		if reason, rejected := r.nodeRejection(node); rejected {
+			if reason == scheduling.RejectionNoGPUs {
+				withoutGPUs++
+				continue
+			}
+			entries = append(entries, fmt.Sprintf("%s: %s", node.Name, reason))
+			continue
+		}
+		if !matchesNodeSelector(node, gw) {
+			entries = append(entries, fmt.Sprintf("%s: %s", node.Name, scheduling.RejectionNodeSelectorMismatch))
+			continue
+		}
+		if detail, ok := unfit[node.Name]; ok {
+			entries = append(entries, fmt.Sprintf("%s: %s (%s)", node.Name, scheduling.RejectionInsufficientResources, detail))
+			continue
+		}
+		if n, ok := eligible[node.Name]; ok {
+			node = n
+		}
+		if reason, detail, rejected := scheduling.CapacityRejection(node, gw, capacity); rejected {
+			entries = append(entries, fmt.Sprintf("%s: %s (%s)", node.Name, reason, detail))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "0/%d nodes are available", len(nodes))
+	if len(entries) > 0 {
+		listed := entries
+		if len(listed) > maxRejectedNodesInEvent {
+			listed = listed[:maxRejectedNodesInEvent]
+		}
+		fmt.Fprintf(&b, ": %s", strings.Join(listed, "; "))
+		if more := len(entries) - len(listed); more > 0 {
+			fmt.Fprintf(&b, "; and %d more", more)
+		}
+	}
+	if withoutGPUs > 0 {
+		fmt.Fprintf(&b, "; %d without GPUs", withoutGPUs)
+	}
+	return b.String()
+}
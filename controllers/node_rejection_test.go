@@ -0,0 +1,166 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+func TestReconcile_EmitsNodeRejectionSummary(t *testing.T) {
+	gw := createTestWorkload("too-big", 4)
+
+	small := createGPUNode("a-small", 2)
+	cordoned := createGPUNode("b-cordoned", 8)
+	cordoned.Spec.Unschedulable = true
+	tainted := createGPUNode("c-tainted", 8)
+	tainted.Spec.Taints = []corev1.Taint{{Key: "maintenance", Effect: corev1.TaintEffectNoExecute}}
+	notReady := createGPUNode("d-not-ready", 8)
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	cpuOnly := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "e-cpu"}}
+
+	r := newTestReconciler(t, gw, &small, &cordoned, &tainted, &notReady, &cpuOnly)
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+
+	reconcileWorkload(t, r, gw)
+
+	event := recorder.find("NodesRejected")
+	if event == nil {
+		t.Fatalf("Expected a NodesRejected event, got %+v", recorder.events)
+	}
+	if event.eventType != corev1.EventTypeWarning {
+		t.Errorf("Expected Warning event, got %s", event.eventType)
+	}
+	for _, want := range []string{
+		"0/5 nodes are available",
+		"a-small: insufficient_gpus (2 available, 4 requested)",
+		"b-cordoned: cordoned",
+		"c-tainted: tainted",
+		"d-not-ready: not_ready",
+		"1 without GPUs",
+	} {
+		if !strings.Contains(event.message, want) {
+			t.Errorf("Expected event message to contain %q, got %q", want, event.message)
+		}
+	}
+	if strings.Contains(event.message, "e-cpu") {
+		t.Errorf("Expected nodes without GPUs to be counted, not listed, got %q", event.message)
+	}
+}
+
+func TestReconcile_NodeRejectionSummaryIsCapped(t *testing.T) {
+	gw := createTestWorkload("too-big", 4)
+	objs := []client.Object{gw}
+	for i := 0; i < maxRejectedNodesInEvent+3; i++ {
+		node := createGPUNode(fmt.Sprintf("node-%02d", i), 1)
+		objs = append(objs, &node)
+	}
+
+	r := newTestReconciler(t, objs...)
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+
+	reconcileWorkload(t, r, gw)
+
+	event := recorder.find("NodesRejected")
+	if event == nil {
+		t.Fatalf("Expected a NodesRejected event, got %+v", recorder.events)
+	}
+	if got := strings.Count(event.message, "insufficient_gpus"); got != maxRejectedNodesInEvent {
+		t.Errorf("Expected %d nodes listed, got %d in %q", maxRejectedNodesInEvent, got, event.message)
+	}
+	if !strings.HasSuffix(event.message, "; and 3 more") {
+		t.Errorf("Expected the summary to count the unlisted nodes, got %q", event.message)
+	}
+}
+
+func TestReconcile_CapacityProviderDrivesPlacementAndRejections(t *testing.T) {
+	gw := createTestWorkload("ledger", 4)
+	other := createTestWorkload("ledger-2", 4)
+	big := createGPUNode("a-big", 8)
+	small := createGPUNode("b-small", 4)
+
+	// A reservation ledger holds most of a-big
+	free := map[string]int64{"a-big": 2, "b-small": 4}
+	r := newTestReconciler(t, gw, other, &big, &small)
+	r.CapacityProvider = scheduling.CapacityFunc(func(node *corev1.Node) int64 { return free[node.Name] })
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.AssignedNode != "b-small" {
+		t.Fatalf("Expected placement on b-small per the provider, got %q: %s", updated.Status.AssignedNode, updated.Status.Message)
+	}
+
+	// With nothing free the rejection summary reports the provider's capacity
+	free["b-small"] = 0
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+	reconcileWorkload(t, r, other)
+
+	event := recorder.find("NodesRejected")
+	if event == nil {
+		t.Fatalf("Expected a NodesRejected event, got %+v", recorder.events)
+	}
+	if !strings.Contains(event.message, "a-big: insufficient_gpus (2 available, 4 requested)") {
+		t.Errorf("Expected the provider's capacity in the summary, got %q", event.message)
+	}
+}
+
+func TestNodeRejection_ExcludesNodesUnderPressure(t *testing.T) {
+	for _, condition := range []corev1.NodeConditionType{corev1.NodeMemoryPressure, corev1.NodeDiskPressure, corev1.NodePIDPressure} {
+		node := createGPUNode("pressured", 8)
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: condition, Status: corev1.ConditionTrue})
+
+		r := &GPUWorkloadReconciler{}
+		if reason, rejected := r.nodeRejection(&node); !rejected || reason != scheduling.RejectionUnderPressure {
+			t.Errorf("%s: expected the node to be rejected as under_pressure, got %q (%v)", condition, reason, rejected)
+		}
+
+		// Ignoring the condition makes the node eligible again
+		r.IgnoredPressureConditions = []corev1.NodeConditionType{condition}
+		if reason, rejected := r.nodeRejection(&node); rejected {
+			t.Errorf("%s: expected the ignored condition to be allowed, got %q", condition, reason)
+		}
+	}
+
+	// Pressure conditions that are false do not count
+	node := createGPUNode("healthy", 8)
+	node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse})
+	if reason, rejected := (&GPUWorkloadReconciler{}).nodeRejection(&node); rejected {
+		t.Errorf("Expected a node without pressure to be eligible, got %q", reason)
+	}
+}
+
+func TestParsePressureConditions(t *testing.T) {
+	conditions, err := ParsePressureConditions(" MemoryPressure, PIDPressure ")
+	if err != nil {
+		t.Fatalf("ParsePressureConditions() error = %v", err)
+	}
+	if len(conditions) != 2 || conditions[0] != corev1.NodeMemoryPressure || conditions[1] != corev1.NodePIDPressure {
+		t.Errorf("Unexpected conditions %v", conditions)
+	}
+	if _, err := ParsePressureConditions("Ready"); err == nil {
+		t.Error("Expected an error for a condition that is not a pressure condition")
+	}
+}
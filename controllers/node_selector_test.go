@@ -0,0 +1,119 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_JobPodCarriesNodeSelectorAndTolerations(t *testing.T) {
+	gw := createTestWorkload("tolerant", 2)
+	gw.Spec.NodeSelector = map[string]string{"pool": "inference"}
+	gw.Spec.Tolerations = []corev1.Toleration{
+		{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpEqual, Value: "present", Effect: corev1.TaintEffectNoSchedule},
+	}
+	node := createGPUNode("node1", 4)
+	node.Labels = map[string]string{"pool": "inference"}
+	node.Spec.Taints = []corev1.Taint{{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule}}
+	r := newTestReconciler(t, gw, &node)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	podSpec := jobs[0].Spec.Template.Spec
+	if !reflect.DeepEqual(podSpec.Tolerations, gw.Spec.Tolerations) {
+		t.Errorf("Expected tolerations %v, got %v", gw.Spec.Tolerations, podSpec.Tolerations)
+	}
+	if !reflect.DeepEqual(podSpec.NodeSelector, gw.Spec.NodeSelector) {
+		t.Errorf("Expected node selector %v, got %v", gw.Spec.NodeSelector, podSpec.NodeSelector)
+	}
+}
+
+func TestCreateJobForWorkload_AddsDefaultGPUToleration(t *testing.T) {
+	userToleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "ml", Effect: corev1.TaintEffectNoSchedule}
+	gpuToleration := corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+	tests := []struct {
+		name     string
+		key      string
+		disabled bool
+		want     []corev1.Toleration
+	}{
+		{"default key", "", false, []corev1.Toleration{userToleration, gpuToleration}},
+		{"configured key", "gpu", false, []corev1.Toleration{userToleration, {Key: "gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}}},
+		{"disabled", "", true, []corev1.Toleration{userToleration}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := createTestWorkload("tolerant", 1)
+			gw.Spec.Tolerations = []corev1.Toleration{userToleration}
+			node := createGPUNode("node1", 4)
+			r := newTestReconciler(t, gw)
+			r.GPUTolerationKey = tt.key
+			r.DisableGPUToleration = tt.disabled
+
+			job, err := r.createJobForWorkload(gw, "", &node, 0)
+			if err != nil {
+				t.Fatalf("createJobForWorkload() error = %v", err)
+			}
+			if got := job.Spec.Template.Spec.Tolerations; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected tolerations %v, got %v", tt.want, got)
+			}
+			if len(gw.Spec.Tolerations) != 1 {
+				t.Errorf("Expected the workload's tolerations to be left untouched, got %v", gw.Spec.Tolerations)
+			}
+		})
+	}
+}
+
+func TestReconcile_SkipsNodesNotMatchingNodeSelector(t *testing.T) {
+	gw := createTestWorkload("selective", 2)
+	gw.Spec.NodeSelector = map[string]string{"pool": "inference"}
+	training := createGPUNode("training", 8)
+	training.Labels = map[string]string{"pool": "training"}
+	inference := createGPUNode("inference", 2)
+	inference.Labels = map[string]string{"pool": "inference"}
+	r := newTestReconciler(t, gw, &training, &inference)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.AssignedNode != inference.Name {
+		t.Errorf("Expected the workload on the inference node, got %q: %s", updated.Status.AssignedNode, updated.Status.Message)
+	}
+}
+
+func TestRejectionSummary_ReportsNodeSelectorMismatch(t *testing.T) {
+	gw := createTestWorkload("selective", 2)
+	gw.Spec.NodeSelector = map[string]string{"pool": "inference"}
+	node := createGPUNode("node1", 8)
+	r := newTestReconciler(t)
+
+	summary := r.rejectionSummary([]corev1.Node{node}, nil, nil, nil, gw)
+	if !strings.Contains(summary, "node1: node_selector_mismatch") {
+		t.Errorf("Expected node1 to be rejected for its labels, got %q", summary)
+	}
+}
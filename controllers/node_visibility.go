@@ -0,0 +1,48 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// nodeVisibilityRecheckInterval is how often workloads held back by too few observed GPU
+// nodes list the nodes again.
+const nodeVisibilityRecheckInterval = 15 * time.Second
+
+// insufficientNodeVisibility reports whether fewer GPU nodes were listed than MinObservedNodes,
+// with a message for the workload status. Nodes count whether or not they are Ready, since
+// the guard is against a partial listing rather than unhealthy nodes.
+func (r *GPUWorkloadReconciler) insufficientNodeVisibility(nodes []corev1.Node) (string, bool) {
+	if r.MinObservedNodes <= 0 {
+		return "", false
+	}
+
+	observed := 0
+	for i := range nodes {
+		if hasGPUs(&nodes[i]) {
+			observed++
+		}
+	}
+	if observed >= r.MinObservedNodes {
+		return "", false
+	}
+	return fmt.Sprintf("Observed %d GPU nodes, fewer than the %d expected; waiting for the node list to recover", observed, r.MinObservedNodes), true
+}
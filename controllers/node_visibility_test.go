@@ -0,0 +1,65 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_TooFewObservedNodesDefersScheduling(t *testing.T) {
+	gw := createTestWorkload("partial-view", 1)
+	node1 := createGPUNode("node1", 8)
+	node2 := createGPUNode("node2", 8)
+	// Nodes without GPUs don't count towards the expected GPU nodes
+	cpuNode := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "cpu-node"}}
+	r := newTestReconciler(t, gw, &node1, &node2, &cpuNode)
+	r.MinObservedNodes = 3
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "insufficient_node_visibility" {
+		t.Errorf("Expected Pending with reason insufficient_node_visibility, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if result.RequeueAfter != nodeVisibilityRecheckInterval {
+		t.Errorf("Expected requeue after %v, got %v", nodeVisibilityRecheckInterval, result.RequeueAfter)
+	}
+	if updated.Status.RetryCount != 0 {
+		t.Errorf("Expected the deferral not to count as a retry, got %d", updated.Status.RetryCount)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no job while too few nodes are observed, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_EnoughObservedNodesSchedules(t *testing.T) {
+	gw := createTestWorkload("full-view", 1)
+	node1 := createGPUNode("node1", 8)
+	// A NotReady node was still listed, so it counts as observed
+	notReady := createGPUNode("node2", 8)
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	r := newTestReconciler(t, gw, &node1, &notReady)
+	r.MinObservedNodes = 2
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.AssignedNode != "node1" {
+		t.Errorf("Expected Scheduled on node1, got %s on %q (%s)", updated.Status.Phase, updated.Status.AssignedNode, updated.Status.Message)
+	}
+}
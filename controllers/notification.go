@@ -0,0 +1,76 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/notification"
+)
+
+// notifyCompletion tells the notifier, when configured, that the workload's Job finished,
+// including the tail of its logs when enabled. Notifications are best effort: failures are
+// logged and never block the workload.
+func (r *GPUWorkloadReconciler) notifyCompletion(ctx context.Context, log logr.Logger, c client.Reader, gw *gpuv1alpha1.GPUWorkload, job *batchv1.Job) {
+	if r.Notifier == nil {
+		return
+	}
+
+	n := notification.Notification{
+		Namespace: gw.Namespace,
+		Name:      gw.Name,
+		ModelName: gw.Spec.ModelName,
+		Phase:     string(gw.Status.Phase),
+		Reason:    gw.Status.Reason,
+		Message:   gw.Status.Message,
+		Node:      gw.Status.AssignedNode,
+		JobName:   job.Name,
+	}
+	// Logs are read through the local API server, so only for Jobs of the local cluster
+	if r.NotificationLogLines > 0 && r.LogSource != nil && gw.Status.Cluster == "" {
+		tail, err := r.workloadLogsTail(ctx, c, job)
+		if err != nil {
+			log.Error(err, "unable to read workload logs for the notification")
+		}
+		n.LogsTail = tail
+	}
+
+	if err := r.Notifier.Notify(ctx, n); err != nil {
+		log.Error(err, "unable to send completion notification")
+	}
+}
+
+// workloadLogsTail returns the last NotificationLogLines lines of the workload container of
+// the Job's most recent pod, or "" when it has no pods.
+func (r *GPUWorkloadReconciler) workloadLogsTail(ctx context.Context, c client.Reader, job *batchv1.Job) (string, error) {
+	pods, err := r.workloadPods(ctx, c, job)
+	if err != nil || len(pods) == 0 {
+		return "", err
+	}
+	latest := &pods[0]
+	for i := range pods {
+		if latest.CreationTimestamp.Before(&pods[i].CreationTimestamp) {
+			latest = &pods[i]
+		}
+	}
+	return r.LogSource.TailLogs(ctx, latest.Namespace, latest.Name, workloadContainerName, r.NotificationLogLines)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/notification"
+)
+
+// capturingNotifier records the notifications it is sent.
+type capturingNotifier struct {
+	sent []notification.Notification
+}
+
+func (c *capturingNotifier) Notify(ctx context.Context, n notification.Notification) error {
+	c.sent = append(c.sent, n)
+	return nil
+}
+
+// fakeLogSource serves fixed logs and records the requests it receives.
+type fakeLogSource struct {
+	logs      string
+	pod       string
+	container string
+	lines     int64
+}
+
+func (f *fakeLogSource) TailLogs(ctx context.Context, namespace, pod, container string, lines int64) (string, error) {
+	f.pod, f.container, f.lines = pod, container, lines
+	return f.logs, nil
+}
+
+func TestReconcile_CompletionNotificationIncludesLogsTail(t *testing.T) {
+	gw := createTestWorkload("notified", 1)
+	job, pod := createFinishedJob(gw, batchv1.JobFailed, 1)
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = job.Name
+	gw.Status.AssignedNode = "node1"
+
+	notifier := &capturingNotifier{}
+	logs := &fakeLogSource{logs: "loading weights\nCUDA error: out of memory\n"}
+	r := newTestReconciler(t, gw, job, pod)
+	r.Notifier = notifier
+	r.LogSource = logs
+	r.NotificationLogLines = 50
+	reconcileWorkload(t, r, gw)
+
+	if len(notifier.sent) != 1 {
+		t.Fatalf("Expected 1 notification, got %d", len(notifier.sent))
+	}
+	sent := notifier.sent[0]
+	if sent.Phase != string(gpuv1alpha1.PhaseFailed) || sent.Name != gw.Name || sent.JobName != job.Name || sent.Node != "node1" {
+		t.Errorf("Unexpected notification %+v", sent)
+	}
+	if sent.LogsTail != logs.logs {
+		t.Errorf("Expected the logs tail %q, got %q", logs.logs, sent.LogsTail)
+	}
+	if logs.pod != pod.Name || logs.container != workloadContainerName || logs.lines != 50 {
+		t.Errorf("Expected the last 50 lines of %s/%s, got %d lines of %s/%s",
+			pod.Name, workloadContainerName, logs.lines, logs.pod, logs.container)
+	}
+}
+
+func TestReconcile_CompletionNotificationWithoutLogs(t *testing.T) {
+	gw := createTestWorkload("notified", 1)
+	job, pod := createFinishedJob(gw, batchv1.JobComplete, 0)
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.JobName = job.Name
+
+	notifier := &capturingNotifier{}
+	logs := &fakeLogSource{logs: "done\n"}
+	r := newTestReconciler(t, gw, job, pod)
+	r.Notifier = notifier
+	r.LogSource = logs
+	reconcileWorkload(t, r, gw)
+
+	if len(notifier.sent) != 1 || notifier.sent[0].Phase != string(gpuv1alpha1.PhaseSucceeded) {
+		t.Fatalf("Expected a Succeeded notification, got %+v", notifier.sent)
+	}
+	if notifier.sent[0].LogsTail != "" || logs.pod != "" {
+		t.Errorf("Expected no logs without notification log lines, got %q", notifier.sent[0].LogsTail)
+	}
+}
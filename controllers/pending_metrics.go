@@ -0,0 +1,80 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// pendingNamespaces remembers the namespaces that had pending workloads at the last update,
+// so a namespace whose last pending workload is gone is reported as zero rather than stale.
+type pendingNamespaces struct {
+	mu       sync.Mutex
+	reported map[string]bool
+}
+
+// recordPendingWorkloads publishes the number of workloads waiting to be scheduled in each
+// namespace. The reconciled workload, identified by key, is counted from current rather than
+// from the cache, which may not reflect the status just written yet. current is nil when the
+// workload no longer exists.
+func (r *GPUWorkloadReconciler) recordPendingWorkloads(ctx context.Context, log logr.Logger, key types.NamespacedName, current *gpuv1alpha1.GPUWorkload) {
+	m := metrics.GetMetrics()
+	if m == nil {
+		return
+	}
+
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		log.Error(err, "unable to list GPUWorkloads for pending metrics")
+		return
+	}
+	counts := map[string]int{}
+	for i := range workloads.Items {
+		gw := &workloads.Items[i]
+		if gw.Namespace == key.Namespace && gw.Name == key.Name {
+			continue
+		}
+		if awaitingScheduling(gw) {
+			counts[gw.Namespace]++
+		}
+	}
+	if current != nil && awaitingScheduling(current) {
+		counts[current.Namespace]++
+	}
+
+	r.pendingNamespaces.mu.Lock()
+	defer r.pendingNamespaces.mu.Unlock()
+	for namespace := range r.pendingNamespaces.reported {
+		if _, ok := counts[namespace]; !ok {
+			m.SetPendingWorkloads(namespace, 0)
+		}
+	}
+	for namespace, count := range counts {
+		m.SetPendingWorkloads(namespace, float64(count))
+	}
+	r.pendingNamespaces.reported = map[string]bool{}
+	for namespace := range counts {
+		r.pendingNamespaces.reported[namespace] = true
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+func pendingGauge(namespace string) float64 {
+	return testutil.ToFloat64(metrics.GetMetrics().GPUWorkloadPending.WithLabelValues(namespace))
+}
+
+func TestReconcile_PendingWorkloadsGauge(t *testing.T) {
+	first := createTestWorkload("first", 2)
+	first.Namespace = "team-pending"
+	second := createTestWorkload("second", 2)
+	second.Namespace = "team-pending"
+	r := newTestReconciler(t, first, second)
+
+	// Without GPU nodes both workloads wait
+	reconcileWorkload(t, r, first)
+	if v := pendingGauge("team-pending"); v != 2 {
+		t.Errorf("Expected 2 pending workloads, got %v", v)
+	}
+
+	node := createGPUNode("node1", 2)
+	if err := r.Create(context.Background(), &node); err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	reconcileWorkload(t, r, first)
+	if v := pendingGauge("team-pending"); v != 1 {
+		t.Errorf("Expected 1 pending workload once the first is scheduled, got %v", v)
+	}
+
+	if err := r.Delete(context.Background(), second); err != nil {
+		t.Fatalf("unable to delete workload: %v", err)
+	}
+	reconcileWorkload(t, r, first)
+	if v := pendingGauge("team-pending"); v != 0 {
+		t.Errorf("Expected the namespace to drop to 0 once its last pending workload is gone, got %v", v)
+	}
+}
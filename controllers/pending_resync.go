@@ -0,0 +1,128 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// pendingBatch is a group of pending workloads enqueued together at an offset into the period.
+type pendingBatch struct {
+	offset    time.Duration
+	workloads []gpuv1alpha1.GPUWorkload
+}
+
+// pendingResync periodically re-enqueues pending GPUWorkloads in randomized batches
+// spread across the period, so retries do not all hit the API server at the same
+// backoff boundary. It implements manager.Runnable.
+type pendingResync struct {
+	client    client.Client
+	log       logr.Logger
+	period    time.Duration
+	batchSize int
+	events    chan<- event.GenericEvent
+	rand      *rand.Rand
+}
+
+// Start runs the resync loop until the context is cancelled.
+func (p *pendingResync) Start(ctx context.Context) error {
+	for {
+		// Jitter the cycle start by up to 10% so replicas do not resync in lockstep
+		wait := p.period + time.Duration(p.rand.Int63n(int64(p.period)/10+1))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+
+		workloads := &gpuv1alpha1.GPUWorkloadList{}
+		if err := p.client.List(ctx, workloads); err != nil {
+			p.log.Error(err, "unable to list GPUWorkloads for pending resync")
+			continue
+		}
+
+		var pending []gpuv1alpha1.GPUWorkload
+		for _, gw := range workloads.Items {
+			if gw.Status.Phase == gpuv1alpha1.PhasePending {
+				pending = append(pending, gw)
+			}
+		}
+
+		cycleStart := time.Now()
+		for _, batch := range p.planBatches(pending) {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Until(cycleStart.Add(batch.offset))):
+			}
+			for i := range batch.workloads {
+				select {
+				case p.events <- event.GenericEvent{Object: &batch.workloads[i]}:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// planBatches shuffles the pending workloads, groups them into batches of at most
+// batchSize, and assigns each batch a random offset within its own slot of the period.
+func (p *pendingResync) planBatches(pending []gpuv1alpha1.GPUWorkload) []pendingBatch {
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batchSize := p.batchSize
+	if batchSize <= 0 {
+		batchSize = len(pending)
+	}
+
+	shuffled := make([]gpuv1alpha1.GPUWorkload, len(pending))
+	copy(shuffled, pending)
+	p.rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	numBatches := (len(shuffled) + batchSize - 1) / batchSize
+	slot := p.period / time.Duration(numBatches)
+
+	batches := make([]pendingBatch, 0, numBatches)
+	for i := 0; i < numBatches; i++ {
+		end := (i + 1) * batchSize
+		if end > len(shuffled) {
+			end = len(shuffled)
+		}
+		jitter := time.Duration(0)
+		if slot > 0 {
+			jitter = time.Duration(p.rand.Int63n(int64(slot)))
+		}
+		batches = append(batches, pendingBatch{
+			offset:    time.Duration(i)*slot + jitter,
+			workloads: shuffled[i*batchSize : end],
+		})
+	}
+	return batches
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestPendingResync_SpreadsBatchesAcrossPeriod(t *testing.T) {
+	period := time.Minute
+	p := &pendingResync{
+		log:       logr.Discard(),
+		period:    period,
+		batchSize: 5,
+		rand:      rand.New(rand.NewSource(1)),
+	}
+
+	var pending []gpuv1alpha1.GPUWorkload
+	for i := 0; i < 40; i++ {
+		pending = append(pending, *createTestWorkload(fmt.Sprintf("pending-%d", i), 1))
+	}
+
+	batches := p.planBatches(pending)
+	if len(batches) != 8 {
+		t.Fatalf("Expected 8 batches of 5 workloads, got %d", len(batches))
+	}
+
+	total := 0
+	slot := period / time.Duration(len(batches))
+	for i, batch := range batches {
+		total += len(batch.workloads)
+		if len(batch.workloads) > 5 {
+			t.Errorf("Batch %d has %d workloads, want at most 5", i, len(batch.workloads))
+		}
+		// Each batch lands in its own slot, so the whole set is spread over the period
+		if batch.offset < time.Duration(i)*slot || batch.offset >= time.Duration(i+1)*slot {
+			t.Errorf("Batch %d offset %v outside its slot [%v, %v)", i, batch.offset, time.Duration(i)*slot, time.Duration(i+1)*slot)
+		}
+	}
+	if total != len(pending) {
+		t.Errorf("Expected all %d pending workloads to be batched, got %d", len(pending), total)
+	}
+
+	last := batches[len(batches)-1].offset
+	if last < period/2 {
+		t.Errorf("Expected batches to span the period, last batch offset was %v", last)
+	}
+}
+
+func TestPendingResync_NoPendingWorkloads(t *testing.T) {
+	p := &pendingResync{period: time.Minute, batchSize: 5, rand: rand.New(rand.NewSource(1))}
+	if batches := p.planBatches(nil); len(batches) != 0 {
+		t.Errorf("Expected no batches without pending workloads, got %d", len(batches))
+	}
+}
+
+func TestPendingResync_StopsWhileEnqueueBlocks(t *testing.T) {
+	gw := createTestWorkload("pending", 1)
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	events := make(chan event.GenericEvent)
+	p := &pendingResync{
+		client: newTestReconciler(t, gw).Client,
+		log:    logr.Discard(),
+		period: 10 * time.Millisecond,
+		events: events,
+		rand:   rand.New(rand.NewSource(1)),
+	}
+
+	// Nothing reads the events, as when the controller has stopped first
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- p.Start(ctx) }()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Start() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected Start to return once the context was cancelled")
+	}
+}
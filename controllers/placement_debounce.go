@@ -0,0 +1,61 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// placementDebouncer remembers when each workload last went through placement, so a burst
+// of events for the same workload (spec update, status update, Job event) lists nodes and
+// runs the strategy at most once per interval. The work queue already deduplicates keys
+// that are queued together; this also coalesces keys that arrive one after the other.
+type placementDebouncer struct {
+	mu   sync.Mutex
+	last map[types.NamespacedName]time.Time
+}
+
+// wait returns how long the workload must wait before its next placement attempt. When it
+// returns zero the attempt is recorded at now and may proceed.
+func (d *placementDebouncer) wait(key types.NamespacedName, now time.Time, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if last, ok := d.last[key]; ok {
+		if remaining := last.Add(interval).Sub(now); remaining > 0 {
+			return remaining
+		}
+	}
+	if d.last == nil {
+		d.last = map[types.NamespacedName]time.Time{}
+	}
+	d.last[key] = now
+	return 0
+}
+
+// forget drops the workload's last attempt, once it is placed or deleted.
+func (d *placementDebouncer) forget(key types.NamespacedName) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.last, key)
+}
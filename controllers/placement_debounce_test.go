@@ -0,0 +1,106 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// countNodeLists wraps the reconciler's client and returns a counter of node List calls.
+func countNodeLists(r *GPUWorkloadReconciler) *int {
+	lists := 0
+	r.Client = interceptor.NewClient(r.Client.(client.WithWatch), interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if _, ok := list.(*corev1.NodeList); ok {
+				lists++
+			}
+			return c.List(ctx, list, opts...)
+		},
+	})
+	return &lists
+}
+
+func TestReconcile_RapidReconcilesCoalesce(t *testing.T) {
+	// No GPU nodes, so every placement attempt leaves the workload pending
+	gw := createTestWorkload("bursty", 1)
+	r := newTestReconciler(t, gw)
+	r.PlacementDebounce = 5 * time.Second
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	r.Clock = fakeClock
+	lists := countNodeLists(r)
+
+	reconcileWorkload(t, r, gw)
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	for i := 0; i < 3; i++ {
+		result, updated := reconcileWorkload(t, r, gw)
+		if result.RequeueAfter != 4*time.Second {
+			t.Errorf("Expected the debounced reconcile to requeue after the remaining 4s, got %v", result.RequeueAfter)
+		}
+		if updated.Status.Phase != gpuv1alpha1.PhasePending {
+			t.Errorf("Expected the workload to stay Pending, got %s", updated.Status.Phase)
+		}
+	}
+	if *lists != 1 {
+		t.Errorf("Expected rapid reconciles to list nodes once, got %d", *lists)
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(5 * time.Second))
+	reconcileWorkload(t, r, gw)
+	if *lists != 2 {
+		t.Errorf("Expected a new placement attempt once the interval elapsed, got %d node lists", *lists)
+	}
+}
+
+func TestReconcile_NoDebounceByDefault(t *testing.T) {
+	gw := createTestWorkload("eager", 1)
+	r := newTestReconciler(t, gw)
+	lists := countNodeLists(r)
+
+	for i := 0; i < 3; i++ {
+		reconcileWorkload(t, r, gw)
+	}
+	if *lists != 3 {
+		t.Errorf("Expected every reconcile to list nodes, got %d", *lists)
+	}
+}
+
+func TestPlacementDebouncer_ForgetAllowsImmediateAttempt(t *testing.T) {
+	var d placementDebouncer
+	key := types.NamespacedName{Namespace: "default", Name: "placed"}
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if wait := d.wait(key, now, time.Minute); wait != 0 {
+		t.Fatalf("Expected the first attempt to proceed, got wait %v", wait)
+	}
+	if wait := d.wait(key, now.Add(10*time.Second), time.Minute); wait != 50*time.Second {
+		t.Errorf("Expected a 50s wait, got %v", wait)
+	}
+	d.forget(key)
+	if wait := d.wait(key, now.Add(10*time.Second), time.Minute); wait != 0 {
+		t.Errorf("Expected a forgotten workload to proceed, got wait %v", wait)
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// withoutPlacedGPUs returns copies of the named cluster's nodes whose GPU resources, after over-commit, are
+// reduced by the GPUs already allocated to placed GPUWorkloads. Called while holding the placement lock, it lets
+// concurrent workers see each other's placements without a separate reservation ledger: the workloads are read
+// past the cache, so a placement recorded by the previous holder of the lock is always seen. Replicas whose Job
+// pod is among the node's pods are left out, since the strategies already count that pod's GPUs.
+func (r *GPUWorkloadReconciler) withoutPlacedGPUs(ctx context.Context, cluster string, nodes []corev1.Node, pods scheduling.NodePods) ([]corev1.Node, error) {
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.apiReader().List(ctx, workloads); err != nil {
+		return nil, err
+	}
+	inFlight := make(map[string]int64)
+	placed := placedIn(workloads.Items, cluster)
+	for i := range placed {
+		gw := &placed[i]
+		if gw.Status.Phase != gpuv1alpha1.PhaseScheduled && gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+			continue
+		}
+		jobs := placedJobs(gw)
+		for replica, node := range placedNodes(gw) {
+			if replica < len(jobs) && hasActiveJobPod(pods[node], jobs[replica].Name) {
+				continue
+			}
+			inFlight[node] += int64(placedGPUs(gw))
+		}
+	}
+
+	adjusted := make([]corev1.Node, 0, len(nodes))
+	for i := range nodes {
+		node := nodes[i].DeepCopy()
+		if requested := inFlight[node.Name]; requested > 0 {
+			scheduling.ApplyOvercommit(node)
+			for _, vendor := range scheduling.DefaultVendorPreference {
+				name, _ := scheduling.VendorResourceName(vendor)
+				reduceResource(node.Status.Allocatable, name, requested)
+				reduceResource(node.Status.Capacity, name, requested)
+			}
+		}
+		adjusted = append(adjusted, *node)
+	}
+	return adjusted, nil
+}
+
+// hasActiveJobPod reports whether one of the pods belongs to the named Job and still holds its resources.
+func hasActiveJobPod(pods []corev1.Pod, jobName string) bool {
+	if jobName == "" {
+		return false
+	}
+	for i := range pods {
+		if pods[i].Labels["job-name"] == jobName && scheduling.HoldsResources(&pods[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodePods lists the pods of the cluster read by c that are bound to the nodes. Autoscaler
+// placeholder pods are left out: they are deleted before the workload's Job is created.
+func nodePods(ctx context.Context, c client.Reader, nodes []corev1.Node) (scheduling.NodePods, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods); err != nil {
+		return nil, err
+	}
+	bound := pods.Items[:0]
+	for i := range pods.Items {
+		if !isAutoscalerTrigger(&pods.Items[i]) {
+			bound = append(bound, pods.Items[i])
+		}
+	}
+	return scheduling.GroupPodsByNode(bound, nodes), nil
+}
+
+// reduceResource lowers a resource in the list by amount, flooring at zero.
+func reduceResource(resources corev1.ResourceList, name corev1.ResourceName, amount int64) {
+	quantity, ok := resources[name]
+	if !ok {
+		return
+	}
+	remaining := quantity.Value() - amount
+	if remaining < 0 {
+		remaining = 0
+	}
+	resources[name] = *resource.NewQuantity(remaining, resource.DecimalSI)
+}
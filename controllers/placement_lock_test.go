@@ -0,0 +1,214 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// Run with -race: concurrent workers share the reconciler and the placement lock.
+func TestReconcile_SerializedPlacementsDoNotOversubscribe(t *testing.T) {
+	const workloads = 8
+	node := createGPUNode("node1", 4)
+	objs := []client.Object{&node}
+	for i := 0; i < workloads; i++ {
+		objs = append(objs, createTestWorkload(fmt.Sprintf("concurrent-%d", i), 1))
+	}
+
+	r := newTestReconciler(t, objs...)
+	r.SerializePlacements = true
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workloads)
+	for i := 0; i < workloads; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: fmt.Sprintf("concurrent-%d", i), Namespace: "default"}}
+			if _, err := r.Reconcile(context.Background(), req); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	list := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(context.Background(), list); err != nil {
+		t.Fatalf("unable to list workloads: %v", err)
+	}
+
+	allocated := int32(0)
+	for _, gw := range list.Items {
+		if gw.Status.Phase == gpuv1alpha1.PhaseScheduled {
+			allocated += gw.Status.AllocatedGPUCount
+		}
+	}
+	if allocated != 4 {
+		t.Errorf("Expected exactly the node's 4 GPUs to be allocated, got %d", allocated)
+	}
+}
+
+func TestWithoutPlacedGPUs_SubtractsFromOvercommittedCapacity(t *testing.T) {
+	node := createGPUNode("node1", 4)
+	node.Annotations = map[string]string{scheduling.OvercommitRatioAnnotation: "2"}
+	placed := createTestWorkload("placed", 4)
+	placed.Status.Phase = gpuv1alpha1.PhaseRunning
+	placed.Status.AssignedNode = "node1"
+	placed.Status.AllocatedGPUCount = 4
+
+	r := newTestReconciler(t, &node, placed)
+	adjusted, err := r.withoutPlacedGPUs(context.Background(), "", []corev1.Node{node}, nil)
+	if err != nil {
+		t.Fatalf("withoutPlacedGPUs() error = %v", err)
+	}
+	if got := scheduling.AvailableGPUs(&adjusted[0]); got != 4 {
+		t.Errorf("Expected 8 over-committed GPUs minus 4 placed, got %d", got)
+	}
+}
+
+func TestWithoutPlacedGPUs_ReadsPlacementsPastTheCache(t *testing.T) {
+	node := createGPUNode("node1", 4)
+	placed := createTestWorkload("placed", 3)
+	placed.Status.Phase = gpuv1alpha1.PhaseScheduled
+	placed.Status.AssignedNode = "node1"
+	placed.Status.AllocatedGPUCount = 3
+
+	// The cache has not seen the placement the previous holder of the lock just recorded
+	r := newTestReconciler(t, &node)
+	r.APIReader = newTestReconciler(t, placed).Client
+	adjusted, err := r.withoutPlacedGPUs(context.Background(), "", []corev1.Node{node}, nil)
+	if err != nil {
+		t.Fatalf("withoutPlacedGPUs() error = %v", err)
+	}
+	if got := scheduling.AvailableGPUs(&adjusted[0]); got != 1 {
+		t.Errorf("Expected 4 GPUs minus 3 placed, got %d", got)
+	}
+}
+
+func TestWithoutPlacedGPUs_CountsEveryReplicaWithoutAPod(t *testing.T) {
+	node1, node2 := createGPUNode("node1", 4), createGPUNode("node2", 4)
+	gang := createTestWorkload("gang", 2)
+	gang.Spec.Replicas = 2
+	gang.Status.Phase = gpuv1alpha1.PhaseScheduled
+	gang.Status.AssignedNode = "node1"
+	gang.Status.ReplicaNodes = []string{"node1", "node2"}
+	gang.Status.JobName = "gang-job"
+	gang.Status.AllocatedGPUCount = 2
+
+	// Replica 0's pod is running and counted by the strategies; replica 1's is not there yet
+	pod := createGPUPod("gang-job-abcde", "node1", 2)
+	pod.Labels = map[string]string{"job-name": "gang-job"}
+	nodes := []corev1.Node{node1, node2}
+	pods := scheduling.GroupPodsByNode([]corev1.Pod{*pod}, nodes)
+
+	r := newTestReconciler(t, &node1, &node2, gang)
+	adjusted, err := r.withoutPlacedGPUs(context.Background(), "", nodes, pods)
+	if err != nil {
+		t.Fatalf("withoutPlacedGPUs() error = %v", err)
+	}
+	if got := scheduling.AvailableGPUs(&adjusted[0]); got != 4 {
+		t.Errorf("Expected node1 left to its pod, got %d available", got)
+	}
+	if got := scheduling.AvailableGPUs(&adjusted[1]); got != 2 {
+		t.Errorf("Expected replica 1's 2 GPUs subtracted on node2, got %d available", got)
+	}
+}
+
+// createGPUPod returns a running pod bound to nodeName requesting gpus NVIDIA GPUs.
+func createGPUPod(name, nodeName string, gpus int64) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestReconcile_PodsOnNodeAreNotDoubleBooked(t *testing.T) {
+	gw := createTestWorkload("fits-elsewhere", 3)
+	busy := createGPUNode("node1", 8)
+	idle := createGPUNode("node2", 4)
+	other := createGPUPod("other-team", "node1", 6)
+
+	r := newTestReconciler(t, gw, &busy, &idle, other)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.AssignedNode != "node2" {
+		t.Errorf("Expected node2, since a running pod holds 6 of node1's GPUs, got %q", updated.Status.AssignedNode)
+	}
+}
+
+func TestReconcile_FullNodeByPodsKeepsWorkloadPending(t *testing.T) {
+	gw := createTestWorkload("no-room", 2)
+	node := createGPUNode("node1", 4)
+	other := createGPUPod("other-team", "node1", 3)
+
+	r := newTestReconciler(t, gw, &node, other)
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhasePending {
+		t.Fatalf("Expected Pending, got %s", updated.Status.Phase)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs, got %d", len(jobs))
+	}
+}
+
+func TestReconcile_SerializedPlacementsCountRunningPodsOnce(t *testing.T) {
+	placed := createTestWorkload("placed", 2)
+	placed.Status.Phase = gpuv1alpha1.PhaseRunning
+	placed.Status.AssignedNode = "node1"
+	placed.Status.JobName = "placed-job"
+	placed.Status.AllocatedGPUCount = 2
+	pod := createGPUPod("placed-job-abcde", "node1", 2)
+	pod.Labels = map[string]string{"job-name": "placed-job"}
+	gw := createTestWorkload("next", 2)
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, placed, pod, gw, &node)
+	r.SerializePlacements = true
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected the placed workload's GPUs to be counted once, leaving room for 2, got %s: %s",
+			updated.Status.Phase, updated.Status.Message)
+	}
+}
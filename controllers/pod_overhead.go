@@ -0,0 +1,137 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// effectiveRequests returns what the workload's pod consumes on a node: its container
+// requests plus the PodOverhead of its RuntimeClass, as the kubelet and scheduler count it.
+// The RuntimeClass is read through c from the cluster the pod would run in.
+func (r *GPUWorkloadReconciler) effectiveRequests(ctx context.Context, c client.Reader, gw *gpuv1alpha1.GPUWorkload) (corev1.ResourceList, error) {
+	requests := r.roundedResources(gw).Requests
+	if gw.Spec.RuntimeClassName == "" {
+		return requests, nil
+	}
+
+	runtimeClass := &nodev1.RuntimeClass{}
+	if err := c.Get(ctx, types.NamespacedName{Name: gw.Spec.RuntimeClassName}, runtimeClass); err != nil {
+		return nil, fmt.Errorf("unable to get RuntimeClass %s: %w", gw.Spec.RuntimeClassName, err)
+	}
+	if runtimeClass.Overhead == nil {
+		return requests, nil
+	}
+	for name, overhead := range runtimeClass.Overhead.PodFixed {
+		total := requests[name]
+		total.Add(overhead)
+		requests[name] = total
+	}
+	return requests, nil
+}
+
+// fitNodes splits the nodes into those whose allocatable resources, less the requests of
+// the pods bound to them, hold the requests and, for the rest, the resources they lack.
+// GPUs are left to the strategies, which account for over-commit, and resources a node
+// does not report are not checked.
+func fitNodes(nodes []corev1.Node, pods scheduling.NodePods, requests corev1.ResourceList, gpuResource corev1.ResourceName) ([]corev1.Node, map[string]string) {
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		if name != gpuResource {
+			names = append(names, string(name))
+		}
+	}
+	sort.Strings(names)
+
+	var fitting []corev1.Node
+	unfit := map[string]string{}
+	for _, node := range nodes {
+		var lacking []string
+		for _, name := range names {
+			allocatable, ok := node.Status.Allocatable[corev1.ResourceName(name)]
+			if !ok {
+				continue
+			}
+			held := podRequests(pods[node.Name], corev1.ResourceName(name))
+			free := allocatable.DeepCopy()
+			free.Sub(held)
+			request := requests[corev1.ResourceName(name)]
+			if free.Cmp(request) >= 0 {
+				continue
+			}
+			detail := fmt.Sprintf("%s %s requested, %s allocatable", name, request.String(), allocatable.String())
+			if !held.IsZero() {
+				detail += fmt.Sprintf(", %s held by pods", held.String())
+			}
+			lacking = append(lacking, detail)
+		}
+		if len(lacking) > 0 {
+			unfit[node.Name] = strings.Join(lacking, ", ")
+			continue
+		}
+		fitting = append(fitting, node)
+	}
+	return fitting, unfit
+}
+
+// podRequests sums what the pods still holding resources request of the named resource,
+// counting each pod as the scheduler does: the larger of its containers' total and its
+// largest init container request, plus its PodOverhead.
+func podRequests(pods []corev1.Pod, name corev1.ResourceName) resource.Quantity {
+	var total resource.Quantity
+	for i := range pods {
+		pod := &pods[i]
+		if !scheduling.HoldsResources(pod) {
+			continue
+		}
+		var containers resource.Quantity
+		for j := range pod.Spec.Containers {
+			containers.Add(containerRequest(&pod.Spec.Containers[j], name))
+		}
+		for j := range pod.Spec.InitContainers {
+			if init := containerRequest(&pod.Spec.InitContainers[j], name); init.Cmp(containers) > 0 {
+				containers = init
+			}
+		}
+		total.Add(containers)
+		if overhead, ok := pod.Spec.Overhead[name]; ok {
+			total.Add(overhead)
+		}
+	}
+	return total
+}
+
+// containerRequest returns the container's request of the named resource, falling back to
+// its limit as the API server defaults it.
+func containerRequest(container *corev1.Container, name corev1.ResourceName) resource.Quantity {
+	if quantity, ok := container.Resources.Requests[name]; ok {
+		return quantity
+	}
+	return container.Resources.Limits[name]
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// createSizedGPUNode returns a GPU node that also reports allocatable CPU and memory.
+func createSizedGPUNode(name string, gpus int64, cpu, memory string) corev1.Node {
+	node := createGPUNode(name, gpus)
+	node.Status.Allocatable[corev1.ResourceCPU] = resource.MustParse(cpu)
+	node.Status.Allocatable[corev1.ResourceMemory] = resource.MustParse(memory)
+	return node
+}
+
+func TestReconcile_PodOverheadPushesWorkloadOffTightNode(t *testing.T) {
+	// A normal-priority 2-GPU workload requests 8 CPUs and 32Gi of memory
+	tight := createSizedGPUNode("tight", 8, "9", "64Gi")
+	roomy := createSizedGPUNode("roomy", 4, "32", "128Gi")
+	kata := &nodev1.RuntimeClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "kata"},
+		Handler:    "kata",
+		Overhead: &nodev1.Overhead{PodFixed: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		}},
+	}
+
+	plain := createTestWorkload("plain", 2)
+	sandboxed := createTestWorkload("sandboxed", 2)
+	sandboxed.Spec.RuntimeClassName = "kata"
+
+	r := newTestReconciler(t, plain, sandboxed, &tight, &roomy, kata)
+
+	_, updated := reconcileWorkload(t, r, plain)
+	if updated.Status.AssignedNode != "tight" {
+		t.Fatalf("Expected the workload without overhead to fit on tight, got %q", updated.Status.AssignedNode)
+	}
+
+	_, updated = reconcileWorkload(t, r, sandboxed)
+	if updated.Status.AssignedNode != "roomy" {
+		t.Errorf("Expected the RuntimeClass overhead to push the workload onto roomy, got %q", updated.Status.AssignedNode)
+	}
+	for _, job := range listJobs(t, r) {
+		if job.Spec.Template.Spec.NodeName == "roomy" {
+			if rc := job.Spec.Template.Spec.RuntimeClassName; rc == nil || *rc != "kata" {
+				t.Errorf("Expected the job to run with the kata RuntimeClass, got %v", rc)
+			}
+		}
+	}
+}
+
+func TestReconcile_PodOverheadRejectionIsExplained(t *testing.T) {
+	tight := createSizedGPUNode("tight", 8, "9", "64Gi")
+	kata := &nodev1.RuntimeClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "kata"},
+		Handler:    "kata",
+		Overhead:   &nodev1.Overhead{PodFixed: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}},
+	}
+	gw := createTestWorkload("sandboxed", 2)
+	gw.Spec.RuntimeClassName = "kata"
+
+	r := newTestReconciler(t, gw, &tight, kata)
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending {
+		t.Fatalf("Expected the workload to stay pending, got %s", updated.Status.Phase)
+	}
+	event := recorder.find("NodesRejected")
+	if event == nil || !strings.Contains(event.message, "tight: insufficient_resources (cpu 10 requested, 9 allocatable)") {
+		t.Errorf("Expected the overhead rejection to be explained, got %+v", event)
+	}
+}
+
+func TestReconcile_BoundPodRequestsCountAgainstNodeResources(t *testing.T) {
+	busy := createSizedGPUNode("busy", 8, "16", "64Gi")
+	roomy := createSizedGPUNode("roomy", 2, "16", "64Gi")
+	// A pod the controller did not create holds most of busy's CPUs but none of its GPUs
+	other := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: "busy",
+			Containers: []corev1.Container{{
+				Name: "other",
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("12"),
+				}},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	gw := createTestWorkload("needs-cpu", 2)
+
+	r := newTestReconciler(t, gw, &busy, &roomy, other)
+	if _, updated := reconcileWorkload(t, r, gw); updated.Status.AssignedNode != "roomy" {
+		t.Errorf("Expected the pod's CPU requests to push the workload onto roomy, got %q", updated.Status.AssignedNode)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/queue"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+const (
+	// reasonPreempting is the Pending reason of a workload waiting for the GPUs of the
+	// workload it preempted to be freed.
+	reasonPreempting = "preempting"
+
+	// reasonPreempted is the Pending reason of a workload released to make room for one of
+	// a higher priority.
+	reasonPreempted = "preempted"
+
+	// preemptionRecheckInterval is how soon a workload that preempted another tries to take
+	// the freed GPUs.
+	preemptionRecheckInterval = 5 * time.Second
+)
+
+// preemptionAllowed reports whether the workload may preempt others to make room for itself.
+// MIG slices and gangs are not preempted for, and a workload that already preempted another
+// waits for its GPUs, falling back to the usual retries, rather than preempting again.
+func preemptionAllowed(gw *gpuv1alpha1.GPUWorkload) bool {
+	return gw.Spec.PreemptionPolicy == gpuv1alpha1.PreemptLowerPriority &&
+		gw.Spec.MIGProfile == "" && replicaCount(gw) == 1 && gw.Status.Reason != reasonPreempting && !gw.Spec.DryRun
+}
+
+// preemptionVictim picks the workload to preempt so that gw fits: a scheduled or running
+// workload of a lower priority, placed on a single candidate node that has room for gw once
+// the victim's GPUs are freed. The lowest priority is preferred, then the fewest GPUs, then
+// the most recently created workload. It returns nil when no workload qualifies.
+func (r *GPUWorkloadReconciler) preemptionVictim(ctx context.Context, gw *gpuv1alpha1.GPUWorkload, candidates []corev1.Node, pods scheduling.NodePods) (*gpuv1alpha1.GPUWorkload, error) {
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return nil, err
+	}
+	capacity := pods.Capacity(r.capacity())
+	requested := int64(gw.RequestedGPUCount())
+
+	var victim *gpuv1alpha1.GPUWorkload
+	for i := range workloads.Items {
+		other := &workloads.Items[i]
+		if !preemptible(other, gw) {
+			continue
+		}
+		node := findNode(candidates, other.Status.AssignedNode)
+		if node == nil || (gw.Spec.GPUVendor != "" && nodeGPUVendor(node) != gw.Spec.GPUVendor) {
+			continue
+		}
+		if capacity.AvailableGPUs(node)+int64(placedGPUs(other)) < requested {
+			continue
+		}
+		if victim == nil || preferredVictim(other, victim) {
+			victim = other
+		}
+	}
+	return victim, nil
+}
+
+// preemptible reports whether the workload may be preempted for preemptor: it holds whole
+// GPUs on a single node of the local cluster and has a lower priority.
+func preemptible(gw, preemptor *gpuv1alpha1.GPUWorkload) bool {
+	if gw.UID == preemptor.UID || gw.DeletionTimestamp != nil {
+		return false
+	}
+	if gw.Status.Phase != gpuv1alpha1.PhaseScheduled && gw.Status.Phase != gpuv1alpha1.PhaseRunning {
+		return false
+	}
+	if gw.Status.Cluster != "" || gw.Status.CPUFallback || gw.Spec.MIGProfile != "" || len(gw.Status.ReplicaNodes) > 1 {
+		return false
+	}
+	return queue.PriorityRank(gw.Spec.Priority) > queue.PriorityRank(preemptor.Spec.Priority)
+}
+
+// preferredVictim reports whether a is a better workload to preempt than b.
+func preferredVictim(a, b *gpuv1alpha1.GPUWorkload) bool {
+	if ra, rb := queue.PriorityRank(a.Spec.Priority), queue.PriorityRank(b.Spec.Priority); ra != rb {
+		return ra > rb
+	}
+	if ga, gb := placedGPUs(a), placedGPUs(b); ga != gb {
+		return ga < gb
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return b.CreationTimestamp.Before(&a.CreationTimestamp)
+	}
+	return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+}
+
+// preempt returns the victim to Pending, deleting its Jobs, and nominates the victim's node
+// for gw, which is checked again once the victim's pods had time to terminate.
+func (r *GPUWorkloadReconciler) preempt(ctx context.Context, log logr.Logger, gw, victim *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	node := victim.Status.AssignedNode
+	victimKey := client.ObjectKeyFromObject(victim)
+
+	message := fmt.Sprintf("Preempted by %s priority workload %s", priorityName(gw.Spec.Priority), client.ObjectKeyFromObject(gw))
+	if err := r.releasePlacement(ctx, log.WithValues("victim", victimKey), victim, reasonPreempted, message); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(victim, corev1.EventTypeWarning, "Preempted", message)
+
+	log.Info("Preempted lower-priority workload", "victim", victimKey, "node", node)
+	message = fmt.Sprintf("Preempted %s priority workload %s on node %s", priorityName(victim.Spec.Priority), victimKey, node)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "PreemptedWorkload", message)
+	gw.Status.NominatedNode = node
+	return r.deferScheduling(ctx, log, gw, reasonPreempting, message, preemptionRecheckInterval)
+}
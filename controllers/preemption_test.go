@@ -0,0 +1,156 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// createRunningWorkload returns a workload of the given priority running on the node.
+func createRunningWorkload(name, priority, node string, gpus int32) *gpuv1alpha1.GPUWorkload {
+	gw := createTestWorkload(name, gpus)
+	gw.Spec.Priority = priority
+	gw.Status.Phase = gpuv1alpha1.PhaseRunning
+	gw.Status.AssignedNode = node
+	gw.Status.JobName = replicaJobName(gw, 0)
+	gw.Status.AllocatedGPUCount = gpus
+	return gw
+}
+
+func TestReconcile_PreemptsLowerPriorityWorkload(t *testing.T) {
+	node := createGPUNode("node1", 4)
+	normal := createRunningWorkload("normal-batch", "normal", node.Name, 2)
+	low := createRunningWorkload("low-batch", "low", node.Name, 2)
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: low.Status.JobName, Namespace: low.Namespace}}
+	urgent := createTestWorkload("urgent", 2)
+	urgent.Spec.Priority = "high"
+	urgent.Spec.PreemptionPolicy = gpuv1alpha1.PreemptLowerPriority
+
+	r := newTestReconciler(t, urgent, normal, low, job, &node,
+		createGPUPod("normal-pod", node.Name, 2), createGPUPod("low-pod", node.Name, 2))
+	recorder := &capturingRecorder{}
+	r.Recorder = recorder
+
+	result, updated := reconcileWorkload(t, r, urgent)
+	if updated.Status.Reason != reasonPreempting || updated.Status.NominatedNode != node.Name {
+		t.Fatalf("Expected the workload to wait for node1 after preempting, got %q nominating %q", updated.Status.Reason, updated.Status.NominatedNode)
+	}
+	if result.RequeueAfter != preemptionRecheckInterval {
+		t.Errorf("Expected a requeue after %v, got %v", preemptionRecheckInterval, result.RequeueAfter)
+	}
+
+	victim := &gpuv1alpha1.GPUWorkload{}
+	if err := r.Get(context.Background(), types.NamespacedName{Name: low.Name, Namespace: low.Namespace}, victim); err != nil {
+		t.Fatalf("unable to fetch victim: %v", err)
+	}
+	if victim.Status.Phase != gpuv1alpha1.PhasePending || victim.Status.Reason != reasonPreempted || victim.Status.AssignedNode != "" {
+		t.Errorf("Expected the low priority workload to be Pending and unplaced, got %s (%q) on %q", victim.Status.Phase, victim.Status.Reason, victim.Status.AssignedNode)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected the victim's job to be deleted, got %d jobs", len(jobs))
+	}
+	event := recorder.find("Preempted")
+	if event == nil || event.object.(*gpuv1alpha1.GPUWorkload).Name != low.Name {
+		t.Fatalf("Expected a Preempted event on the victim, got %+v", recorder.events)
+	}
+	if want := "Preempted by high priority workload default/urgent"; event.message != want {
+		t.Errorf("Expected message %q, got %q", want, event.message)
+	}
+	if recorder.find("PreemptedWorkload") == nil {
+		t.Error("Expected a PreemptedWorkload event on the preemptor")
+	}
+
+	// Once the victim's pod is gone the workload takes its GPUs
+	if err := r.Delete(context.Background(), createGPUPod("low-pod", node.Name, 2)); err != nil {
+		t.Fatalf("unable to delete pod: %v", err)
+	}
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.AssignedNode != node.Name {
+		t.Errorf("Expected the workload scheduled on node1, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.NominatedNode != "" {
+		t.Errorf("Expected the nominated node to be cleared, got %q", updated.Status.NominatedNode)
+	}
+}
+
+func TestReconcile_PreemptionRequiresPolicyAndLowerPriority(t *testing.T) {
+	node := createGPUNode("node1", 2)
+	pod := createGPUPod("batch-pod", node.Name, 2)
+
+	for name, tc := range map[string]struct {
+		policy          string
+		preemptor, held string
+	}{
+		"policy unset":   {"", "high", "low"},
+		"never":          {gpuv1alpha1.PreemptNever, "high", "low"},
+		"equal priority": {gpuv1alpha1.PreemptLowerPriority, "normal", ""},
+		"higher victim":  {gpuv1alpha1.PreemptLowerPriority, "low", "high"},
+	} {
+		running := createRunningWorkload("batch", tc.held, node.Name, 2)
+		gw := createTestWorkload("urgent", 2)
+		gw.Spec.Priority = tc.preemptor
+		gw.Spec.PreemptionPolicy = tc.policy
+		r := newTestReconciler(t, gw, running, &node, pod)
+
+		_, updated := reconcileWorkload(t, r, gw)
+		if updated.Status.Reason != "all_nodes_full" {
+			t.Errorf("%s: expected all_nodes_full without preemption, got %q", name, updated.Status.Reason)
+		}
+		held := &gpuv1alpha1.GPUWorkload{}
+		if err := r.Get(context.Background(), types.NamespacedName{Name: running.Name, Namespace: running.Namespace}, held); err != nil {
+			t.Fatalf("%s: unable to fetch workload: %v", name, err)
+		}
+		if held.Status.Phase != gpuv1alpha1.PhaseRunning {
+			t.Errorf("%s: expected the running workload to be left alone, got %s", name, held.Status.Phase)
+		}
+	}
+}
+
+func TestPreemptionVictim_PrefersLowestPriorityThenFewestGPUs(t *testing.T) {
+	node := createGPUNode("node1", 8)
+	gw := createTestWorkload("urgent", 2)
+	gw.Spec.Priority = "high"
+
+	// node1 is full, so the victim must free at least 2 GPUs; node2 is not a candidate
+	normal := createRunningWorkload("normal-small", "normal", node.Name, 2)
+	lowLarge := createRunningWorkload("low-large", "low", node.Name, 4)
+	lowSmall := createRunningWorkload("low-small", "low", node.Name, 2)
+	lowTooSmall := createRunningWorkload("low-too-small", "low", node.Name, 1)
+	elsewhere := createRunningWorkload("low-elsewhere", "low", "node2", 2)
+	r := newTestReconciler(t, gw, normal, lowLarge, lowSmall, lowTooSmall, elsewhere, createGPUPod("filler", node.Name, 8))
+
+	candidates := []corev1.Node{node}
+	pods, err := nodePods(context.Background(), r, candidates)
+	if err != nil {
+		t.Fatalf("nodePods() error = %v", err)
+	}
+	victim, err := r.preemptionVictim(context.Background(), gw, candidates, pods)
+	if err != nil {
+		t.Fatalf("preemptionVictim() error = %v", err)
+	}
+	if victim == nil || victim.Name != lowSmall.Name {
+		t.Errorf("Expected low-small to be preempted, got %v", victim)
+	}
+}
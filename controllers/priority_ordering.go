@@ -0,0 +1,146 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/queue"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+const (
+	// reasonQueued is the Pending reason of a workload waiting for one ahead of it in the
+	// scheduling queue to be placed first.
+	reasonQueued = "queued"
+
+	// queuedRecheckInterval is how often a workload waiting behind another is retried. The
+	// workload ahead is woken right away, so this only bounds the wait when it is not placed.
+	queuedRecheckInterval = 5 * time.Second
+
+	// queueEventsBuffer is how many wake-ups of workloads ahead in the queue may be pending.
+	queueEventsBuffer = 64
+)
+
+// serializePlacements reports whether placements hold placementMu and count the GPUs of placed
+// workloads. Priority ordering needs both, so a workload and the ones queued behind it are
+// never placed concurrently.
+func (r *GPUWorkloadReconciler) serializePlacements() bool {
+	return r.SerializePlacements || r.PriorityOrdering
+}
+
+// schedulingQueue returns the queue of workloads waiting to be placed, creating it on first use.
+func (r *GPUWorkloadReconciler) schedulingQueue() *queue.Queue {
+	r.pendingQueueOnce.Do(func() { r.pendingQueue = queue.New() })
+	return r.pendingQueue
+}
+
+// syncSchedulingQueue takes the workload off the queue once it no longer waits to be placed.
+// gw is nil when the workload no longer exists.
+func (r *GPUWorkloadReconciler) syncSchedulingQueue(key types.NamespacedName, gw *gpuv1alpha1.GPUWorkload) {
+	if !r.PriorityOrdering {
+		return
+	}
+	if gw == nil || !awaitingScheduling(gw) {
+		r.dequeue(key)
+	}
+}
+
+// dequeue takes the workload off the scheduling queue.
+func (r *GPUWorkloadReconciler) dequeue(key types.NamespacedName) {
+	if !r.PriorityOrdering {
+		return
+	}
+	q := r.schedulingQueue()
+	q.Remove(key)
+	if m := metrics.GetMetrics(); m != nil {
+		m.SetQueueDepth(q.Len())
+	}
+}
+
+// queuedBehind queues the workload and returns the first workload ahead of it that should be
+// placed before it: one that fits on a node both may use, given the GPUs free on nodes. Only
+// whole-GPU workloads on the local cluster are ordered; MIG slices are not comparable to GPUs.
+func (r *GPUWorkloadReconciler) queuedBehind(gw *gpuv1alpha1.GPUWorkload, nodes []corev1.Node, pods scheduling.NodePods) (queue.Item, bool) {
+	if !r.PriorityOrdering || gw.Spec.MIGProfile != "" {
+		return queue.Item{}, false
+	}
+	item := queueItem(gw)
+	q := r.schedulingQueue()
+	q.Add(item)
+	if m := metrics.GetMetrics(); m != nil {
+		m.SetQueueDepth(q.Len())
+	}
+
+	capacity := pods.Capacity(r.capacity())
+	for _, ahead := range q.Ahead(item.Key) {
+		selector := labels.SelectorFromSet(ahead.NodeSelector)
+		for i := range nodes {
+			node := &nodes[i]
+			if !matchesNodeSelector(node, gw) || !selector.Matches(labels.Set(node.Labels)) {
+				continue
+			}
+			if ahead.HoldsBack(capacity.AvailableGPUs(node)) {
+				return ahead, true
+			}
+		}
+	}
+	return queue.Item{}, false
+}
+
+// markUnplaced records that the workload could not be placed on nodes, so it stops holding
+// back the workloads queued behind it until more GPUs are free.
+func (r *GPUWorkloadReconciler) markUnplaced(gw *gpuv1alpha1.GPUWorkload, nodes []corev1.Node, pods scheduling.NodePods) {
+	if !r.PriorityOrdering {
+		return
+	}
+	capacity := pods.Capacity(r.capacity())
+	var free int64
+	for i := range nodes {
+		if available := capacity.AvailableGPUs(&nodes[i]); available > free {
+			free = available
+		}
+	}
+	r.schedulingQueue().MarkUnplaced(types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}, free)
+}
+
+// wakeQueued triggers a reconcile of the workload ahead, so it is placed without waiting for
+// its own requeue. The send does not block; a full channel means a wake-up is already pending.
+func (r *GPUWorkloadReconciler) wakeQueued(item queue.Item) {
+	if r.queueEvents == nil {
+		return
+	}
+	gw := &gpuv1alpha1.GPUWorkload{}
+	gw.Namespace, gw.Name = item.Key.Namespace, item.Key.Name
+	select {
+	case r.queueEvents <- event.GenericEvent{Object: gw}:
+	default:
+	}
+}
+
+// queuedMessage explains which workload the queued workload waits for.
+func queuedMessage(ahead queue.Item) string {
+	return fmt.Sprintf("Waiting for %s (priority %s) to be placed first", ahead.Key, priorityName(ahead.Priority))
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+func TestReconcile_PriorityOrderingPlacesHighPriorityFirst(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := createGPUNode("node-a", 4)
+
+	// holder keeps node-a full until it finishes; its pod has not started yet
+	holder, job, _ := createPlacedWorkload("holder", "node-a", 4)
+	batch := createTestWorkload("batch", 2)
+	batch.CreationTimestamp = metav1.NewTime(created)
+	urgent := createTestWorkload("urgent", 4)
+	urgent.Spec.Priority = "high"
+	urgent.CreationTimestamp = metav1.NewTime(created.Add(time.Minute))
+
+	r := newTestReconciler(t, &node, holder, job, batch, urgent)
+	r.PriorityOrdering = true
+	wake := make(chan event.GenericEvent, 1)
+	r.queueEvents = wake
+
+	// Neither fits while holder runs; both wait in the queue
+	reconcileWorkload(t, r, urgent)
+	reconcileWorkload(t, r, batch)
+	if depth := testutil.ToFloat64(metrics.GetMetrics().GPUWorkloadQueueDepth); depth != 2 {
+		t.Errorf("Expected a queue depth of 2, got %v", depth)
+	}
+
+	holder = getWorkload(t, r, "holder")
+	holder.Status.Phase = gpuv1alpha1.PhaseSucceeded
+	if err := r.Status().Update(context.Background(), holder); err != nil {
+		t.Fatalf("unable to update holder: %v", err)
+	}
+
+	// batch, older but of lower priority, reconciles first and leaves the GPUs to urgent
+	_, queued := reconcileWorkload(t, r, getWorkload(t, r, "batch"))
+	if queued.Status.Phase != gpuv1alpha1.PhasePending || queued.Status.Reason != reasonQueued {
+		t.Fatalf("Expected batch to wait in the queue, got phase %s reason %q", queued.Status.Phase, queued.Status.Reason)
+	}
+	select {
+	case e := <-wake:
+		if e.Object.GetName() != "urgent" {
+			t.Errorf("Expected urgent to be woken, got %s", e.Object.GetName())
+		}
+	default:
+		t.Error("Expected the workload ahead to be woken")
+	}
+
+	_, placed := reconcileWorkload(t, r, getWorkload(t, r, "urgent"))
+	if placed.Status.Phase != gpuv1alpha1.PhaseScheduled || placed.Status.AssignedNode != "node-a" {
+		t.Errorf("Expected urgent to be placed on node-a, got phase %s node %q", placed.Status.Phase, placed.Status.AssignedNode)
+	}
+	if depth := testutil.ToFloat64(metrics.GetMetrics().GPUWorkloadQueueDepth); depth != 1 {
+		t.Errorf("Expected a queue depth of 1 once urgent is placed, got %v", depth)
+	}
+}
+
+func TestReconcile_PriorityOrderingSkipsWorkloadsThatCannotUseTheNode(t *testing.T) {
+	node := createGPUNode("node-a", 4)
+	urgent := createTestWorkload("urgent", 8)
+	urgent.Spec.Priority = "high"
+	pinned := createTestWorkload("pinned", 2)
+	pinned.Spec.Priority = "high"
+	pinned.Spec.NodeSelector = map[string]string{"pool": "a100"}
+	batch := createTestWorkload("batch", 2)
+
+	r := newTestReconciler(t, &node, urgent, pinned, batch)
+	r.PriorityOrdering = true
+
+	// urgent never fits node-a and pinned may not use it, so neither holds batch back
+	reconcileWorkload(t, r, urgent)
+	reconcileWorkload(t, r, pinned)
+	_, placed := reconcileWorkload(t, r, batch)
+	if placed.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected batch to be placed, got phase %s reason %q", placed.Status.Phase, placed.Status.Reason)
+	}
+}
+
+func TestReconcile_PriorityOrderingDisabledByDefault(t *testing.T) {
+	node := createGPUNode("node-a", 4)
+	urgent := createTestWorkload("urgent", 4)
+	urgent.Spec.Priority = "high"
+	batch := createTestWorkload("batch", 2)
+
+	r := newTestReconciler(t, &node, urgent, batch)
+	r.schedulingQueue().Add(queueItem(urgent))
+
+	if _, placed := reconcileWorkload(t, r, batch); placed.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Errorf("Expected batch to be placed without priority ordering, got phase %s", placed.Status.Phase)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// CPU and memory reserved per GPU for workloads whose priority maps to a QoS class
+// that needs them, unless CPUPerGPU or MemoryPerGPU is set.
+var (
+	defaultCPUPerGPU    = resource.MustParse("4")
+	defaultMemoryPerGPU = resource.MustParse("16Gi")
+)
+
+// workloadResources maps the workload's priority onto the pod QoS class, which decides
+// the kubelet's oom_score_adj and therefore the order in which workloads are OOM-killed:
+//
+//   - high: Guaranteed. CPU and memory requests equal their limits (oom_score_adj -997).
+//   - normal: Burstable. CPU and memory are requested without limits.
+//   - low: BestEffort. Only GPUs are requested, so these pods are killed first (oom_score_adj 1000).
+//
+// GPUs are always requested with equal limits, as extended resources require. CPU and
+// memory quantities set in the spec replace those derived from the priority, and a derived
+// request never exceeds a limit set in the spec.
+func (r *GPUWorkloadReconciler) workloadResources(gw *gpuv1alpha1.GPUWorkload) corev1.ResourceRequirements {
+	cpuPerGPU, memoryPerGPU := r.CPUPerGPU, r.MemoryPerGPU
+	if cpuPerGPU.IsZero() {
+		cpuPerGPU = defaultCPUPerGPU
+	}
+	if memoryPerGPU.IsZero() {
+		memoryPerGPU = defaultMemoryPerGPU
+	}
+
+	gpus := gw.RequestedGPUCount()
+	gpuQuantity := *resource.NewQuantity(int64(gpus), resource.DecimalSI)
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{gpuResourceName(gw): gpuQuantity},
+		Limits:   corev1.ResourceList{gpuResourceName(gw): gpuQuantity},
+	}
+
+	switch gw.Spec.Priority {
+	case "low":
+	case "high":
+		resources.Limits[corev1.ResourceCPU] = scaleQuantity(cpuPerGPU, gpus)
+		resources.Limits[corev1.ResourceMemory] = scaleQuantity(memoryPerGPU, gpus)
+		fallthrough
+	default:
+		resources.Requests[corev1.ResourceCPU] = scaleQuantity(cpuPerGPU, gpus)
+		resources.Requests[corev1.ResourceMemory] = scaleQuantity(memoryPerGPU, gpus)
+	}
+
+	setQuantity(resources.Requests, corev1.ResourceCPU, gw.Spec.CPURequest)
+	setQuantity(resources.Requests, corev1.ResourceMemory, gw.Spec.MemoryRequest)
+	setQuantity(resources.Limits, corev1.ResourceCPU, gw.Spec.CPULimit)
+	setQuantity(resources.Limits, corev1.ResourceMemory, gw.Spec.MemoryLimit)
+	if gw.Spec.Priority == "high" {
+		// Keep the workload Guaranteed when only one side of a resource is overridden
+		matchQuantity(resources, corev1.ResourceCPU, gw.Spec.CPURequest, gw.Spec.CPULimit)
+		matchQuantity(resources, corev1.ResourceMemory, gw.Spec.MemoryRequest, gw.Spec.MemoryLimit)
+	}
+	// The API server rejects a Job whose request exceeds its limit
+	capRequest(resources, corev1.ResourceCPU, gw.Spec.CPURequest)
+	capRequest(resources, corev1.ResourceMemory, gw.Spec.MemoryRequest)
+	return resources
+}
+
+// setQuantity sets the named resource in list to value, when value is a valid quantity.
+// Empty values leave the list untouched; workloads with invalid ones fail in validateSpec.
+func setQuantity(list corev1.ResourceList, name corev1.ResourceName, value string) {
+	if value == "" {
+		return
+	}
+	if quantity, err := resource.ParseQuantity(value); err == nil {
+		list[name] = quantity
+	}
+}
+
+// matchQuantity copies the overridden side of a resource onto the other, so its request and
+// limit stay equal.
+func matchQuantity(resources corev1.ResourceRequirements, name corev1.ResourceName, request, limit string) {
+	switch {
+	case request != "" && limit == "":
+		resources.Limits[name] = resources.Requests[name]
+	case request == "" && limit != "":
+		resources.Requests[name] = resources.Limits[name]
+	}
+}
+
+// capRequest lowers a request derived from the priority to the resource's limit when it
+// exceeds it. Requests set in the spec are left for validateSpec to judge.
+func capRequest(resources corev1.ResourceRequirements, name corev1.ResourceName, request string) {
+	if request != "" {
+		return
+	}
+	limit, hasLimit := resources.Limits[name]
+	if derived, ok := resources.Requests[name]; ok && hasLimit && derived.Cmp(limit) > 0 {
+		resources.Requests[name] = limit
+	}
+}
+
+// scaleQuantity returns q multiplied by n.
+func scaleQuantity(q resource.Quantity, n int32) resource.Quantity {
+	scaled := resource.NewMilliQuantity(q.MilliValue()*int64(n), q.Format)
+	return *scaled
+}
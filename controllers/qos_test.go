@@ -0,0 +1,211 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// podQOSClass derives the QoS class the kubelet assigns to a pod, following the rules of
+// the Kubernetes QoS documentation for CPU and memory.
+func podQOSClass(spec corev1.PodSpec) corev1.PodQOSClass {
+	guaranteed, bestEffort := true, true
+	for _, container := range spec.Containers {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			request, hasRequest := container.Resources.Requests[name]
+			limit, hasLimit := container.Resources.Limits[name]
+			if hasRequest || hasLimit {
+				bestEffort = false
+			}
+			if !hasLimit || (hasRequest && request.Cmp(limit) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+	switch {
+	case bestEffort:
+		return corev1.PodQOSBestEffort
+	case guaranteed:
+		return corev1.PodQOSGuaranteed
+	default:
+		return corev1.PodQOSBurstable
+	}
+}
+
+func TestReconcile_PriorityMapsToQOSClass(t *testing.T) {
+	tests := []struct {
+		priority string
+		expected corev1.PodQOSClass
+	}{
+		{"high", corev1.PodQOSGuaranteed},
+		{"normal", corev1.PodQOSBurstable},
+		{"", corev1.PodQOSBurstable},
+		{"low", corev1.PodQOSBestEffort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.priority, func(t *testing.T) {
+			gw := createTestWorkload("qos", 2)
+			gw.Spec.Priority = tt.priority
+			node := createGPUNode("node1", 4)
+			r := newTestReconciler(t, gw, &node)
+
+			_, updated := reconcileWorkload(t, r, gw)
+			if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+				t.Fatalf("Expected Scheduled, got %q", updated.Status.Phase)
+			}
+
+			jobs := listJobs(t, r)
+			if len(jobs) != 1 {
+				t.Fatalf("Expected 1 job, got %d", len(jobs))
+			}
+			if qos := podQOSClass(jobs[0].Spec.Template.Spec); qos != tt.expected {
+				t.Errorf("Expected %s QoS for priority %q, got %s", tt.expected, tt.priority, qos)
+			}
+		})
+	}
+}
+
+func TestWorkloadResources_HighPriorityLimitsEqualRequests(t *testing.T) {
+	gw := createTestWorkload("guaranteed", 2)
+	gw.Spec.Priority = "high"
+
+	resources := (&GPUWorkloadReconciler{}).workloadResources(gw)
+	for name, request := range resources.Requests {
+		limit, ok := resources.Limits[name]
+		if !ok || request.Cmp(limit) != 0 {
+			t.Errorf("Expected %s limit to equal request %s, got %v", name, request.String(), limit.String())
+		}
+	}
+	if cpu := resources.Requests[corev1.ResourceCPU]; cpu.Value() != 8 {
+		t.Errorf("Expected 8 CPUs for 2 GPUs, got %s", cpu.String())
+	}
+	if memory := resources.Requests[corev1.ResourceMemory]; memory.String() != "32Gi" {
+		t.Errorf("Expected 32Gi memory for 2 GPUs, got %s", memory.String())
+	}
+}
+
+func TestWorkloadResources_SpecQuantitiesOverridePriority(t *testing.T) {
+	gw := createTestWorkload("sized", 2)
+	gw.Spec.CPURequest = "500m"
+	gw.Spec.MemoryRequest = "8Gi"
+	gw.Spec.MemoryLimit = "12Gi"
+
+	resources := (&GPUWorkloadReconciler{}).workloadResources(gw)
+	if cpu := resources.Requests[corev1.ResourceCPU]; cpu.String() != "500m" {
+		t.Errorf("Expected a 500m CPU request, got %s", cpu.String())
+	}
+	if _, ok := resources.Limits[corev1.ResourceCPU]; ok {
+		t.Error("Expected no CPU limit for a normal priority workload without spec.cpuLimit")
+	}
+	if memory := resources.Requests[corev1.ResourceMemory]; memory.String() != "8Gi" {
+		t.Errorf("Expected an 8Gi memory request, got %s", memory.String())
+	}
+	if memory := resources.Limits[corev1.ResourceMemory]; memory.String() != "12Gi" {
+		t.Errorf("Expected a 12Gi memory limit, got %s", memory.String())
+	}
+
+	// A high priority workload stays Guaranteed when only its CPU request is set
+	gw.Spec.Priority = "high"
+	gw.Spec.MemoryRequest, gw.Spec.MemoryLimit = "", ""
+	resources = (&GPUWorkloadReconciler{}).workloadResources(gw)
+	if cpu := resources.Limits[corev1.ResourceCPU]; cpu.String() != "500m" {
+		t.Errorf("Expected the CPU limit to follow the 500m request, got %s", cpu.String())
+	}
+	if qos := podQOSClass(corev1.PodSpec{Containers: []corev1.Container{{Resources: resources}}}); qos != corev1.PodQOSGuaranteed {
+		t.Errorf("Expected Guaranteed QoS, got %s", qos)
+	}
+}
+
+func TestWorkloadResources_DerivedRequestsAreCappedAtSpecLimits(t *testing.T) {
+	gw := createTestWorkload("capped", 1)
+	gw.Spec.CPULimit = "2"
+	gw.Spec.MemoryLimit = "8Gi"
+
+	resources := (&GPUWorkloadReconciler{}).workloadResources(gw)
+	if cpu := resources.Requests[corev1.ResourceCPU]; cpu.String() != "2" {
+		t.Errorf("Expected the CPU request to be capped at the 2 CPU limit, got %s", cpu.String())
+	}
+	if memory := resources.Requests[corev1.ResourceMemory]; memory.String() != "8Gi" {
+		t.Errorf("Expected the memory request to be capped at the 8Gi limit, got %s", memory.String())
+	}
+}
+
+func TestWorkloadResources_UsesConfiguredPerGPUQuantities(t *testing.T) {
+	gw := createTestWorkload("configured", 2)
+	r := &GPUWorkloadReconciler{CPUPerGPU: resource.MustParse("6"), MemoryPerGPU: resource.MustParse("24Gi")}
+
+	resources := r.workloadResources(gw)
+	if cpu := resources.Requests[corev1.ResourceCPU]; cpu.Value() != 12 {
+		t.Errorf("Expected 12 CPUs for 2 GPUs, got %s", cpu.String())
+	}
+	if memory := resources.Requests[corev1.ResourceMemory]; memory.String() != "48Gi" {
+		t.Errorf("Expected 48Gi memory for 2 GPUs, got %s", memory.String())
+	}
+}
+
+func TestReconcile_JobRequestsTheWorkloadGPUCount(t *testing.T) {
+	gw := createTestWorkload("four-gpus", 4)
+	node := createGPUNode("node1", 8)
+	r := newTestReconciler(t, gw, &node)
+
+	if _, updated := reconcileWorkload(t, r, gw); updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %q", updated.Status.Phase)
+	}
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	resources := jobs[0].Spec.Template.Spec.Containers[0].Resources
+	for _, list := range []corev1.ResourceList{resources.Requests, resources.Limits} {
+		if gpus := list[corev1.ResourceName("nvidia.com/gpu")]; gpus.String() != "4" {
+			t.Errorf("Expected exactly 4 GPUs, got %s", gpus.String())
+		}
+	}
+}
+
+func TestReconcile_MIGWorkloadRequestsAllocatableSlices(t *testing.T) {
+	gw := createTestWorkload("mig", 2)
+	gw.Spec.MIGProfile = "1g.5gb"
+	// The node advertises only MIG slices, no whole GPUs
+	node := createGPUNode("a100", 0)
+	slices := *resource.NewQuantity(7, resource.DecimalSI)
+	node.Status.Allocatable[scheduling.MIGResourceName("1g.5gb")] = slices
+	node.Status.Capacity[scheduling.MIGResourceName("1g.5gb")] = slices
+	r := newTestReconciler(t, gw, &node)
+
+	if _, updated := reconcileWorkload(t, r, gw); updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.AssignedNode != "a100" {
+		t.Fatalf("Expected Scheduled on a100, got %q on %q", updated.Status.Phase, updated.Status.AssignedNode)
+	}
+	jobs := listJobs(t, r)
+	if len(jobs) != 1 {
+		t.Fatalf("Expected 1 job, got %d", len(jobs))
+	}
+	resources := jobs[0].Spec.Template.Spec.Containers[0].Resources
+	if migSlices := resources.Limits["nvidia.com/mig-1g.5gb"]; migSlices.String() != "2" {
+		t.Errorf("Expected 2 nvidia.com/mig-1g.5gb slices, got %s", migSlices.String())
+	}
+	if _, ok := resources.Limits["nvidia.com/gpu"]; ok {
+		t.Error("Expected no whole GPUs to be requested")
+	}
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// recordReconcileError counts a failed reconcile step in warp_reconcile_errors_total under
+// errorType. Writes rejected for a stale resourceVersion are counted as conflicts whatever
+// the step, since they call for a retry rather than a fix.
+func recordReconcileError(errorType string, err error) {
+	if apierrors.IsConflict(err) {
+		errorType = metrics.ReconcileErrorConflict
+	}
+	if m := metrics.GetMetrics(); m != nil {
+		m.RecordReconcileError(errorType)
+	}
+}
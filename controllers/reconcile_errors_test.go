@@ -0,0 +1,118 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+func reconcileErrors(errorType string) float64 {
+	return testutil.ToFloat64(metrics.GetMetrics().ReconcileErrorsTotal.WithLabelValues(errorType))
+}
+
+func TestReconcile_RecordsReconcileErrorTypes(t *testing.T) {
+	conflict := apierrors.NewConflict(schema.GroupResource{Group: "gpu.warp.dev", Resource: "gpuworkloads"}, "workload", errors.New("object was modified"))
+
+	tests := []struct {
+		name      string
+		errorType string
+		strategy  string
+		funcs     interceptor.Funcs
+	}{
+		{
+			name:      "node list",
+			errorType: metrics.ReconcileErrorNodeList,
+			funcs: interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*corev1.NodeList); ok {
+						return errors.New("apiserver unavailable")
+					}
+					return c.List(ctx, list, opts...)
+				},
+			},
+		},
+		{
+			name:      "status update",
+			errorType: metrics.ReconcileErrorStatusUpdate,
+			funcs: interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					return errors.New("etcd timeout")
+				},
+			},
+		},
+		{
+			name:      "status conflict",
+			errorType: metrics.ReconcileErrorConflict,
+			funcs: interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					return conflict
+				},
+			},
+		},
+		{
+			name:      "job create",
+			errorType: metrics.ReconcileErrorJobCreate,
+			funcs: interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if _, ok := obj.(*batchv1.Job); ok {
+						return errors.New("admission webhook denied the request")
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			},
+		},
+		{
+			name:      "strategy",
+			errorType: metrics.ReconcileErrorStrategy,
+			strategy:  "migPartition", // without a migProfile
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := createTestWorkload("reconcile-errors", 2)
+			gw.Status.Phase = gpuv1alpha1.PhasePending
+			gw.Spec.SchedulingStrategy = tt.strategy
+			node := createGPUNode("node1", 4)
+			r := newTestReconciler(t, gw, &node)
+			r.Client = interceptor.NewClient(r.Client.(client.WithWatch), tt.funcs)
+
+			before := reconcileErrors(tt.errorType)
+			key := types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}
+			r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+
+			if got := reconcileErrors(tt.errorType) - before; got < 1 {
+				t.Errorf("Expected the %s reconcile error to be counted, got %v more", tt.errorType, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// roundedGPUCount returns the GPU count rounded by GPURequestRounding. A count that would
+// round above MaxGPUCount is left as it is.
+func (r *GPUWorkloadReconciler) roundedGPUCount(gpus int32) int32 {
+	rounded := r.GPURequestRounding.Round(int64(gpus))
+	if rounded > gpuv1alpha1.MaxGPUCount {
+		return gpus
+	}
+	return int32(rounded)
+}
+
+// roundedResources returns the resources of the workload container with its memory request
+// and limit rounded by MemoryRequestRounding, whose granularity is in bytes.
+func (r *GPUWorkloadReconciler) roundedResources(gw *gpuv1alpha1.GPUWorkload) corev1.ResourceRequirements {
+	resources := r.workloadResources(gw)
+	for _, list := range []corev1.ResourceList{resources.Requests, resources.Limits} {
+		if memory, ok := list[corev1.ResourceMemory]; ok {
+			list[corev1.ResourceMemory] = *resource.NewQuantity(r.MemoryRequestRounding.Round(memory.Value()), resource.BinarySI)
+		}
+	}
+	return resources
+}
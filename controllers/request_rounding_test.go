@@ -0,0 +1,83 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/sizing"
+)
+
+func TestReconcile_RequestRounding(t *testing.T) {
+	// Memory is requested per rounded GPU, 16Gi each, then rounded to 20Gi
+	tests := []struct {
+		mode           sizing.RoundingMode
+		expectedGPUs   int32
+		expectedMemory string
+	}{
+		{sizing.RoundUp, 4, "80Gi"},
+		{sizing.RoundDown, 2, "20Gi"},
+		{sizing.RoundNearest, 4, "60Gi"},
+		{sizing.RoundOff, 3, "48Gi"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.mode), func(t *testing.T) {
+			gw := createTestWorkload("rounded", 3)
+			node := createGPUNode("node1", 8)
+			r := newTestReconciler(t, gw, &node)
+			r.GPURequestRounding = sizing.Rounding{Mode: tt.mode, Granularity: 2}
+			r.MemoryRequestRounding = sizing.Rounding{Mode: tt.mode, Granularity: 20 << 30}
+
+			_, updated := reconcileWorkload(t, r, gw)
+			if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+				t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+			}
+			if updated.Status.AllocatedGPUCount != tt.expectedGPUs {
+				t.Errorf("Expected %d allocated GPUs, got %d", tt.expectedGPUs, updated.Status.AllocatedGPUCount)
+			}
+			if updated.Status.AllocatedMemory != tt.expectedMemory {
+				t.Errorf("Expected %s allocated memory, got %s", tt.expectedMemory, updated.Status.AllocatedMemory)
+			}
+
+			jobs := listJobs(t, r)
+			if len(jobs) != 1 {
+				t.Fatalf("Expected one job, got %d", len(jobs))
+			}
+			memory := jobs[0].Spec.Template.Spec.Containers[0].Resources.Requests[corev1.ResourceMemory]
+			if expected := resource.MustParse(tt.expectedMemory); memory.Cmp(expected) != 0 {
+				t.Errorf("Expected a %s memory request, got %s", tt.expectedMemory, memory.String())
+			}
+		})
+	}
+}
+
+func TestReconcile_GPURoundingAboveMaximumIsSkipped(t *testing.T) {
+	gw := createTestWorkload("large", 7)
+	node := createGPUNode("node1", 8)
+	r := newTestReconciler(t, gw, &node)
+	r.GPURequestRounding = sizing.Rounding{Mode: sizing.RoundUp, Granularity: 3}
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.AllocatedGPUCount != 7 {
+		t.Errorf("Expected the count to stay at 7 rather than exceed %d, got %d", gpuv1alpha1.MaxGPUCount, updated.Status.AllocatedGPUCount)
+	}
+}
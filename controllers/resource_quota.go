@@ -0,0 +1,66 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// quotaExceeded reports whether a ResourceQuota of the workload's namespace, in the cluster
+// its Jobs would be created in, caps the workload's GPU resource below what its replicas
+// request on top of the quota's current usage. The message names the quota and its remaining
+// room. Quota scopes are not evaluated, so every quota capping the resource is honored.
+func (r *GPUWorkloadReconciler) quotaExceeded(ctx context.Context, cluster string, gw *gpuv1alpha1.GPUWorkload) (string, bool, error) {
+	c, err := r.clusterClient(cluster)
+	if err != nil {
+		return "", false, err
+	}
+	quotas := &corev1.ResourceQuotaList{}
+	if err := c.List(ctx, quotas, client.InNamespace(gw.Namespace)); err != nil {
+		return "", false, err
+	}
+	sort.Slice(quotas.Items, func(i, j int) bool { return quotas.Items[i].Name < quotas.Items[j].Name })
+
+	// Extended resources such as GPUs can only be capped through their requests
+	name := corev1.ResourceName(corev1.DefaultResourceRequestsPrefix + string(gpuResourceName(gw)))
+	requested := resource.NewQuantity(int64(gw.RequestedGPUCount())*int64(replicaCount(gw)), resource.DecimalSI)
+	for _, quota := range quotas.Items {
+		hard, ok := quota.Status.Hard[name]
+		if !ok {
+			hard, ok = quota.Spec.Hard[name]
+		}
+		if !ok {
+			continue
+		}
+		used := quota.Status.Used[name]
+		total := used.DeepCopy()
+		total.Add(*requested)
+		if total.Cmp(hard) > 0 {
+			return fmt.Sprintf("ResourceQuota %s caps %s at %s in namespace %s: %s used, %s requested",
+				quota.Name, name, hard.String(), gw.Namespace, used.String(), requested.String()), true, nil
+		}
+	}
+	return "", false, nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// createGPUQuota returns a ResourceQuota in namespace capping NVIDIA GPU requests at hard,
+// of which used are in use.
+func createGPUQuota(namespace string, hard, used int64) *corev1.ResourceQuota {
+	name := corev1.ResourceName("requests.nvidia.com/gpu")
+	return &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-quota", Namespace: namespace},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{name: *resource.NewQuantity(hard, resource.DecimalSI)},
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: corev1.ResourceList{name: *resource.NewQuantity(hard, resource.DecimalSI)},
+			Used: corev1.ResourceList{name: *resource.NewQuantity(used, resource.DecimalSI)},
+		},
+	}
+}
+
+func TestReconcile_NearFullQuotaKeepsWorkloadPending(t *testing.T) {
+	gw := createTestWorkload("over-quota", 2)
+	node := createGPUNode("node1", 8)
+	quota := createGPUQuota("default", 4, 3)
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw, &node, quota)
+	r.Recorder = recorder
+
+	result, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "namespace_quota_exceeded" {
+		t.Fatalf("Expected Pending with reason namespace_quota_exceeded, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if !strings.Contains(updated.Status.Message, "gpu-quota") {
+		t.Errorf("Expected the message to name the quota, got %q", updated.Status.Message)
+	}
+	if updated.Status.RetryCount != 0 {
+		t.Errorf("Expected no retry to be counted, got %d", updated.Status.RetryCount)
+	}
+	if result.RequeueAfter != quotaRecheckInterval {
+		t.Errorf("Expected requeue after %v, got %v", quotaRecheckInterval, result.RequeueAfter)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no Job to be created, got %d", len(jobs))
+	}
+	if event := recorder.find("NamespaceQuotaExceeded"); event == nil || event.eventType != corev1.EventTypeWarning {
+		t.Errorf("Expected a NamespaceQuotaExceeded warning event, got %+v", recorder.events)
+	}
+}
+
+func TestReconcile_RequestWithinQuotaIsScheduled(t *testing.T) {
+	gw := createTestWorkload("within-quota", 1)
+	node := createGPUNode("node1", 8)
+	quota := createGPUQuota("default", 4, 3)
+
+	r := newTestReconciler(t, gw, &node, quota)
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled within the quota, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+}
+
+func TestReconcile_QuotaOfOtherNamespaceIsIgnored(t *testing.T) {
+	gw := createTestWorkload("other-namespace", 2)
+	node := createGPUNode("node1", 8)
+	quota := createGPUQuota("team-b", 4, 4)
+
+	r := newTestReconciler(t, gw, &node, quota)
+
+	_, updated := reconcileWorkload(t, r, gw)
+
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+)
+
+// schedulingDeadlineReason is the status reason and failure metric label of workloads that
+// were not scheduled before their deadline.
+const schedulingDeadlineReason = "deadline_exceeded"
+
+// schedulingDeadlineRemaining returns how long the workload may still wait to be scheduled,
+// and false when it has no deadline.
+func (r *GPUWorkloadReconciler) schedulingDeadlineRemaining(gw *gpuv1alpha1.GPUWorkload) (time.Duration, bool) {
+	if gw.Spec.SchedulingDeadlineSeconds == nil {
+		return 0, false
+	}
+	deadline := gw.CreationTimestamp.Add(time.Duration(*gw.Spec.SchedulingDeadlineSeconds) * time.Second)
+	return deadline.Sub(r.now()), true
+}
+
+// capToSchedulingDeadline shortens a requeue delay that would overshoot the workload's
+// scheduling deadline, so it fails when the deadline passes rather than after the delay.
+func (r *GPUWorkloadReconciler) capToSchedulingDeadline(gw *gpuv1alpha1.GPUWorkload, after time.Duration) time.Duration {
+	if remaining, ok := r.schedulingDeadlineRemaining(gw); ok && remaining > 0 && remaining < after {
+		return remaining
+	}
+	return after
+}
+
+// failSchedulingDeadline fails a workload that is still waiting to be scheduled past its
+// deadline. Like an invalid spec, the failure sets no FailedTime, so it is final rather than
+// auto-retried, and the workload is not requeued.
+func (r *GPUWorkloadReconciler) failSchedulingDeadline(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	gw.Status.Phase = gpuv1alpha1.PhaseFailed
+	gw.Status.Reason = schedulingDeadlineReason
+	gw.Status.Message = "scheduling deadline exceeded"
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	if m := metrics.GetMetrics(); m != nil {
+		m.RecordSchedulingFailure(schedulingDeadlineReason)
+		m.RecordSchedulingTimeout()
+	}
+	log.Info("Scheduling deadline exceeded", "deadlineSeconds", *gw.Spec.SchedulingDeadlineSeconds)
+	r.Recorder.Event(gw, corev1.EventTypeWarning, "DeadlineExceeded", gw.Status.Message)
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_FailsPastSchedulingDeadline(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("deadline", 2)
+	gw.CreationTimestamp = metav1.NewTime(created)
+	gw.Spec.SchedulingDeadlineSeconds = int32Ptr(90)
+	gw.Spec.AutoRetryAfterSeconds = int32Ptr(60)
+	gw.Spec.RetryPolicy = &gpuv1alpha1.RetryPolicy{MaxRetries: 10, BackoffSeconds: 60}
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw)
+	r.Recorder = recorder
+	fakeClock := clocktesting.NewFakePassiveClock(created.Add(time.Minute))
+	r.Clock = fakeClock
+
+	// With no GPU node the workload backs off, but not past its deadline
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase == gpuv1alpha1.PhaseFailed {
+		t.Fatalf("Expected the workload to keep waiting before its deadline, got %s", updated.Status.Phase)
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > 30*time.Second {
+		t.Errorf("Expected a requeue at the deadline 30s away, got %v", result.RequeueAfter)
+	}
+
+	fakeClock.SetTime(created.Add(90 * time.Second))
+	result, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "deadline_exceeded" {
+		t.Fatalf("Expected Failed with reason deadline_exceeded, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+	}
+	if updated.Status.Message != "scheduling deadline exceeded" {
+		t.Errorf("Unexpected message %q", updated.Status.Message)
+	}
+	if result.Requeue || result.RequeueAfter != 0 {
+		t.Errorf("Expected no requeue, got %+v", result)
+	}
+	if recorder.find("DeadlineExceeded") == nil {
+		t.Errorf("Expected a DeadlineExceeded event, got %+v", recorder.events)
+	}
+
+	// The failure is final, even with auto-retry enabled
+	fakeClock.SetTime(created.Add(10 * time.Minute))
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseFailed {
+		t.Errorf("Expected the workload to stay Failed, got %s", updated.Status.Phase)
+	}
+}
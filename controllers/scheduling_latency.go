@@ -0,0 +1,109 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// latencyWindowSize is the number of most recent node selections kept per strategy. It bounds
+// the memory of the window to a few kilobytes per strategy.
+const latencyWindowSize = 1024
+
+// StrategyLatency reports the node selection latency of a strategy over its recent placements.
+type StrategyLatency struct {
+	Strategy   string  `json:"strategy"`
+	Samples    int     `json:"samples"`
+	P50Seconds float64 `json:"p50Seconds"`
+	P99Seconds float64 `json:"p99Seconds"`
+}
+
+// schedulingLatencies keeps a sliding window of the latest node selection latencies of each
+// strategy, so strategies can be compared on live traffic. Unlike the reconcile duration
+// histogram it is not aggregated across strategies, and old samples roll out of the window.
+type schedulingLatencies struct {
+	mu      sync.Mutex
+	windows map[string]*latencyWindow
+}
+
+// latencyWindow is a ring buffer of the latest latencyWindowSize samples.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+// observe records how long the strategy took to select a node.
+func (l *schedulingLatencies) observe(strategy string, latency time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.windows == nil {
+		l.windows = map[string]*latencyWindow{}
+	}
+	window, ok := l.windows[strategy]
+	if !ok {
+		window = &latencyWindow{}
+		l.windows[strategy] = window
+	}
+	if len(window.samples) < latencyWindowSize {
+		window.samples = append(window.samples, latency)
+		return
+	}
+	window.samples[window.next] = latency
+	window.next = (window.next + 1) % latencyWindowSize
+}
+
+// snapshot returns the p50 and p99 latency of every strategy with samples, by strategy name.
+func (l *schedulingLatencies) snapshot() []StrategyLatency {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	result := make([]StrategyLatency, 0, len(l.windows))
+	for strategy, window := range l.windows {
+		sorted := append([]time.Duration(nil), window.samples...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		result = append(result, StrategyLatency{
+			Strategy:   strategy,
+			Samples:    len(sorted),
+			P50Seconds: percentile(sorted, 50).Seconds(),
+			P99Seconds: percentile(sorted, 99).Seconds(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Strategy < result[j].Strategy })
+	return result
+}
+
+// percentile returns the nearest-rank p-th percentile of sorted, which must not be empty.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// serveSchedulingLatency writes the rolling node selection latency of each strategy as JSON.
+func (r *GPUWorkloadReconciler) serveSchedulingLatency(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(r.latencies.snapshot()); err != nil {
+		r.Log.Error(err, "unable to encode scheduling latencies")
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSchedulingLatencies_Percentiles(t *testing.T) {
+	var l schedulingLatencies
+	// 1ms to 100ms for leastLoaded, a constant 5ms for binPack
+	for i := 100; i >= 1; i-- {
+		l.observe("leastLoaded", time.Duration(i)*time.Millisecond)
+	}
+	for i := 0; i < 10; i++ {
+		l.observe("binPack", 5*time.Millisecond)
+	}
+
+	snapshot := l.snapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("Expected 2 strategies, got %+v", snapshot)
+	}
+	binPack, leastLoaded := snapshot[0], snapshot[1]
+	if binPack.Strategy != "binPack" || binPack.Samples != 10 || binPack.P50Seconds != 0.005 || binPack.P99Seconds != 0.005 {
+		t.Errorf("Unexpected binPack latency: %+v", binPack)
+	}
+	if leastLoaded.Strategy != "leastLoaded" || leastLoaded.Samples != 100 {
+		t.Fatalf("Unexpected leastLoaded latency: %+v", leastLoaded)
+	}
+	if leastLoaded.P50Seconds != 0.05 {
+		t.Errorf("Expected p50 of 50ms, got %vs", leastLoaded.P50Seconds)
+	}
+	if leastLoaded.P99Seconds != 0.099 {
+		t.Errorf("Expected p99 of 99ms, got %vs", leastLoaded.P99Seconds)
+	}
+}
+
+func TestSchedulingLatencies_WindowIsBounded(t *testing.T) {
+	var l schedulingLatencies
+	// Slow samples roll out of the window once enough fast ones are observed after them
+	for i := 0; i < latencyWindowSize; i++ {
+		l.observe("random", time.Second)
+	}
+	for i := 0; i < latencyWindowSize; i++ {
+		l.observe("random", time.Millisecond)
+	}
+
+	if got := len(l.windows["random"].samples); got != latencyWindowSize {
+		t.Errorf("Expected the window to hold %d samples, got %d", latencyWindowSize, got)
+	}
+	snapshot := l.snapshot()
+	if snapshot[0].Samples != latencyWindowSize || snapshot[0].P99Seconds != 0.001 {
+		t.Errorf("Expected only the latest samples to count, got %+v", snapshot[0])
+	}
+}
+
+func TestDebugHandler_SchedulingLatency(t *testing.T) {
+	gw := createTestWorkload("timed", 1)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+	reconcileWorkload(t, r, gw)
+
+	rec := getDebug(r, "/debug/latency")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var latencies []StrategyLatency
+	if err := json.Unmarshal(rec.Body.Bytes(), &latencies); err != nil {
+		t.Fatalf("unable to decode latencies: %v", err)
+	}
+	if len(latencies) != 1 || latencies[0].Strategy != "leastLoaded" || latencies[0].Samples != 1 {
+		t.Errorf("Expected one leastLoaded sample from the placement, got %+v", latencies)
+	}
+}
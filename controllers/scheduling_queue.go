@@ -0,0 +1,112 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/queue"
+)
+
+// QueueEntry describes a pending GPUWorkload and its position in the scheduling queue.
+type QueueEntry struct {
+	Position          int         `json:"position"`
+	Namespace         string      `json:"namespace"`
+	Name              string      `json:"name"`
+	Priority          string      `json:"priority"`
+	GPUCount          int32       `json:"gpuCount"`
+	RetryCount        int32       `json:"retryCount"`
+	Reason            string      `json:"reason,omitempty"`
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+}
+
+// awaitingScheduling reports whether the workload is waiting to be placed: new or Pending,
+// and not being deleted.
+func awaitingScheduling(gw *gpuv1alpha1.GPUWorkload) bool {
+	if gw.DeletionTimestamp != nil {
+		return false
+	}
+	return gw.Status.Phase == "" || gw.Status.Phase == gpuv1alpha1.PhasePending
+}
+
+// queueItem describes the workload as an item of the scheduling queue.
+func queueItem(gw *gpuv1alpha1.GPUWorkload) queue.Item {
+	return queue.Item{
+		Key:               types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name},
+		Priority:          gw.Spec.Priority,
+		CreationTimestamp: gw.CreationTimestamp.Time,
+		GPUCount:          gw.RequestedGPUCount(),
+		NodeSelector:      gw.Spec.NodeSelector,
+	}
+}
+
+// priorityName returns the priority as shown to users. Unset means normal.
+func priorityName(priority string) string {
+	if priority == "" {
+		return "normal"
+	}
+	return priority
+}
+
+// sortSchedulingQueue orders workloads the way the controller should schedule them, see queue.Less.
+func sortSchedulingQueue(workloads []gpuv1alpha1.GPUWorkload) {
+	sort.SliceStable(workloads, func(i, j int) bool {
+		return queue.Less(queueItem(&workloads[i]), queueItem(&workloads[j]))
+	})
+}
+
+// serveSchedulingQueue writes every workload waiting to be scheduled, in queue order, as JSON.
+func (r *GPUWorkloadReconciler) serveSchedulingQueue(w http.ResponseWriter, req *http.Request) {
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(req.Context(), workloads); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var pending []gpuv1alpha1.GPUWorkload
+	for _, gw := range workloads.Items {
+		if awaitingScheduling(&gw) {
+			pending = append(pending, gw)
+		}
+	}
+	sortSchedulingQueue(pending)
+
+	entries := make([]QueueEntry, 0, len(pending))
+	for i, gw := range pending {
+		entries = append(entries, QueueEntry{
+			Position:          i + 1,
+			Namespace:         gw.Namespace,
+			Name:              gw.Name,
+			Priority:          priorityName(gw.Spec.Priority),
+			GPUCount:          gw.RequestedGPUCount(),
+			RetryCount:        gw.Status.RetryCount,
+			Reason:            gw.Status.Reason,
+			CreationTimestamp: gw.CreationTimestamp,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		r.Log.Error(err, "unable to encode scheduling queue")
+	}
+}
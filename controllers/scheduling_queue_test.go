@@ -0,0 +1,74 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestDebugHandler_SchedulingQueue(t *testing.T) {
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queued := func(name, priority string, age time.Duration, phase gpuv1alpha1.GPUWorkloadPhase) *gpuv1alpha1.GPUWorkload {
+		gw := createTestWorkload(name, 1)
+		gw.Spec.Priority = priority
+		gw.CreationTimestamp = metav1.NewTime(base.Add(-age))
+		gw.Status.Phase = phase
+		return gw
+	}
+
+	r := newTestReconciler(t,
+		queued("normal-new", "normal", time.Minute, gpuv1alpha1.PhasePending),
+		queued("low-old", "low", time.Hour, gpuv1alpha1.PhasePending),
+		queued("high-new", "high", time.Minute, gpuv1alpha1.PhasePending),
+		queued("unset-old", "", time.Hour, ""),
+		queued("high-old", "high", time.Hour, gpuv1alpha1.PhasePending),
+		queued("high-running", "high", 2*time.Hour, gpuv1alpha1.PhaseRunning),
+	)
+
+	rec := getDebug(r, "/debug/queue")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var queue []QueueEntry
+	if err := json.Unmarshal(rec.Body.Bytes(), &queue); err != nil {
+		t.Fatalf("unable to decode queue: %v", err)
+	}
+
+	var names []string
+	for i, entry := range queue {
+		names = append(names, entry.Name)
+		if entry.Position != i+1 {
+			t.Errorf("Expected %s at position %d, got %d", entry.Name, i+1, entry.Position)
+		}
+	}
+	want := []string{"high-old", "high-new", "unset-old", "normal-new", "low-old"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected queue order %v, got %v", want, names)
+	}
+	if queue[2].Priority != "normal" {
+		t.Errorf("Expected unset priority to be reported as normal, got %q", queue[2].Priority)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// simulatedPhaseDuration is how long a simulated workload stays in each of the Scheduled
+// and Running phases.
+const simulatedPhaseDuration = 10 * time.Second
+
+// advanceSimulation moves a placed workload to its next phase once it has spent
+// simulatedPhaseDuration in the current one, measured from its schedule time: Scheduled
+// workloads start Running, Running workloads succeed. No Job exists to be followed.
+func (r *GPUWorkloadReconciler) advanceSimulation(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	next, after := gpuv1alpha1.PhaseRunning, simulatedPhaseDuration
+	if gw.Status.Phase == gpuv1alpha1.PhaseRunning {
+		next, after = gpuv1alpha1.PhaseSucceeded, 2*simulatedPhaseDuration
+	}
+	if gw.Status.LastScheduleTime != nil {
+		if remaining := gw.Status.LastScheduleTime.Add(after).Sub(r.now()); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	gw.Status.Phase = next
+	gw.Status.Reason = ""
+	gw.Status.Message = fmt.Sprintf("Simulated job %s is %s", gw.Status.JobName, next)
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Advanced simulated GPUWorkload", "phase", next)
+	if next == gpuv1alpha1.PhaseRunning {
+		return ctrl.Result{RequeueAfter: simulatedPhaseDuration}, nil
+	}
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_SimulateAdvancesPhasesWithoutJobs(t *testing.T) {
+	gw := createTestWorkload("simulated", 2)
+	node := createGPUNode("node1", 4)
+	r := newTestReconciler(t, gw, &node)
+	r.Simulate = true
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	r.Clock = fakeClock
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || updated.Status.JobName == "" {
+		t.Fatalf("Expected Scheduled with a simulated job name, got %s/%q", updated.Status.Phase, updated.Status.JobName)
+	}
+
+	result, updated := reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled || result.RequeueAfter != simulatedPhaseDuration {
+		t.Errorf("Expected to stay Scheduled and requeue after %v, got %s after %v", simulatedPhaseDuration, updated.Status.Phase, result.RequeueAfter)
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(simulatedPhaseDuration))
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseRunning {
+		t.Errorf("Expected Running after %v, got %s", simulatedPhaseDuration, updated.Status.Phase)
+	}
+
+	fakeClock.SetTime(fakeClock.Now().Add(simulatedPhaseDuration))
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseSucceeded {
+		t.Errorf("Expected Succeeded after %v, got %s", 2*simulatedPhaseDuration, updated.Status.Phase)
+	}
+
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected no jobs to be created in simulate mode, got %d", len(jobs))
+	}
+}
@@ -0,0 +1,28 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// validateSpec checks the spec fields the controller parses while building the workload's
+// Job, returning an error naming the first invalid field. The validating webhook rejects
+// the same values on admission; this catches workloads that bypassed it.
+func validateSpec(gw *gpuv1alpha1.GPUWorkload) error {
+	return gw.Spec.ValidateQuantities()
+}
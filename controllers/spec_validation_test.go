@@ -0,0 +1,60 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"strings"
+	"testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_FailsMalformedQuantities(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(spec *gpuv1alpha1.GPUWorkloadSpec)
+		wantMsg string
+	}{
+		{"word", func(spec *gpuv1alpha1.GPUWorkloadSpec) { spec.CPURequest = "four" }, `spec.cpuRequest "four" is not a valid quantity`},
+		{"unknown suffix", func(spec *gpuv1alpha1.GPUWorkloadSpec) { spec.MemoryRequest = "64GB" }, `spec.memoryRequest "64GB" is not a valid quantity`},
+		{"negative", func(spec *gpuv1alpha1.GPUWorkloadSpec) { spec.CPULimit = "-2" }, `spec.cpuLimit "-2" must not be negative`},
+		{"whitespace", func(spec *gpuv1alpha1.GPUWorkloadSpec) { spec.MemoryLimit = "16 Gi" }, `spec.memoryLimit "16 Gi" is not a valid quantity`},
+		{"request above limit", func(spec *gpuv1alpha1.GPUWorkloadSpec) {
+			spec.CPURequest, spec.CPULimit = "8", "4"
+		}, "spec.cpuRequest 8 must not exceed spec.cpuLimit 4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := createTestWorkload("malformed", 1)
+			tt.mutate(&gw.Spec)
+			node := createGPUNode("node1", 4)
+			r := newTestReconciler(t, gw, &node)
+
+			_, updated := reconcileWorkload(t, r, gw)
+			if updated.Status.Phase != gpuv1alpha1.PhaseFailed || updated.Status.Reason != "invalid_spec" {
+				t.Fatalf("Expected Failed with reason invalid_spec, got %s/%s", updated.Status.Phase, updated.Status.Reason)
+			}
+			if !strings.Contains(updated.Status.Message, tt.wantMsg) {
+				t.Errorf("Expected message to contain %q, got %q", tt.wantMsg, updated.Status.Message)
+			}
+			if jobs := listJobs(t, r); len(jobs) != 0 {
+				t.Errorf("Expected no jobs, got %d", len(jobs))
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// modelPlacements returns how many replicas of the workload's model each local node runs.
+func (r *GPUWorkloadReconciler) modelPlacements(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) (scheduling.ModelPlacements, error) {
+	workloads := &gpuv1alpha1.GPUWorkloadList{}
+	if err := r.List(ctx, workloads); err != nil {
+		return nil, err
+	}
+	return placementsOfModel(workloads.Items, gw), nil
+}
+
+// placementsOfModel counts the replicas of the Scheduled and Running workloads sharing gw's
+// model by node, leaving out gw itself, workloads on remote clusters and CPU fallbacks.
+func placementsOfModel(workloads []gpuv1alpha1.GPUWorkload, gw *gpuv1alpha1.GPUWorkload) scheduling.ModelPlacements {
+	placements := scheduling.ModelPlacements{}
+	for i := range workloads {
+		other := &workloads[i]
+		if other.UID == gw.UID || other.Spec.ModelName != gw.Spec.ModelName {
+			continue
+		}
+		if other.Status.Phase != gpuv1alpha1.PhaseScheduled && other.Status.Phase != gpuv1alpha1.PhaseRunning {
+			continue
+		}
+		if other.Status.Cluster != "" || other.Status.CPUFallback {
+			continue
+		}
+		for _, node := range placedNodes(other) {
+			placements[node]++
+		}
+	}
+	return placements
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_SpreadPlacesModelOnAnotherNode(t *testing.T) {
+	hosting := createGPUNode("hosting", 8)
+	other := createGPUNode("other", 4)
+	running, job, pod := createPlacedWorkload("replica-a", "hosting", 1)
+	// A workload of another model does not keep the spread strategy off its node
+	unrelated, unrelatedJob, unrelatedPod := createPlacedWorkload("unrelated", "other", 1)
+	unrelated.Spec.ModelName = "other-model"
+
+	gw := createTestWorkload("replica-b", 1)
+	gw.Spec.SchedulingStrategy = "spread"
+	r := newTestReconciler(t, gw, &hosting, &other, running, job, pod, unrelated, unrelatedJob, unrelatedPod)
+
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s: %s", updated.Status.Phase, updated.Status.Message)
+	}
+	if updated.Status.AssignedNode != "other" {
+		t.Errorf("Expected the node not running test-model, got %s", updated.Status.AssignedNode)
+	}
+}
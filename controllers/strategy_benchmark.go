@@ -0,0 +1,94 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/metrics"
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+// benchmarkWorkload is the synthetic single-GPU workload strategies are timed against.
+var benchmarkWorkload = &gpuv1alpha1.GPUWorkload{
+	ObjectMeta: metav1.ObjectMeta{Name: "strategy-benchmark"},
+	Spec:       gpuv1alpha1.GPUWorkloadSpec{ModelName: "strategy-benchmark", GPUCount: 1},
+}
+
+// strategyBenchmark periodically times every scheduling strategy over the current GPU
+// nodes, so operators can see when a strategy slows down as the cluster grows.
+// It implements manager.Runnable.
+type strategyBenchmark struct {
+	client  client.Client
+	log     logr.Logger
+	period  time.Duration
+	filter  func([]corev1.Node) []corev1.Node
+	options scheduling.Options
+}
+
+// Start runs the benchmark loop until the context is cancelled.
+func (b *strategyBenchmark) Start(ctx context.Context) error {
+	ticker := time.NewTicker(b.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := b.runOnce(ctx); err != nil {
+			b.log.Error(err, "unable to benchmark scheduling strategies")
+		}
+	}
+}
+
+// runOnce times a single ChooseNode call of each strategy and records the results.
+// Strategies log nothing and record no placements while being benchmarked.
+func (b *strategyBenchmark) runOnce(ctx context.Context) error {
+	nodes := &corev1.NodeList{}
+	if err := b.client.List(ctx, nodes); err != nil {
+		return err
+	}
+	gpuNodes := b.filter(nodes.Items)
+
+	m := metrics.GetMetrics()
+	for _, name := range scheduling.StrategyNames {
+		strategy, err := scheduling.Factory(name, logr.Discard(), b.options)
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		// Selection errors are expected, e.g. no MIG slices; only latency matters here
+		_, _ = strategy.ChooseNode(ctx, gpuNodes, nil, benchmarkWorkload)
+		elapsed := time.Since(start)
+
+		b.log.V(1).Info("Benchmarked scheduling strategy", "strategy", name, "nodes", len(gpuNodes), "duration", elapsed)
+		if m != nil {
+			m.RecordStrategyBenchmark(name, elapsed.Seconds())
+		}
+	}
+	return nil
+}
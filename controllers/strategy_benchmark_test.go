@@ -0,0 +1,63 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/reyisjones/GPU_Orchestrator/internal/scheduling"
+)
+
+func TestStrategyBenchmark_PopulatesMetric(t *testing.T) {
+	node1 := createGPUNode("node1", 4)
+	node2 := createGPUNode("node2", 8)
+	r := newTestReconciler(t, &node1, &node2)
+
+	b := &strategyBenchmark{client: r.Client, log: logr.Discard(), filter: r.filterGPUNodes}
+	if err := b.runOnce(context.Background()); err != nil {
+		t.Fatalf("runOnce() error = %v", err)
+	}
+
+	families, err := ctrlmetrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("unable to gather metrics: %v", err)
+	}
+
+	benchmarked := map[string]bool{}
+	for _, family := range families {
+		if family.GetName() != "warp_strategy_benchmark_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "strategy" {
+					benchmarked[label.GetValue()] = true
+				}
+			}
+		}
+	}
+
+	for _, name := range scheduling.StrategyNames {
+		if !benchmarked[name] {
+			t.Errorf("Expected warp_strategy_benchmark_seconds for strategy %s, got %v", name, benchmarked)
+		}
+	}
+}
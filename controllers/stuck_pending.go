@@ -0,0 +1,75 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// stuckPendingReason is the reason of the StuckPending condition and of its warning events.
+const stuckPendingReason = "PendingTooLong"
+
+// setStuckPendingCondition sets the StuckPending condition of a workload that has been pending
+// without a placement for longer than StuckPendingThreshold, counted from its creation like
+// its scheduling deadline. The condition message states the time pending in multiples of the
+// threshold, and a warning is due each time it changes, so a stuck workload is warned about
+// once per threshold rather than on every attempt. It returns the warning due, if any.
+func (r *GPUWorkloadReconciler) setStuckPendingCondition(gw *gpuv1alpha1.GPUWorkload) string {
+	if r.StuckPendingThreshold <= 0 {
+		meta.RemoveStatusCondition(&gw.Status.Conditions, gpuv1alpha1.ConditionStuckPending)
+		return ""
+	}
+
+	pendingFor := r.now().Sub(gw.CreationTimestamp.Time)
+	pending := gw.Status.Phase == "" || gw.Status.Phase == gpuv1alpha1.PhasePending
+	if !pending || gw.Status.AssignedNode != "" || pendingFor < r.StuckPendingThreshold {
+		reason := string(gw.Status.Phase)
+		if reason == "" {
+			reason = string(gpuv1alpha1.PhasePending)
+		}
+		meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+			Type:               gpuv1alpha1.ConditionStuckPending,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: gw.Generation,
+			Reason:             reason,
+		})
+		return ""
+	}
+
+	message := fmt.Sprintf("pending for over %s without a placement", pendingFor.Truncate(r.StuckPendingThreshold))
+	previous := meta.FindStatusCondition(gw.Status.Conditions, gpuv1alpha1.ConditionStuckPending)
+	due := previous == nil || previous.Status != metav1.ConditionTrue || previous.Message != message
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               gpuv1alpha1.ConditionStuckPending,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: gw.Generation,
+		Reason:             stuckPendingReason,
+		Message:            message,
+	})
+	if !due {
+		return ""
+	}
+	if gw.Status.Message != "" {
+		return fmt.Sprintf("%s: %s", message, gw.Status.Message)
+	}
+	return message
+}
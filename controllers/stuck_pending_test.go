@@ -0,0 +1,102 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_FlagsWorkloadsStuckPending(t *testing.T) {
+	created := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	gw := createTestWorkload("stuck", 2)
+	gw.CreationTimestamp = metav1.NewTime(created)
+	gw.Spec.RetryPolicy = &gpuv1alpha1.RetryPolicy{MaxRetries: 100, BackoffSeconds: 60}
+
+	recorder := &capturingRecorder{}
+	r := newTestReconciler(t, gw)
+	r.Recorder = recorder
+	r.StuckPendingThreshold = 10 * time.Minute
+	fakeClock := clocktesting.NewFakePassiveClock(created.Add(5 * time.Minute))
+	r.Clock = fakeClock
+
+	// With no GPU node the workload stays pending, but not yet for long enough
+	_, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending {
+		t.Fatalf("Expected Pending, got %s", updated.Status.Phase)
+	}
+	if meta.IsStatusConditionTrue(updated.Status.Conditions, gpuv1alpha1.ConditionStuckPending) {
+		t.Error("Expected StuckPending to be false before the threshold")
+	}
+	if recorder.find("PendingTooLong") != nil {
+		t.Errorf("Expected no warning before the threshold, got %+v", recorder.events)
+	}
+
+	fakeClock.SetTime(created.Add(12 * time.Minute))
+	_, updated = reconcileWorkload(t, r, updated)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, gpuv1alpha1.ConditionStuckPending)
+	if condition == nil || condition.Status != metav1.ConditionTrue || condition.Reason != "PendingTooLong" {
+		t.Fatalf("Expected StuckPending to be true past the threshold, got %+v", condition)
+	}
+	if condition.Message != "pending for over 10m0s without a placement" {
+		t.Errorf("Unexpected condition message %q", condition.Message)
+	}
+	if event := recorder.find("PendingTooLong"); event == nil || event.eventType != "Warning" {
+		t.Fatalf("Expected a PendingTooLong warning, got %+v", recorder.events)
+	}
+
+	// The warning is repeated once per threshold, not on every attempt
+	warnings := func() int {
+		count := 0
+		for _, event := range recorder.events {
+			if event.reason == "PendingTooLong" {
+				count++
+			}
+		}
+		return count
+	}
+	fakeClock.SetTime(created.Add(15 * time.Minute))
+	_, updated = reconcileWorkload(t, r, updated)
+	if count := warnings(); count != 1 {
+		t.Errorf("Expected 1 warning within the same threshold period, got %d", count)
+	}
+	fakeClock.SetTime(created.Add(21 * time.Minute))
+	_, updated = reconcileWorkload(t, r, updated)
+	if count := warnings(); count != 2 {
+		t.Errorf("Expected a second warning after another threshold, got %d", count)
+	}
+
+	// The condition clears once the workload is placed
+	node := createGPUNode("node1", 4)
+	if err := r.Create(context.Background(), &node); err != nil {
+		t.Fatalf("Failed to create node: %v", err)
+	}
+	_, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled, got %s", updated.Status.Phase)
+	}
+	if meta.IsStatusConditionTrue(updated.Status.Conditions, gpuv1alpha1.ConditionStuckPending) {
+		t.Error("Expected StuckPending to clear once the workload is placed")
+	}
+}
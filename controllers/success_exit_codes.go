@@ -0,0 +1,91 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// workloadContainerName is the name of the container running the workload in its Job.
+const workloadContainerName = "gpu-workload"
+
+// workloadOutcome decides whether a finished Job met the workload's success criteria. Without
+// SuccessExitCodes the Job's own outcome stands; with them, the exit code of the workload
+// container decides, whatever the Job's condition.
+func (r *GPUWorkloadReconciler) workloadOutcome(ctx context.Context, c client.Reader, gw *gpuv1alpha1.GPUWorkload, job *batchv1.Job, jobSucceeded bool) (bool, string, error) {
+	if len(gw.Spec.SuccessExitCodes) == 0 {
+		if !jobSucceeded {
+			return false, fmt.Sprintf("Job %s failed", job.Name), nil
+		}
+		return true, fmt.Sprintf("Job %s completed", job.Name), nil
+	}
+
+	exitCode, found, err := r.workloadExitCode(ctx, c, job)
+	if err != nil {
+		return false, "", err
+	}
+	switch {
+	case !found:
+		return false, fmt.Sprintf("Job %s finished but no container exit code was found", job.Name), nil
+	case containsExitCode(gw.Spec.SuccessExitCodes, exitCode):
+		return true, fmt.Sprintf("Job %s finished with accepted exit code %d", job.Name, exitCode), nil
+	default:
+		return false, fmt.Sprintf("Job %s finished with exit code %d, expected one of %v", job.Name, exitCode, gw.Spec.SuccessExitCodes), nil
+	}
+}
+
+// workloadExitCode returns the exit code of the workload container in the Job's pods.
+// The most recently terminated container wins when the Job ran several pods.
+func (r *GPUWorkloadReconciler) workloadExitCode(ctx context.Context, c client.Reader, job *batchv1.Job) (int32, bool, error) {
+	pods, err := r.workloadPods(ctx, c, job)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var latest *corev1.ContainerStateTerminated
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != workloadContainerName || status.State.Terminated == nil {
+				continue
+			}
+			terminated := status.State.Terminated
+			if latest == nil || terminated.FinishedAt.After(latest.FinishedAt.Time) {
+				latest = terminated
+			}
+		}
+	}
+	if latest == nil {
+		return 0, false, nil
+	}
+	return latest.ExitCode, true, nil
+}
+
+func containsExitCode(codes []int32, code int32) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_SuccessExitCodes(t *testing.T) {
+	tests := []struct {
+		name             string
+		successExitCodes []int32
+		condition        batchv1.JobConditionType
+		exitCode         int32
+		expectedPhase    gpuv1alpha1.GPUWorkloadPhase
+	}{
+		{"completed without criteria", nil, batchv1.JobComplete, 0, gpuv1alpha1.PhaseSucceeded},
+		{"failed without criteria", nil, batchv1.JobFailed, 1, gpuv1alpha1.PhaseFailed},
+		{"accepted exit code", []int32{0, 3}, batchv1.JobComplete, 3, gpuv1alpha1.PhaseSucceeded},
+		{"accepted exit code on failed job", []int32{42}, batchv1.JobFailed, 42, gpuv1alpha1.PhaseSucceeded},
+		{"rejected exit code", []int32{3}, batchv1.JobComplete, 0, gpuv1alpha1.PhaseFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := createTestWorkload("exit-codes", 1)
+			gw.Spec.SuccessExitCodes = tt.successExitCodes
+			job, pod := createFinishedJob(gw, tt.condition, tt.exitCode)
+			gw.Status.Phase = gpuv1alpha1.PhaseScheduled
+			gw.Status.JobName = job.Name
+
+			r := newTestReconciler(t, gw, job, pod)
+			_, updated := reconcileWorkload(t, r, gw)
+
+			if updated.Status.Phase != tt.expectedPhase {
+				t.Errorf("Expected phase %s, got %s: %s", tt.expectedPhase, updated.Status.Phase, updated.Status.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	"github.com/reyisjones/GPU_Orchestrator/internal/timewindow"
+)
+
+// workloadSchedule parses the workload's time windows. It returns nil when the workload has none.
+func workloadSchedule(gw *gpuv1alpha1.GPUWorkload) (timewindow.Schedule, error) {
+	if gw.Spec.Schedule == nil {
+		return nil, nil
+	}
+	return timewindow.Parse(gw.Spec.Schedule.Windows)
+}
+
+// followPlacedWorkload syncs a Scheduled or Running workload with its Job, suspending it
+// when its schedule asks for that and the window has closed.
+func (r *GPUWorkloadReconciler) followPlacedWorkload(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload) (ctrl.Result, error) {
+	if r.Simulate {
+		return r.advanceSimulation(ctx, log, gw)
+	}
+	if gw.Spec.Schedule == nil || !gw.Spec.Schedule.SuspendOutsideWindow {
+		return r.syncJobStatus(ctx, log, gw)
+	}
+
+	schedule, err := workloadSchedule(gw)
+	if err != nil {
+		// The spec was valid when the workload was placed; keep following its Job
+		log.Error(err, "invalid workload schedule")
+		return r.syncJobStatus(ctx, log, gw)
+	}
+
+	now := r.now()
+	if !schedule.Contains(now) {
+		return r.suspendOutsideSchedule(ctx, log, gw, schedule.UntilOpen(now))
+	}
+
+	result, err := r.syncJobStatus(ctx, log, gw)
+	if err != nil || (gw.Status.Phase != gpuv1alpha1.PhaseScheduled && gw.Status.Phase != gpuv1alpha1.PhaseRunning) {
+		return result, err
+	}
+	// Come back when the window closes so the workload is suspended promptly
+	if remaining := schedule.Remaining(now); result.RequeueAfter == 0 || remaining < result.RequeueAfter {
+		result.RequeueAfter = remaining
+	}
+	return result, nil
+}
+
+// suspendOutsideSchedule deletes the workload's Job and returns it to Pending until its
+// next window opens.
+func (r *GPUWorkloadReconciler) suspendOutsideSchedule(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, untilOpen time.Duration) (ctrl.Result, error) {
+	message := fmt.Sprintf("Suspended outside the workload schedule %q", gw.Spec.Schedule.Windows)
+	if err := r.releasePlacement(ctx, log, gw, "outside_schedule", message); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Suspended GPUWorkload outside its schedule", "reopensIn", untilOpen)
+	r.Recorder.Event(gw, corev1.EventTypeNormal, "Suspended", gw.Status.Message)
+	return ctrl.Result{RequeueAfter: untilOpen}, nil
+}
+
+// releasePlacement deletes a placed workload's Job, letting its pods terminate gracefully,
+// and returns the workload to Pending with the given reason so it is scheduled again.
+func (r *GPUWorkloadReconciler) releasePlacement(ctx context.Context, log logr.Logger, gw *gpuv1alpha1.GPUWorkload, reason, message string) error {
+	if jobs := placedJobs(gw); len(jobs) > 0 {
+		if err := r.deleteJobs(ctx, gw, gw.Status.Cluster, jobs); err != nil {
+			log.Error(err, "unable to delete the workload's jobs", "reason", reason)
+			return err
+		}
+	}
+
+	gw.Status.Phase = gpuv1alpha1.PhasePending
+	gw.Status.Reason = reason
+	gw.Status.Message = message
+	gw.Status.JobName = ""
+	gw.Status.AssignedNode = ""
+	gw.Status.ReplicaNodes = nil
+	gw.Status.Cluster = ""
+	gw.Status.AllocatedGPUCount = 0
+	gw.Status.AllocatedMemory = ""
+	gw.Status.CPUFallback = false
+	gw.Status.AssignedNodeGPUInfo = nil
+	gw.Status.NodeLabels = nil
+	gw.Status.ImagePullFailingSince = nil
+	if err := r.updateStatus(ctx, gw); err != nil {
+		log.Error(err, "unable to update GPUWorkload status")
+		return err
+	}
+	return nil
+}
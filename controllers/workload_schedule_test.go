@@ -0,0 +1,96 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func TestReconcile_WorkloadSchedule(t *testing.T) {
+	// 2025-01-03 is a Friday
+	tests := []struct {
+		name           string
+		now            time.Time
+		expectedPhase  gpuv1alpha1.GPUWorkloadPhase
+		expectedReason string
+		expectedWait   time.Duration
+	}{
+		{"inside window", time.Date(2025, time.January, 3, 23, 0, 0, 0, time.UTC), gpuv1alpha1.PhaseScheduled, "", 0},
+		{"outside window", time.Date(2025, time.January, 3, 12, 0, 0, 0, time.UTC), gpuv1alpha1.PhasePending, "outside_schedule", 10 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := createTestWorkload("off-peak", 1)
+			gw.Spec.Schedule = &gpuv1alpha1.WorkloadSchedule{Windows: "22:00-06:00"}
+			node := createGPUNode("node1", 4)
+
+			r := newTestReconciler(t, gw, &node)
+			r.Clock = clocktesting.NewFakePassiveClock(tt.now)
+
+			result, updated := reconcileWorkload(t, r, gw)
+			if updated.Status.Phase != tt.expectedPhase || updated.Status.Reason != tt.expectedReason {
+				t.Errorf("Expected %q/%q, got %q/%q", tt.expectedPhase, tt.expectedReason, updated.Status.Phase, updated.Status.Reason)
+			}
+			if tt.expectedWait > 0 && result.RequeueAfter != tt.expectedWait {
+				t.Errorf("Expected requeue when the window opens (%v), got %v", tt.expectedWait, result.RequeueAfter)
+			}
+		})
+	}
+}
+
+func TestReconcile_WorkloadScheduleSuspendsOutsideWindow(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Date(2025, time.January, 3, 23, 0, 0, 0, time.UTC))
+
+	gw := createTestWorkload("suspend", 1)
+	gw.Spec.Schedule = &gpuv1alpha1.WorkloadSchedule{Windows: "22:00-06:00", SuspendOutsideWindow: true}
+	node := createGPUNode("node1", 4)
+
+	r := newTestReconciler(t, gw, &node)
+	r.Clock = fakeClock
+
+	result, updated := reconcileWorkload(t, r, gw)
+	if updated.Status.Phase != gpuv1alpha1.PhaseScheduled {
+		t.Fatalf("Expected Scheduled inside the window, got %q", updated.Status.Phase)
+	}
+
+	// A placed workload is revisited when its window closes
+	result, updated = reconcileWorkload(t, r, updated)
+	if result.RequeueAfter != 7*time.Hour {
+		t.Errorf("Expected requeue at window close (7h), got %v", result.RequeueAfter)
+	}
+
+	fakeClock.SetTime(time.Date(2025, time.January, 4, 7, 0, 0, 0, time.UTC))
+	result, updated = reconcileWorkload(t, r, updated)
+	if updated.Status.Phase != gpuv1alpha1.PhasePending || updated.Status.Reason != "outside_schedule" {
+		t.Errorf("Expected Pending with reason outside_schedule, got %q/%q", updated.Status.Phase, updated.Status.Reason)
+	}
+	if updated.Status.JobName != "" || updated.Status.AssignedNode != "" {
+		t.Errorf("Expected the placement to be cleared, got job %q on %q", updated.Status.JobName, updated.Status.AssignedNode)
+	}
+	if jobs := listJobs(t, r); len(jobs) != 0 {
+		t.Errorf("Expected the job to be deleted, got %d", len(jobs))
+	}
+	if result.RequeueAfter != 15*time.Hour {
+		t.Errorf("Expected requeue when the window reopens (15h), got %v", result.RequeueAfter)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approval gates GPU workload scheduling on an external approval service.
+package approval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+// Approver decides whether a workload may consume GPUs.
+type Approver interface {
+	// Approve returns whether the workload is approved and a human-readable message
+	// explaining the decision. An error means no decision could be obtained.
+	Approve(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) (bool, string, error)
+}
+
+// Request is the payload sent to the approval service.
+type Request struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	ModelName string `json:"modelName"`
+	GPUCount  int32  `json:"gpuCount"`
+	Priority  string `json:"priority,omitempty"`
+}
+
+// Response is the optional JSON body returned by the approval service.
+type Response struct {
+	Approved *bool  `json:"approved,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// HTTPApprover asks an HTTP approval service for a decision. A 200 response approves
+// the workload unless its body explicitly sets "approved" to false; any other status
+// denies it.
+type HTTPApprover struct {
+	Endpoint   string
+	HTTPClient *http.Client
+}
+
+var _ Approver = &HTTPApprover{}
+
+// NewHTTPApprover creates an HTTPApprover for the given endpoint.
+func NewHTTPApprover(endpoint string) *HTTPApprover {
+	return &HTTPApprover{
+		Endpoint:   endpoint,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Approve posts the workload details to the approval service and interprets its response.
+func (a *HTTPApprover) Approve(ctx context.Context, gw *gpuv1alpha1.GPUWorkload) (bool, string, error) {
+	body, err := json.Marshal(Request{
+		Namespace: gw.Namespace,
+		Name:      gw.Name,
+		ModelName: gw.Spec.ModelName,
+		GPUCount:  gw.RequestedGPUCount(),
+		Priority:  gw.Spec.Priority,
+	})
+	if err != nil {
+		return false, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.HTTPClient.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("approval request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var decision Response
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return false, "", fmt.Errorf("reading approval response: %w", err)
+	}
+	if len(bytes.TrimSpace(data)) > 0 {
+		// Tolerate non-JSON bodies; the status code alone carries the decision
+		_ = json.Unmarshal(data, &decision)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if decision.Message == "" {
+			decision.Message = fmt.Sprintf("approval service returned %s", resp.Status)
+		}
+		return false, decision.Message, nil
+	}
+	if decision.Approved != nil && !*decision.Approved {
+		if decision.Message == "" {
+			decision.Message = "approval service denied the workload"
+		}
+		return false, decision.Message, nil
+	}
+	return true, decision.Message, nil
+}
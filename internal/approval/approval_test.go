@@ -0,0 +1,92 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+)
+
+func createWorkload() *gpuv1alpha1.GPUWorkload {
+	return &gpuv1alpha1.GPUWorkload{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "research"},
+		Spec: gpuv1alpha1.GPUWorkloadSpec{
+			ModelName: "llama2",
+			GPUCount:  4,
+			Priority:  "high",
+		},
+	}
+}
+
+func TestHTTPApprover(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		body     string
+		approved bool
+	}{
+		{"200 without body approves", http.StatusOK, "", true},
+		{"200 with approved body approves", http.StatusOK, `{"approved": true}`, true},
+		{"200 with denied body denies", http.StatusOK, `{"approved": false, "message": "over budget"}`, false},
+		{"403 denies", http.StatusForbidden, `{"message": "not approved"}`, false},
+		{"500 denies", http.StatusInternalServerError, "boom", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received Request
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+					t.Errorf("unable to decode approval request: %v", err)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			approved, message, err := NewHTTPApprover(server.URL).Approve(context.Background(), createWorkload())
+			if err != nil {
+				t.Fatalf("Approve() error = %v", err)
+			}
+			if approved != tt.approved {
+				t.Errorf("Approve() = %v (%q), want %v", approved, message, tt.approved)
+			}
+			if !approved && message == "" {
+				t.Error("Expected a message explaining the denial")
+			}
+			if received.Namespace != "research" || received.GPUCount != 4 || received.ModelName != "llama2" {
+				t.Errorf("Unexpected approval request payload: %+v", received)
+			}
+		})
+	}
+}
+
+func TestHTTPApprover_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	if _, _, err := NewHTTPApprover(server.URL).Approve(context.Background(), createWorkload()); err == nil {
+		t.Error("Expected error when the approval service is unreachable")
+	}
+}
@@ -24,6 +24,9 @@ import (
 	"time"
 )
 
+// MaxBackoff is the longest backoff either algorithm returns.
+const MaxBackoff = 5 * time.Minute
+
 // NextBackoff calculates the next backoff duration using exponential backoff with jitter.
 //
 // The formula is:
@@ -39,7 +42,8 @@ import (
 //   - attempt: the retry attempt number (0-indexed)
 //
 // Returns:
-//   - the calculated backoff duration, capped at a reasonable maximum (5 minutes)
+//   - the calculated backoff duration, capped at MaxBackoff. Once capped, the jitter is
+//     subtracted instead so capped retries stay spread out.
 //
 // Example:
 //
@@ -56,18 +60,50 @@ func NextBackoff(base time.Duration, attempt int) time.Duration {
 	// Calculate exponential backoff: base * 2^attempt
 	exponentialDuration := float64(base) * math.Pow(2, float64(attempt))
 
-	// Cap at 5 minutes to prevent extremely long wait times
-	maxDuration := 5 * time.Minute
-	if time.Duration(exponentialDuration) > maxDuration {
-		exponentialDuration = float64(maxDuration)
+	// Cap at MaxBackoff to prevent extremely long wait times
+	capped := false
+	if time.Duration(exponentialDuration) >= MaxBackoff {
+		exponentialDuration = float64(MaxBackoff)
+		capped = true
 	}
 
 	// Add jitter: 0-10% of the exponential duration
 	jitter := time.Duration(rand.Float64() * exponentialDuration * 0.1)
 
+	if capped {
+		return MaxBackoff - jitter
+	}
 	return time.Duration(exponentialDuration) + jitter
 }
 
+// NextBackoffDecorrelated calculates the next backoff duration using decorrelated jitter:
+//
+//	backoff = min(MaxBackoff, random_between(base, prev * 3))
+//
+// Each delay is drawn from a range that grows with the previous one rather than with the
+// attempt number, so workloads that failed together drift apart instead of retrying in
+// lockstep. A prev shorter than base, such as zero on the first attempt, counts as base.
+func NextBackoffDecorrelated(base, prev time.Duration) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	if base > MaxBackoff {
+		return MaxBackoff
+	}
+	if prev < base {
+		prev = base
+	}
+
+	upper := prev * 3
+	if upper > MaxBackoff || upper < prev {
+		upper = MaxBackoff
+	}
+	if upper <= base {
+		return base
+	}
+	return base + time.Duration(rand.Int63n(int64(upper-base)+1))
+}
+
 // CalculateNextRetryTime calculates when to retry based on the last attempt time.
 // It returns the time to wait before the next retry.
 func CalculateNextRetryTime(baseDuration time.Duration, attempt int) time.Duration {
@@ -135,3 +135,46 @@ func BenchmarkNextBackoff(b *testing.B) {
 		NextBackoff(base, 3)
 	}
 }
+
+func TestNextBackoffDecorrelated_StaysWithinBounds(t *testing.T) {
+	base := 10 * time.Second
+
+	tests := []struct {
+		name   string
+		prev   time.Duration
+		minDur time.Duration
+		maxDur time.Duration
+	}{
+		{"first attempt uses base as previous", 0, base, 3 * base},
+		{"grows up to three times the previous", 40 * time.Second, base, 120 * time.Second},
+		{"capped at the maximum", 4 * time.Minute, base, MaxBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 1000; i++ {
+				result := NextBackoffDecorrelated(base, tt.prev)
+				if result < tt.minDur || result > tt.maxDur {
+					t.Fatalf("NextBackoffDecorrelated(%v, %v) = %v, want between %v and %v", base, tt.prev, result, tt.minDur, tt.maxDur)
+				}
+			}
+		})
+	}
+}
+
+func TestNextBackoffDecorrelated_IsDecorrelated(t *testing.T) {
+	// Workloads failing together from the same previous backoff retry at different times
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 20; i++ {
+		seen[NextBackoffDecorrelated(30*time.Second, 30*time.Second)] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("Expected varied backoffs, got %v", seen)
+	}
+}
+
+func TestNextBackoffDecorrelated_ZeroBase(t *testing.T) {
+	if result := NextBackoffDecorrelated(0, time.Minute); result != 0 {
+		t.Errorf("NextBackoffDecorrelated with zero base should return 0, got %v", result)
+	}
+}
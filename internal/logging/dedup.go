@@ -0,0 +1,162 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logging provides logger wrappers that keep controller logs readable under high churn.
+package logging
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+)
+
+// NewDedupLogger wraps a logger so that identical Info messages logged within window are
+// coalesced: the first one is written, repeats are suppressed, and the next message written
+// after the window carries a "suppressed" count. Messages are identical when they share the
+// logger name, verbosity, message text and key/value pairs, including those added through
+// WithValues, so the same message about different workloads is never dropped. Errors are
+// never suppressed. A non-positive window returns the logger unchanged.
+func NewDedupLogger(logger logr.Logger, window time.Duration, clk clock.PassiveClock) logr.Logger {
+	sink := logger.GetSink()
+	if window <= 0 || sink == nil {
+		return logger
+	}
+	if clk == nil {
+		clk = clock.RealClock{}
+	}
+	return logr.New(&dedupSink{
+		sink: sink,
+		state: &dedupState{
+			window:  window,
+			clock:   clk,
+			entries: map[dedupKey]*dedupEntry{},
+		},
+	})
+}
+
+// dedupKey identifies a repeated message.
+type dedupKey struct {
+	name   string
+	level  int
+	msg    string
+	values string
+}
+
+type dedupEntry struct {
+	first      time.Time
+	suppressed int
+}
+
+// dedupState is shared by every logger derived from the same dedup logger.
+type dedupState struct {
+	window  time.Duration
+	clock   clock.PassiveClock
+	mu      sync.Mutex
+	entries map[dedupKey]*dedupEntry
+}
+
+// allow reports whether the message should be written and how many repeats were
+// suppressed since it was last written.
+func (s *dedupState) allow(key dedupKey) (bool, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	entry, ok := s.entries[key]
+	if ok && now.Sub(entry.first) < s.window {
+		entry.suppressed++
+		return false, 0
+	}
+
+	suppressed := 0
+	if ok {
+		suppressed = entry.suppressed
+	}
+	// Forget stale messages so the map does not grow without bound
+	for k, e := range s.entries {
+		if now.Sub(e.first) >= s.window && e.suppressed == 0 {
+			delete(s.entries, k)
+		}
+	}
+	s.entries[key] = &dedupEntry{first: now}
+	return true, suppressed
+}
+
+// dedupSink is a logr.LogSink that drops repeated Info messages.
+type dedupSink struct {
+	sink  logr.LogSink
+	name  string
+	state *dedupState
+
+	// values renders the key/value pairs added through WithValues
+	values string
+}
+
+var _ logr.LogSink = &dedupSink{}
+
+// Init implements logr.LogSink.
+func (d *dedupSink) Init(info logr.RuntimeInfo) {
+	// Account for the extra frame added by this wrapper
+	info.CallDepth++
+	d.sink.Init(info)
+}
+
+// Enabled implements logr.LogSink.
+func (d *dedupSink) Enabled(level int) bool {
+	return d.sink.Enabled(level)
+}
+
+// Info implements logr.LogSink.
+func (d *dedupSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	ok, suppressed := d.state.allow(dedupKey{name: d.name, level: level, msg: msg, values: d.values + formatValues(keysAndValues)})
+	if !ok {
+		return
+	}
+	if suppressed > 0 {
+		keysAndValues = append(keysAndValues, "suppressed", suppressed)
+	}
+	d.sink.Info(level, msg, keysAndValues...)
+}
+
+// Error implements logr.LogSink.
+func (d *dedupSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	d.sink.Error(err, msg, keysAndValues...)
+}
+
+// WithValues implements logr.LogSink.
+func (d *dedupSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &dedupSink{sink: d.sink.WithValues(keysAndValues...), name: d.name, state: d.state, values: d.values + formatValues(keysAndValues)}
+}
+
+// WithName implements logr.LogSink.
+func (d *dedupSink) WithName(name string) logr.LogSink {
+	fullName := name
+	if d.name != "" {
+		fullName = d.name + "/" + name
+	}
+	return &dedupSink{sink: d.sink.WithName(name), name: fullName, state: d.state, values: d.values}
+}
+
+// formatValues renders key/value pairs for comparison in a dedupKey.
+func formatValues(keysAndValues []interface{}) string {
+	if len(keysAndValues) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%v", keysAndValues)
+}
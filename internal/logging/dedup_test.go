@@ -0,0 +1,100 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// recordingSink collects the messages written to it.
+type recordingSink struct {
+	entries *[]recordedEntry
+}
+
+type recordedEntry struct {
+	msg           string
+	keysAndValues []interface{}
+}
+
+func (s recordingSink) Init(logr.RuntimeInfo)        {}
+func (s recordingSink) Enabled(int) bool             { return true }
+func (s recordingSink) WithName(string) logr.LogSink { return s }
+func (s recordingSink) WithValues(...interface{}) logr.LogSink {
+	return s
+}
+func (s recordingSink) Info(_ int, msg string, keysAndValues ...interface{}) {
+	*s.entries = append(*s.entries, recordedEntry{msg: msg, keysAndValues: keysAndValues})
+}
+func (s recordingSink) Error(_ error, msg string, keysAndValues ...interface{}) {
+	*s.entries = append(*s.entries, recordedEntry{msg: msg, keysAndValues: keysAndValues})
+}
+
+func TestDedupLogger_SuppressesRepeatedMessages(t *testing.T) {
+	var entries []recordedEntry
+	clk := clocktesting.NewFakePassiveClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	log := NewDedupLogger(logr.New(recordingSink{entries: &entries}), time.Minute, clk)
+
+	for i := 0; i < 5; i++ {
+		log.WithValues("gpuworkload", "default/a").Info("No GPU nodes available")
+	}
+	log.WithValues("gpuworkload", "default/b").Info("No GPU nodes available")
+	log.Info("No GPU nodes available", "gpuworkload", "default/c")
+
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 messages within the window, one per workload, got %d: %+v", len(entries), entries)
+	}
+
+	// After the window the message is written again with the number of suppressed repeats
+	clk.SetTime(clk.Now().Add(time.Minute))
+	log.WithValues("gpuworkload", "default/a").Info("No GPU nodes available")
+
+	if len(entries) != 4 {
+		t.Fatalf("Expected the message to be logged again after the window, got %d", len(entries))
+	}
+	kv := entries[3].keysAndValues
+	if len(kv) != 2 || kv[0] != "suppressed" || kv[1] != 4 {
+		t.Errorf("Expected suppressed=4, got %v", kv)
+	}
+}
+
+func TestDedupLogger_NeverSuppressesErrors(t *testing.T) {
+	var entries []recordedEntry
+	clk := clocktesting.NewFakePassiveClock(time.Now())
+	log := NewDedupLogger(logr.New(recordingSink{entries: &entries}), time.Minute, clk)
+
+	for i := 0; i < 3; i++ {
+		log.Error(nil, "unable to list nodes")
+	}
+	if len(entries) != 3 {
+		t.Errorf("Expected every error to be logged, got %d", len(entries))
+	}
+}
+
+func TestDedupLogger_DisabledWindow(t *testing.T) {
+	var entries []recordedEntry
+	log := NewDedupLogger(logr.New(recordingSink{entries: &entries}), 0, nil)
+
+	log.Info("same")
+	log.Info("same")
+	if len(entries) != 2 {
+		t.Errorf("Expected no suppression with a zero window, got %d messages", len(entries))
+	}
+}
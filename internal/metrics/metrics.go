@@ -18,10 +18,58 @@ limitations under the License.
 package metrics
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+// Names of the exported metrics, shared with the generated Prometheus rules.
+const (
+	ScheduledTotalName           = "warp_gpuworkload_scheduled_total"
+	FailedTotalName              = "warp_gpuworkload_failed_total"
+	RetriesTotalName             = "warp_gpuworkload_retries_total"
+	SchedulingTimeoutsTotalName  = "warp_gpuworkload_scheduling_timeouts_total"
+	ReconcileDurationSecondsName = "warp_gpuworkload_reconcile_duration_seconds"
+	ReconcileErrorsTotalName     = "warp_reconcile_errors_total"
+	GPUsRequestedTotalName       = "warp_gpuworkload_gpus_requested_total"
+	GPURequestsTotalName         = "warp_gpuworkload_gpu_requests_total"
+	AttemptsToScheduleName       = "warp_gpuworkload_attempts_to_schedule"
+	NodeGPUAllocatableName       = "warp_node_gpu_allocatable"
+	NodeGPURequestedName         = "warp_node_gpu_requested"
+	NodeAvailableGPUsName        = "warp_node_available_gpus"
+	GPUAllocationDriftName       = "warp_gpu_allocation_drift"
+	StrategyBenchmarkSecondsName = "warp_strategy_benchmark_seconds"
+	PendingName                  = "warp_gpuworkload_pending"
+	QueueDepthName               = "warp_gpuworkload_queue_depth"
+	ActiveJobsName               = "warp_active_jobs"
+	ConfigInfoName               = "warp_config_info"
+	ConfigOvercommitRatioName    = "warp_config_gpu_overcommit_ratio"
+	ConfigCanaryPercentName      = "warp_config_canary_percent"
+)
+
+// Types of reconcile errors counted by warp_reconcile_errors_total.
+const (
+	// ReconcileErrorNodeList is a failure to list the nodes, or the pods bound to them.
+	ReconcileErrorNodeList = "node_list"
+
+	// ReconcileErrorStatusUpdate is a failure to write a workload's status.
+	ReconcileErrorStatusUpdate = "status_update"
+
+	// ReconcileErrorJobCreate is a failure to create a workload's Job.
+	ReconcileErrorJobCreate = "job_create"
+
+	// ReconcileErrorStrategy is a scheduling strategy that could not be created or failed for
+	// another reason than no node fitting the workload.
+	ReconcileErrorStrategy = "strategy"
+
+	// ReconcileErrorConflict is a write rejected because the object changed since it was read.
+	ReconcileErrorConflict = "conflict"
+)
+
 // Metrics holds all Prometheus metrics for the GPU_Orchestrator controller.
 type Metrics struct {
 	// GPUWorkloadScheduledTotal counts the number of successfully scheduled GPUWorkloads
@@ -33,62 +81,366 @@ type Metrics struct {
 	// GPUWorkloadRetriesTotal counts the total number of retry attempts
 	GPUWorkloadRetriesTotal prometheus.Counter
 
+	// GPUWorkloadSchedulingTimeoutsTotal counts the workloads failed for not being scheduled in time
+	GPUWorkloadSchedulingTimeoutsTotal prometheus.Counter
+
 	// GPUWorkloadReconcileDurationSeconds measures the duration of reconciliation
 	GPUWorkloadReconcileDurationSeconds prometheus.HistogramVec
+
+	// ReconcileErrorsTotal counts reconcile errors by the step that failed
+	ReconcileErrorsTotal prometheus.CounterVec
+
+	// GPUWorkloadGPUsRequestedTotal counts the GPUs requested by scheduled GPUWorkloads
+	GPUWorkloadGPUsRequestedTotal prometheus.CounterVec
+
+	// GPUWorkloadGPURequestsTotal counts the Jobs created per requested GPU resource name
+	GPUWorkloadGPURequestsTotal prometheus.CounterVec
+
+	// NodeGPUAllocatable reports the allocatable GPUs of each GPU node
+	NodeGPUAllocatable prometheus.GaugeVec
+
+	// NodeGPURequested reports the GPUs requested by scheduled workloads on each GPU node
+	NodeGPURequested prometheus.GaugeVec
+
+	// NodeAvailableGPUs reports the GPUs available to new workloads on each GPU node the scheduler evaluated
+	NodeAvailableGPUs prometheus.GaugeVec
+
+	// GPUAllocationDrift reports, per node, the GPUs its pods request less those the controller assumes its placed workloads hold
+	GPUAllocationDrift prometheus.GaugeVec
+
+	// StrategyBenchmarkSeconds reports the latest self-benchmark duration of each strategy
+	StrategyBenchmarkSeconds prometheus.GaugeVec
+
+	// GPUWorkloadPending reports the GPUWorkloads waiting to be scheduled in each namespace
+	GPUWorkloadPending prometheus.GaugeVec
+
+	// GPUWorkloadQueueDepth reports the workloads in the priority-ordered scheduling queue
+	GPUWorkloadQueueDepth prometheus.Gauge
+
+	// ActiveJobs reports the unfinished Jobs the controller manages
+	ActiveJobs prometheus.Gauge
+
+	// GPUWorkloadAttemptsToSchedule observes the retry count at which workloads were scheduled
+	GPUWorkloadAttemptsToSchedule prometheus.Histogram
+
+	// ConfigInfo is always 1, labeled with the effective string-valued scheduling config
+	ConfigInfo prometheus.GaugeVec
+
+	// ConfigOvercommitRatio reports the effective global GPU over-commit ratio
+	ConfigOvercommitRatio prometheus.Gauge
+
+	// ConfigCanaryPercent reports the share of workloads routed to the canary strategy
+	ConfigCanaryPercent prometheus.Gauge
 }
 
 var (
 	// Global metrics instance
 	metricsInstance *Metrics
 
-	gpuWorkloadScheduledTotal = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "warp_gpuworkload_scheduled_total",
-			Help: "Total number of GPUWorkloads successfully scheduled",
-		},
-		[]string{"strategy"},
-	)
+	// workloadLabelKeys are the GPUWorkload labels propagated onto per-workload metrics
+	workloadLabelKeys []string
+
+	gpuWorkloadScheduledTotal = newScheduledTotal(nil)
+
+	gpuWorkloadGPUsRequestedTotal = newGPUsRequestedTotal(nil)
 
 	gpuWorkloadFailedTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
-			Name: "warp_gpuworkload_failed_total",
+			Name: FailedTotalName,
 			Help: "Total number of GPUWorkload scheduling failures",
 		},
 		[]string{"reason"},
 	)
 
+	gpuWorkloadGPURequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: GPURequestsTotalName,
+			Help: "Total number of GPUWorkload Jobs created, by the GPU resource name they request",
+		},
+		[]string{"resource"},
+	)
+
 	gpuWorkloadRetriesTotal = prometheus.NewCounter(
 		prometheus.CounterOpts{
-			Name: "warp_gpuworkload_retries_total",
+			Name: RetriesTotalName,
 			Help: "Total number of GPUWorkload retry attempts",
 		},
 	)
 
+	gpuWorkloadSchedulingTimeoutsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: SchedulingTimeoutsTotalName,
+			Help: "Total number of GPUWorkloads failed because they were not scheduled before their deadline",
+		},
+	)
+
 	gpuWorkloadReconcileDurationSeconds = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
-			Name:    "warp_gpuworkload_reconcile_duration_seconds",
+			Name:    ReconcileDurationSecondsName,
 			Help:    "Duration of GPUWorkload reconciliation in seconds",
 			Buckets: prometheus.DefBuckets,
 		},
 		[]string{"result"},
 	)
+
+	reconcileErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: ReconcileErrorsTotalName,
+			Help: "Total number of GPUWorkload reconcile errors, by the type of step that failed",
+		},
+		[]string{"type"},
+	)
+
+	nodeGPUAllocatable = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: NodeGPUAllocatableName,
+			Help: "Number of allocatable GPUs on each GPU node",
+		},
+		[]string{"node"},
+	)
+
+	nodeGPURequested = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: NodeGPURequestedName,
+			Help: "Number of GPUs requested by scheduled GPUWorkloads on each GPU node",
+		},
+		[]string{"node"},
+	)
+
+	nodeAvailableGPUs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: NodeAvailableGPUsName,
+			Help: "Number of GPUs available to new GPUWorkloads on each GPU node, as last evaluated by the scheduler",
+		},
+		[]string{"node"},
+	)
+
+	gpuAllocationDrift = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: GPUAllocationDriftName,
+			Help: "GPUs requested by the pods on each GPU node less the GPUs the controller assumes its placed GPUWorkloads hold there",
+		},
+		[]string{"node"},
+	)
+
+	strategyBenchmarkSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: StrategyBenchmarkSecondsName,
+			Help: "Duration of the latest self-benchmark run of each scheduling strategy over the current GPU nodes",
+		},
+		[]string{"strategy"},
+	)
+
+	gpuWorkloadPending = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: PendingName,
+			Help: "Number of GPUWorkloads waiting to be scheduled in each namespace",
+		},
+		[]string{"namespace"},
+	)
+
+	gpuWorkloadQueueDepth = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: QueueDepthName,
+			Help: "Number of GPUWorkloads in the priority-ordered scheduling queue",
+		},
+	)
+
+	activeJobs = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: ActiveJobsName,
+			Help: "Number of unfinished Jobs the controller manages, counted when --max-active-jobs is set",
+		},
+	)
+
+	configInfo = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: ConfigInfoName,
+			Help: "Effective scheduling configuration of the controller; always 1",
+		},
+		[]string{"default_strategy", "canary_strategy", "tie_break_policy"},
+	)
+
+	configOvercommitRatio = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: ConfigOvercommitRatioName,
+			Help: "Effective global GPU over-commit ratio, after clamping",
+		},
+	)
+
+	configCanaryPercent = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: ConfigCanaryPercentName,
+			Help: "Percentage of GPUWorkloads routed to the canary scheduling strategy",
+		},
+	)
+
+	// reportedNodes tracks the nodes that currently have per-node series, and driftNodes
+	// those of them with an allocation drift series
+	reportedNodes   = map[string]bool{}
+	driftNodes      = map[string]bool{}
+	reportedNodesMu sync.Mutex
+
+	gpuWorkloadAttemptsToSchedule = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    AttemptsToScheduleName,
+			Help:    "Number of retries a GPUWorkload needed before it was scheduled",
+			Buckets: []float64{0, 1, 2, 3, 5, 8, 13},
+		},
+	)
 )
 
 func init() {
 	// Register metrics with the controller-runtime metrics registry
 	metrics.Registry.MustRegister(
-		gpuWorkloadScheduledTotal,
 		gpuWorkloadFailedTotal,
+		gpuWorkloadGPURequestsTotal,
 		gpuWorkloadRetriesTotal,
+		gpuWorkloadSchedulingTimeoutsTotal,
 		gpuWorkloadReconcileDurationSeconds,
+		reconcileErrorsTotal,
+		gpuWorkloadAttemptsToSchedule,
+		nodeGPUAllocatable,
+		nodeGPURequested,
+		nodeAvailableGPUs,
+		gpuAllocationDrift,
+		strategyBenchmarkSeconds,
+		gpuWorkloadPending,
+		gpuWorkloadQueueDepth,
+		activeJobs,
+		configInfo,
+		configOvercommitRatio,
+		configCanaryPercent,
+		workloadCollector{},
 	)
 
 	metricsInstance = &Metrics{
 		GPUWorkloadScheduledTotal:           *gpuWorkloadScheduledTotal,
 		GPUWorkloadFailedTotal:              *gpuWorkloadFailedTotal,
 		GPUWorkloadRetriesTotal:             gpuWorkloadRetriesTotal,
+		GPUWorkloadSchedulingTimeoutsTotal:  gpuWorkloadSchedulingTimeoutsTotal,
 		GPUWorkloadReconcileDurationSeconds: *gpuWorkloadReconcileDurationSeconds,
+		ReconcileErrorsTotal:                *reconcileErrorsTotal,
+		GPUWorkloadGPUsRequestedTotal:       *gpuWorkloadGPUsRequestedTotal,
+		GPUWorkloadGPURequestsTotal:         *gpuWorkloadGPURequestsTotal,
+		GPUWorkloadAttemptsToSchedule:       gpuWorkloadAttemptsToSchedule,
+		NodeGPUAllocatable:                  *nodeGPUAllocatable,
+		NodeGPURequested:                    *nodeGPURequested,
+		NodeAvailableGPUs:                   *nodeAvailableGPUs,
+		GPUAllocationDrift:                  *gpuAllocationDrift,
+		StrategyBenchmarkSeconds:            *strategyBenchmarkSeconds,
+		GPUWorkloadPending:                  *gpuWorkloadPending,
+		GPUWorkloadQueueDepth:               gpuWorkloadQueueDepth,
+		ActiveJobs:                          activeJobs,
+		ConfigInfo:                          *configInfo,
+		ConfigOvercommitRatio:               configOvercommitRatio,
+		ConfigCanaryPercent:                 configCanaryPercent,
+	}
+}
+
+func newScheduledTotal(labelKeys []string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: ScheduledTotalName,
+			Help: "Total number of GPUWorkloads successfully scheduled",
+		},
+		append([]string{"strategy"}, labelNames(labelKeys)...),
+	)
+}
+
+func newGPUsRequestedTotal(labelKeys []string) *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: GPUsRequestedTotalName,
+			Help: "Total number of GPUs requested by successfully scheduled GPUWorkloads",
+		},
+		labelNames(labelKeys),
+	)
+}
+
+// invalidLabelChars matches characters that are not allowed in Prometheus label names.
+var invalidLabelChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// labelNames converts workload label keys into valid Prometheus label names.
+func labelNames(labelKeys []string) []string {
+	names := make([]string, 0, len(labelKeys))
+	for _, key := range labelKeys {
+		name := invalidLabelChars.ReplaceAllString(key, "_")
+		if name != "" && name[0] >= '0' && name[0] <= '9' {
+			name = "_" + name
+		}
+		names = append(names, name)
 	}
+	return names
+}
+
+// workloadCollector exposes the per-workload metrics, whose label names depend on the
+// configured allowlist. It describes no metrics so the registry accepts it unchecked,
+// which allows the allowlist to be configured after init.
+type workloadCollector struct{}
+
+// Describe implements prometheus.Collector.
+func (workloadCollector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (workloadCollector) Collect(ch chan<- prometheus.Metric) {
+	gpuWorkloadScheduledTotal.Collect(ch)
+	gpuWorkloadGPUsRequestedTotal.Collect(ch)
+}
+
+// ConfigureWorkloadLabels sets the allowlist of GPUWorkload labels propagated onto
+// per-workload metrics (scheduled total and GPUs requested). Keeping the allowlist
+// small bounds metric cardinality. It resets the affected metrics and must be called
+// during startup, before any metrics are recorded. It returns an error, leaving the
+// metrics unchanged, when a key is empty or its label name is reserved by Prometheus,
+// built into the metrics or shared with another key.
+func ConfigureWorkloadLabels(labelKeys []string) error {
+	if err := validateLabelKeys(labelKeys); err != nil {
+		return err
+	}
+
+	workloadLabelKeys = labelKeys
+	gpuWorkloadScheduledTotal = newScheduledTotal(labelKeys)
+	gpuWorkloadGPUsRequestedTotal = newGPUsRequestedTotal(labelKeys)
+
+	metricsInstance.GPUWorkloadScheduledTotal = *gpuWorkloadScheduledTotal
+	metricsInstance.GPUWorkloadGPUsRequestedTotal = *gpuWorkloadGPUsRequestedTotal
+	return nil
+}
+
+// builtinWorkloadLabels are the label names the per-workload metrics set themselves.
+var builtinWorkloadLabels = map[string]bool{"strategy": true}
+
+// validateLabelKeys checks that the workload label keys map onto distinct label names the
+// per-workload metrics can carry.
+func validateLabelKeys(labelKeys []string) error {
+	keysByName := make(map[string]string, len(labelKeys))
+	for i, name := range labelNames(labelKeys) {
+		key := labelKeys[i]
+		switch {
+		case key == "":
+			return fmt.Errorf("empty workload label key")
+		case strings.HasPrefix(name, "__"):
+			return fmt.Errorf("workload label %q maps to %q, which Prometheus reserves", key, name)
+		case builtinWorkloadLabels[name]:
+			return fmt.Errorf("workload label %q maps to the built-in label %q", key, name)
+		}
+		if other, ok := keysByName[name]; ok {
+			return fmt.Errorf("workload labels %q and %q both map to %q", other, key, name)
+		}
+		keysByName[name] = key
+	}
+	return nil
+}
+
+// workloadLabelValues returns the values of the allowlisted labels, in allowlist order.
+// Missing labels are reported as empty strings.
+func workloadLabelValues(workloadLabels map[string]string) []string {
+	values := make([]string, 0, len(workloadLabelKeys))
+	for _, key := range workloadLabelKeys {
+		values = append(values, workloadLabels[key])
+	}
+	return values
 }
 
 // GetMetrics returns the global metrics instance.
@@ -96,9 +448,23 @@ func GetMetrics() *Metrics {
 	return metricsInstance
 }
 
-// RecordSchedulingSuccess increments the scheduled counter for a given strategy.
-func (m *Metrics) RecordSchedulingSuccess(strategy string) {
-	gpuWorkloadScheduledTotal.WithLabelValues(strategy).Inc()
+// RecordSchedulingSuccess increments the scheduled counter for a given strategy,
+// labelled with the workload's allowlisted labels.
+func (m *Metrics) RecordSchedulingSuccess(strategy string, workloadLabels map[string]string) {
+	labelValues := append([]string{strategy}, workloadLabelValues(workloadLabels)...)
+	gpuWorkloadScheduledTotal.WithLabelValues(labelValues...).Inc()
+}
+
+// RecordGPUsRequested adds the GPUs requested by a scheduled workload,
+// labelled with the workload's allowlisted labels.
+func (m *Metrics) RecordGPUsRequested(gpus int32, workloadLabels map[string]string) {
+	gpuWorkloadGPUsRequestedTotal.WithLabelValues(workloadLabelValues(workloadLabels)...).Add(float64(gpus))
+}
+
+// RecordGPURequest increments the requests counter for the GPU resource a created Job requests,
+// such as "nvidia.com/gpu" or "nvidia.com/mig-1g.5gb".
+func (m *Metrics) RecordGPURequest(resource string) {
+	gpuWorkloadGPURequestsTotal.WithLabelValues(resource).Inc()
 }
 
 // RecordSchedulingFailure increments the failed counter for a given reason.
@@ -111,8 +477,135 @@ func (m *Metrics) RecordRetry() {
 	gpuWorkloadRetriesTotal.Inc()
 }
 
+// RecordSchedulingTimeout increments the timeouts counter. It should be called once per
+// workload, when it is failed for exceeding its scheduling timeout or deadline.
+func (m *Metrics) RecordSchedulingTimeout() {
+	gpuWorkloadSchedulingTimeoutsTotal.Inc()
+}
+
+// RecordAttemptsToSchedule observes the retry count at which a workload was scheduled.
+// It should be called once per workload, when it is placed.
+func (m *Metrics) RecordAttemptsToSchedule(attempt int32) {
+	gpuWorkloadAttemptsToSchedule.Observe(float64(attempt))
+}
+
+// NodeGPUAllocation is the GPU accounting of a single node.
+type NodeGPUAllocation struct {
+	Allocatable int64
+	Requested   int64
+}
+
+// UpdateNodeGPUAllocation sets the per-node GPU gauges to the given allocation, keyed by
+// node name, and removes the series of nodes that are no longer reported.
+func (m *Metrics) UpdateNodeGPUAllocation(allocation map[string]NodeGPUAllocation) {
+	reportedNodesMu.Lock()
+	defer reportedNodesMu.Unlock()
+
+	for node := range reportedNodes {
+		if _, ok := allocation[node]; !ok {
+			deleteNodeSeries(node)
+		}
+	}
+	for node, a := range allocation {
+		nodeGPUAllocatable.WithLabelValues(node).Set(float64(a.Allocatable))
+		nodeGPURequested.WithLabelValues(node).Set(float64(a.Requested))
+		reportedNodes[node] = true
+	}
+}
+
+// SetNodeAvailableGPUs sets the GPUs available to new workloads on the node.
+func (m *Metrics) SetNodeAvailableGPUs(node string, gpus float64) {
+	reportedNodesMu.Lock()
+	defer reportedNodesMu.Unlock()
+
+	nodeAvailableGPUs.WithLabelValues(node).Set(gpus)
+	reportedNodes[node] = true
+}
+
+// UpdateGPUAllocationDrift sets, per node, the GPUs its pods request beyond those the
+// controller assumes its placed workloads hold there, negative when they request fewer, and
+// removes the drift series of nodes that are no longer reported.
+func (m *Metrics) UpdateGPUAllocationDrift(drift map[string]float64) {
+	reportedNodesMu.Lock()
+	defer reportedNodesMu.Unlock()
+
+	for node := range driftNodes {
+		if _, ok := drift[node]; !ok {
+			gpuAllocationDrift.DeleteLabelValues(node)
+			delete(driftNodes, node)
+		}
+	}
+	for node, gpus := range drift {
+		gpuAllocationDrift.WithLabelValues(node).Set(gpus)
+		driftNodes[node] = true
+		reportedNodes[node] = true
+	}
+}
+
+// DeleteNodeMetrics removes every per-node series of the node, such as when it leaves the cluster.
+func (m *Metrics) DeleteNodeMetrics(node string) {
+	reportedNodesMu.Lock()
+	defer reportedNodesMu.Unlock()
+
+	deleteNodeSeries(node)
+}
+
+// deleteNodeSeries removes the per-node series of the node. reportedNodesMu must be held.
+func deleteNodeSeries(node string) {
+	nodeGPUAllocatable.DeleteLabelValues(node)
+	nodeGPURequested.DeleteLabelValues(node)
+	nodeAvailableGPUs.DeleteLabelValues(node)
+	gpuAllocationDrift.DeleteLabelValues(node)
+	delete(driftNodes, node)
+	delete(reportedNodes, node)
+}
+
+// RecordStrategyBenchmark records how long a strategy took to choose a node during a self-benchmark.
+func (m *Metrics) RecordStrategyBenchmark(strategy string, seconds float64) {
+	strategyBenchmarkSeconds.WithLabelValues(strategy).Set(seconds)
+}
+
+// SetPendingWorkloads sets the number of GPUWorkloads waiting to be scheduled in the namespace.
+func (m *Metrics) SetPendingWorkloads(namespace string, count float64) {
+	gpuWorkloadPending.WithLabelValues(namespace).Set(count)
+}
+
+// SetQueueDepth sets the number of GPUWorkloads in the priority-ordered scheduling queue.
+func (m *Metrics) SetQueueDepth(depth int) {
+	gpuWorkloadQueueDepth.Set(float64(depth))
+}
+
+// SetActiveJobs sets the number of unfinished Jobs the controller manages.
+func (m *Metrics) SetActiveJobs(count int) {
+	activeJobs.Set(float64(count))
+}
+
 // RecordReconcileDuration records the duration of a reconciliation attempt.
 // result should be "success" or "error".
 func (m *Metrics) RecordReconcileDuration(duration float64, result string) {
 	gpuWorkloadReconcileDurationSeconds.WithLabelValues(result).Observe(duration)
 }
+
+// RecordReconcileError increments the reconcile errors counter for the type of step that
+// failed, one of the ReconcileError constants.
+func (m *Metrics) RecordReconcileError(errorType string) {
+	reconcileErrorsTotal.WithLabelValues(errorType).Inc()
+}
+
+// SchedulingConfig is the effective scheduling configuration exported as warp_config_* metrics.
+type SchedulingConfig struct {
+	DefaultStrategy    string
+	CanaryStrategy     string
+	CanaryPercent      int
+	TieBreakPolicy     string
+	GPUOvercommitRatio float64
+}
+
+// SetSchedulingConfig exports the effective scheduling configuration, replacing any
+// previously exported values, so dashboards can confirm what is live.
+func (m *Metrics) SetSchedulingConfig(config SchedulingConfig) {
+	configInfo.Reset()
+	configInfo.WithLabelValues(config.DefaultStrategy, config.CanaryStrategy, config.TieBreakPolicy).Set(1)
+	configOvercommitRatio.Set(config.GPUOvercommitRatio)
+	configCanaryPercent.Set(float64(config.CanaryPercent))
+}
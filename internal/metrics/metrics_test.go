@@ -0,0 +1,264 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestWorkloadLabelsArePropagated(t *testing.T) {
+	if err := ConfigureWorkloadLabels([]string{"team", "project"}); err != nil {
+		t.Fatalf("ConfigureWorkloadLabels() error = %v", err)
+	}
+	defer ConfigureWorkloadLabels(nil)
+
+	m := GetMetrics()
+	workloadLabels := map[string]string{
+		"team":    "vision",
+		"project": "detector",
+		"other":   "ignored",
+	}
+
+	m.RecordSchedulingSuccess("leastLoaded", workloadLabels)
+	m.RecordGPUsRequested(4, workloadLabels)
+
+	scheduled := testutil.ToFloat64(gpuWorkloadScheduledTotal.WithLabelValues("leastLoaded", "vision", "detector"))
+	if scheduled != 1 {
+		t.Errorf("Expected scheduled total of 1 for team/project labels, got %v", scheduled)
+	}
+
+	requested := testutil.ToFloat64(gpuWorkloadGPUsRequestedTotal.WithLabelValues("vision", "detector"))
+	if requested != 4 {
+		t.Errorf("Expected 4 GPUs requested for team/project labels, got %v", requested)
+	}
+}
+
+func TestWorkloadLabelsMissingValues(t *testing.T) {
+	if err := ConfigureWorkloadLabels([]string{"team", "app.kubernetes.io/project"}); err != nil {
+		t.Fatalf("ConfigureWorkloadLabels() error = %v", err)
+	}
+	defer ConfigureWorkloadLabels(nil)
+
+	GetMetrics().RecordSchedulingSuccess("random", map[string]string{"team": "nlp"})
+
+	scheduled := testutil.ToFloat64(gpuWorkloadScheduledTotal.WithLabelValues("random", "nlp", ""))
+	if scheduled != 1 {
+		t.Errorf("Expected unlabeled project to be recorded as empty, got %v", scheduled)
+	}
+}
+
+func TestLabelNames(t *testing.T) {
+	names := labelNames([]string{"team", "app.kubernetes.io/project", "1st"})
+	expected := []string{"team", "app_kubernetes_io_project", "_1st"}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("labelNames()[%d] = %q, want %q", i, names[i], expected[i])
+		}
+	}
+}
+
+func TestConfigureWorkloadLabels_RejectsUnusableKeys(t *testing.T) {
+	for _, keys := range [][]string{
+		{"strategy"},
+		{"team", "app.name", "app_name"},
+		{"__name__"},
+		{""},
+	} {
+		if err := ConfigureWorkloadLabels(keys); err == nil {
+			t.Errorf("ConfigureWorkloadLabels(%q) accepted unusable keys", keys)
+		}
+	}
+	if len(workloadLabelKeys) != 0 {
+		t.Errorf("Expected rejected keys to leave the allowlist unchanged, got %v", workloadLabelKeys)
+	}
+}
+
+func TestRecordAttemptsToSchedule(t *testing.T) {
+	m := GetMetrics()
+	m.RecordAttemptsToSchedule(0)
+	m.RecordAttemptsToSchedule(2)
+
+	expected := `
+# HELP warp_gpuworkload_attempts_to_schedule Number of retries a GPUWorkload needed before it was scheduled
+# TYPE warp_gpuworkload_attempts_to_schedule histogram
+warp_gpuworkload_attempts_to_schedule_bucket{le="0"} 1
+warp_gpuworkload_attempts_to_schedule_bucket{le="1"} 1
+warp_gpuworkload_attempts_to_schedule_bucket{le="2"} 2
+warp_gpuworkload_attempts_to_schedule_bucket{le="3"} 2
+warp_gpuworkload_attempts_to_schedule_bucket{le="5"} 2
+warp_gpuworkload_attempts_to_schedule_bucket{le="8"} 2
+warp_gpuworkload_attempts_to_schedule_bucket{le="13"} 2
+warp_gpuworkload_attempts_to_schedule_bucket{le="+Inf"} 2
+warp_gpuworkload_attempts_to_schedule_sum 2
+warp_gpuworkload_attempts_to_schedule_count 2
+`
+	if err := testutil.CollectAndCompare(gpuWorkloadAttemptsToSchedule, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecordSchedulingTimeout(t *testing.T) {
+	before := testutil.ToFloat64(gpuWorkloadSchedulingTimeoutsTotal)
+	GetMetrics().RecordSchedulingTimeout()
+
+	if got := testutil.ToFloat64(gpuWorkloadSchedulingTimeoutsTotal) - before; got != 1 {
+		t.Errorf("Expected the timeouts counter to increase by 1, got %v", got)
+	}
+}
+
+func TestSetPendingWorkloads(t *testing.T) {
+	m := GetMetrics()
+	m.SetPendingWorkloads("team-a", 3)
+	m.SetPendingWorkloads("team-b", 0)
+
+	if v := testutil.ToFloat64(gpuWorkloadPending.WithLabelValues("team-a")); v != 3 {
+		t.Errorf("Expected 3 pending workloads in team-a, got %v", v)
+	}
+	if v := testutil.ToFloat64(gpuWorkloadPending.WithLabelValues("team-b")); v != 0 {
+		t.Errorf("Expected 0 pending workloads in team-b, got %v", v)
+	}
+}
+
+func TestUpdateNodeGPUAllocation(t *testing.T) {
+	m := GetMetrics()
+	defer m.UpdateNodeGPUAllocation(nil)
+
+	m.UpdateNodeGPUAllocation(map[string]NodeGPUAllocation{
+		"node1": {Allocatable: 8, Requested: 6},
+		"node2": {Allocatable: 4, Requested: 0},
+	})
+
+	if v := testutil.ToFloat64(nodeGPUAllocatable.WithLabelValues("node1")); v != 8 {
+		t.Errorf("Expected node1 allocatable of 8, got %v", v)
+	}
+	if v := testutil.ToFloat64(nodeGPURequested.WithLabelValues("node1")); v != 6 {
+		t.Errorf("Expected node1 requested of 6, got %v", v)
+	}
+
+	// node2 disappears and its series must be removed
+	m.UpdateNodeGPUAllocation(map[string]NodeGPUAllocation{
+		"node1": {Allocatable: 8, Requested: 2},
+	})
+
+	if n := testutil.CollectAndCount(nodeGPUAllocatable); n != 1 {
+		t.Errorf("Expected 1 allocatable series after node2 vanished, got %d", n)
+	}
+	if n := testutil.CollectAndCount(nodeGPURequested); n != 1 {
+		t.Errorf("Expected 1 requested series after node2 vanished, got %d", n)
+	}
+	if v := testutil.ToFloat64(nodeGPURequested.WithLabelValues("node1")); v != 2 {
+		t.Errorf("Expected node1 requested of 2, got %v", v)
+	}
+}
+
+func TestNodeAvailableGPUs(t *testing.T) {
+	m := GetMetrics()
+	defer m.UpdateNodeGPUAllocation(nil)
+
+	m.SetNodeAvailableGPUs("node1", 6)
+	m.SetNodeAvailableGPUs("node2", 0)
+	if v := testutil.ToFloat64(nodeAvailableGPUs.WithLabelValues("node1")); v != 6 {
+		t.Errorf("Expected 6 available GPUs on node1, got %v", v)
+	}
+
+	// Deleting a node removes all of its series
+	m.UpdateNodeGPUAllocation(map[string]NodeGPUAllocation{
+		"node1": {Allocatable: 8, Requested: 2},
+		"node2": {Allocatable: 4, Requested: 4},
+	})
+	m.UpdateGPUAllocationDrift(map[string]float64{"node1": 0, "node2": -2})
+	m.DeleteNodeMetrics("node2")
+	for name, gauge := range map[string]*prometheus.GaugeVec{
+		"available":   nodeAvailableGPUs,
+		"allocatable": nodeGPUAllocatable,
+		"requested":   nodeGPURequested,
+		"drift":       gpuAllocationDrift,
+	} {
+		if n := testutil.CollectAndCount(gauge); n != 1 {
+			t.Errorf("Expected 1 %s series after node2 was deleted, got %d", name, n)
+		}
+	}
+
+	// Nodes that stop being reported lose their available series too
+	m.UpdateNodeGPUAllocation(nil)
+	if n := testutil.CollectAndCount(nodeAvailableGPUs); n != 0 {
+		t.Errorf("Expected no available series once node1 vanished, got %d", n)
+	}
+}
+
+func TestUpdateGPUAllocationDrift(t *testing.T) {
+	m := GetMetrics()
+	defer m.UpdateGPUAllocationDrift(nil)
+
+	m.UpdateGPUAllocationDrift(map[string]float64{"node1": 2, "node2": -1})
+	if v := testutil.ToFloat64(gpuAllocationDrift.WithLabelValues("node2")); v != -1 {
+		t.Errorf("Expected a drift of -1 on node2, got %v", v)
+	}
+
+	// Nodes missing from the next audit lose their series
+	m.UpdateGPUAllocationDrift(map[string]float64{"node1": 0})
+	if n := testutil.CollectAndCount(gpuAllocationDrift); n != 1 {
+		t.Errorf("Expected only node1's drift series to remain, got %d", n)
+	}
+}
+
+func TestSetSchedulingConfig(t *testing.T) {
+	m := GetMetrics()
+	m.SetSchedulingConfig(SchedulingConfig{DefaultStrategy: "leastLoaded", TieBreakPolicy: "random", GPUOvercommitRatio: 1})
+
+	// A reload replaces the previous values rather than adding series
+	m.SetSchedulingConfig(SchedulingConfig{
+		DefaultStrategy:    "leastLoaded",
+		CanaryStrategy:     "binPack",
+		CanaryPercent:      10,
+		TieBreakPolicy:     "name",
+		GPUOvercommitRatio: 1.5,
+	})
+
+	expected := `
+		# HELP warp_config_info Effective scheduling configuration of the controller; always 1
+		# TYPE warp_config_info gauge
+		warp_config_info{canary_strategy="binPack",default_strategy="leastLoaded",tie_break_policy="name"} 1
+	`
+	if err := testutil.CollectAndCompare(configInfo, strings.NewReader(expected)); err != nil {
+		t.Errorf("Unexpected config info: %v", err)
+	}
+	if v := testutil.ToFloat64(configOvercommitRatio); v != 1.5 {
+		t.Errorf("Expected an over-commit ratio of 1.5, got %v", v)
+	}
+	if v := testutil.ToFloat64(configCanaryPercent); v != 10 {
+		t.Errorf("Expected a canary percent of 10, got %v", v)
+	}
+}
+
+func TestRecordReconcileError(t *testing.T) {
+	m := GetMetrics()
+	before := testutil.ToFloat64(reconcileErrorsTotal.WithLabelValues(ReconcileErrorJobCreate))
+	m.RecordReconcileError(ReconcileErrorJobCreate)
+	m.RecordReconcileError(ReconcileErrorJobCreate)
+
+	if got := testutil.ToFloat64(reconcileErrorsTotal.WithLabelValues(ReconcileErrorJobCreate)) - before; got != 2 {
+		t.Errorf("Expected 2 job_create errors, got %v", got)
+	}
+	if got := testutil.ToFloat64(reconcileErrorsTotal.WithLabelValues(ReconcileErrorConflict)); got != 0 {
+		t.Errorf("Expected no conflict errors, got %v", got)
+	}
+}
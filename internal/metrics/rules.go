@@ -0,0 +1,130 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/yaml"
+)
+
+// RuleFile is a Prometheus rule file, as loaded through rule_files or embedded in the
+// spec of a PrometheusRule resource.
+type RuleFile struct {
+	Groups []RuleGroup `json:"groups"`
+}
+
+// RuleGroup is a named group of rules evaluated together.
+type RuleGroup struct {
+	Name  string `json:"name"`
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is either a recording rule (Record set) or an alerting rule (Alert set).
+type Rule struct {
+	Record      string            `json:"record,omitempty"`
+	Alert       string            `json:"alert,omitempty"`
+	Expr        string            `json:"expr"`
+	For         string            `json:"for,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Recorded series produced by the recommended rules.
+const (
+	failureRatioRecord      = "warp:gpuworkload_failure_ratio:rate5m"
+	clusterSaturationRecord = "warp:cluster_gpu_saturation:ratio"
+	nodeSaturationRecord    = "warp:node_gpu_saturation:ratio"
+)
+
+// RecommendedRules returns the recording and alerting rules recommended for the controller.
+// They are built from the metric name constants so they stay in sync with the exported
+// metrics as those evolve.
+func RecommendedRules() RuleFile {
+	return RuleFile{Groups: []RuleGroup{
+		{
+			Name: "gpu-orchestrator.rules",
+			Rules: []Rule{
+				{
+					Record: failureRatioRecord,
+					Expr: fmt.Sprintf("sum(rate(%[1]s[5m])) / clamp_min(sum(rate(%[1]s[5m])) + sum(rate(%[2]s[5m])), 1e-9)",
+						FailedTotalName, ScheduledTotalName),
+				},
+				{
+					Record: nodeSaturationRecord,
+					Expr:   fmt.Sprintf("%s / on(node) (%s > 0)", NodeGPURequestedName, NodeGPUAllocatableName),
+				},
+				{
+					Record: clusterSaturationRecord,
+					Expr:   fmt.Sprintf("sum(%s) / clamp_min(sum(%s), 1)", NodeGPURequestedName, NodeGPUAllocatableName),
+				},
+			},
+		},
+		{
+			Name: "gpu-orchestrator.alerts",
+			Rules: []Rule{
+				{
+					Alert: "GPUWorkloadSchedulingBacklog",
+					Expr: fmt.Sprintf("sum(increase(%s[15m])) > 0 and sum(increase(%s[15m])) == 0",
+						RetriesTotalName, ScheduledTotalName),
+					For:    "15m",
+					Labels: map[string]string{"severity": "warning"},
+					Annotations: map[string]string{
+						"summary": "GPUWorkloads keep retrying but none are being scheduled",
+					},
+				},
+				{
+					Alert:  "GPUWorkloadSchedulingFailureRateHigh",
+					Expr:   failureRatioRecord + " > 0.2",
+					For:    "15m",
+					Labels: map[string]string{"severity": "warning"},
+					Annotations: map[string]string{
+						"summary": "More than 20% of GPUWorkload scheduling attempts are failing",
+					},
+				},
+				{
+					Alert:  "GPUWorkloadSchedulingTimedOut",
+					Expr:   fmt.Sprintf("sum(increase(%s[15m])) > 0", SchedulingTimeoutsTotalName),
+					Labels: map[string]string{"severity": "warning"},
+					Annotations: map[string]string{
+						"summary": "GPUWorkloads failed because no capacity was found before their scheduling deadline",
+					},
+				},
+				{
+					Alert:  "GPUClusterSaturated",
+					Expr:   clusterSaturationRecord + " > 0.9",
+					For:    "30m",
+					Labels: map[string]string{"severity": "warning"},
+					Annotations: map[string]string{
+						"summary": "More than 90% of allocatable GPUs are requested by scheduled GPUWorkloads",
+					},
+				},
+			},
+		},
+	}}
+}
+
+// WriteRules writes the recommended rules to w as YAML.
+func WriteRules(w io.Writer) error {
+	out, err := yaml.Marshal(RecommendedRules())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
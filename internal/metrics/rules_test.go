@@ -0,0 +1,120 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	seriesNamePattern = regexp.MustCompile(`[a-zA-Z_:][a-zA-Z0-9_:]*`)
+	descNamePattern   = regexp.MustCompile(`fqName: "([^"]+)"`)
+)
+
+// exportedMetricNames returns the names of every metric the controller exposes.
+func exportedMetricNames() map[string]bool {
+	collectors := []prometheus.Collector{
+		gpuWorkloadScheduledTotal,
+		gpuWorkloadGPUsRequestedTotal,
+		gpuWorkloadFailedTotal,
+		gpuWorkloadGPURequestsTotal,
+		gpuWorkloadRetriesTotal,
+		gpuWorkloadSchedulingTimeoutsTotal,
+		gpuWorkloadReconcileDurationSeconds,
+		gpuWorkloadAttemptsToSchedule,
+		nodeGPUAllocatable,
+		nodeGPURequested,
+		nodeAvailableGPUs,
+		strategyBenchmarkSeconds,
+		gpuWorkloadPending,
+		configInfo,
+		configOvercommitRatio,
+		configCanaryPercent,
+	}
+
+	names := map[string]bool{}
+	for _, c := range collectors {
+		descs := make(chan *prometheus.Desc, 10)
+		c.Describe(descs)
+		close(descs)
+		for desc := range descs {
+			if m := descNamePattern.FindStringSubmatch(desc.String()); m != nil {
+				names[m[1]] = true
+			}
+		}
+	}
+	return names
+}
+
+func TestRecommendedRulesReferenceExportedMetrics(t *testing.T) {
+	exported := exportedMetricNames()
+	if len(exported) == 0 {
+		t.Fatal("Expected exported metric names to be discovered")
+	}
+
+	rules := RecommendedRules()
+	recorded := map[string]bool{}
+	for _, group := range rules.Groups {
+		for _, rule := range group.Rules {
+			if rule.Record != "" {
+				recorded[rule.Record] = true
+			}
+		}
+	}
+
+	for _, group := range rules.Groups {
+		for _, rule := range group.Rules {
+			referenced := 0
+			for _, name := range seriesNamePattern.FindAllString(rule.Expr, -1) {
+				switch {
+				case strings.HasPrefix(name, "warp:"):
+					if !recorded[name] {
+						t.Errorf("Rule %q references series %q that no rule records", rule.Record+rule.Alert, name)
+					}
+					referenced++
+				case strings.HasPrefix(name, "warp_"):
+					if !exported[name] {
+						t.Errorf("Rule %q references unknown metric %q", rule.Record+rule.Alert, name)
+					}
+					referenced++
+				}
+			}
+			if referenced == 0 {
+				t.Errorf("Rule %q references no controller metric", rule.Record+rule.Alert)
+			}
+		}
+	}
+}
+
+func TestWriteRules(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRules(&buf); err != nil {
+		t.Fatalf("WriteRules() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"groups:", "alert: GPUClusterSaturated", "record: " + failureRatioRecord} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected rules output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
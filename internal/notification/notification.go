@@ -0,0 +1,145 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package notification tells an external webhook when GPU workloads finish, optionally
+// with the tail of their logs.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// MaxLogTailBytes bounds the size of the log tail included in a notification. Longer tails
+// keep their last lines.
+const MaxLogTailBytes = 16 * 1024
+
+// maxLogReadBytes bounds how much of a pod's logs is read before the tail is cut.
+const maxLogReadBytes = 1024 * 1024
+
+// Notification is the payload posted to the webhook when a workload finishes.
+type Notification struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	ModelName string `json:"modelName"`
+	Phase     string `json:"phase"`
+	Reason    string `json:"reason,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Node      string `json:"node,omitempty"`
+	JobName   string `json:"jobName,omitempty"`
+
+	// LogsTail holds the last lines of the workload container's logs, when enabled.
+	LogsTail string `json:"logsTail,omitempty"`
+}
+
+// Notifier delivers notifications.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// HTTPNotifier posts notifications as JSON to a webhook. Any response other than 2xx is an error.
+type HTTPNotifier struct {
+	Endpoint   string
+	HTTPClient *http.Client
+}
+
+var _ Notifier = &HTTPNotifier{}
+
+// NewHTTPNotifier creates an HTTPNotifier for the given endpoint.
+func NewHTTPNotifier(endpoint string) *HTTPNotifier {
+	return &HTTPNotifier{
+		Endpoint:   endpoint,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Notify posts the notification to the webhook.
+func (h *HTTPNotifier) Notify(ctx context.Context, n Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("notification request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// LogSource reads the last lines of a pod container's logs.
+type LogSource interface {
+	TailLogs(ctx context.Context, namespace, pod, container string, lines int64) (string, error)
+}
+
+// PodLogSource reads logs through the pod log API.
+type PodLogSource struct {
+	Pods corev1client.PodsGetter
+}
+
+var _ LogSource = &PodLogSource{}
+
+// TailLogs returns the last lines of the container's logs, at most MaxLogTailBytes of them.
+func (s *PodLogSource) TailLogs(ctx context.Context, namespace, pod, container string, lines int64) (string, error) {
+	stream, err := s.Pods.Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container: container,
+		TailLines: &lines,
+	}).Stream(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+
+	data, err := io.ReadAll(io.LimitReader(stream, maxLogReadBytes))
+	if err != nil {
+		return "", err
+	}
+	return TruncateTail(string(data), MaxLogTailBytes), nil
+}
+
+// TruncateTail returns the last limit bytes of logs, dropping the partial line the cut
+// leaves at the start.
+func TruncateTail(logs string, limit int) string {
+	if len(logs) <= limit {
+		return logs
+	}
+	logs = logs[len(logs)-limit:]
+	if i := strings.IndexByte(logs, '\n'); i >= 0 && i < len(logs)-1 {
+		logs = logs[i+1:]
+	}
+	return logs
+}
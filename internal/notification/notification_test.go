@@ -0,0 +1,70 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPNotifier_PostsNotification(t *testing.T) {
+	var received Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Decoding notification: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sent := Notification{Namespace: "default", Name: "llama", Phase: "Failed", LogsTail: "CUDA error\n"}
+	if err := NewHTTPNotifier(server.URL).Notify(context.Background(), sent); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if received != sent {
+		t.Errorf("Expected %+v to be posted, got %+v", sent, received)
+	}
+}
+
+func TestHTTPNotifier_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	if err := NewHTTPNotifier(server.URL).Notify(context.Background(), Notification{Name: "llama"}); err == nil {
+		t.Error("Expected an error for a failed delivery")
+	}
+}
+
+func TestTruncateTail(t *testing.T) {
+	if got := TruncateTail("short\n", 16); got != "short\n" {
+		t.Errorf("Expected short logs to be kept, got %q", got)
+	}
+
+	logs := strings.Repeat("x", 20) + "\nline two\nline three\n"
+	if got := TruncateTail(logs, 22); got != "line two\nline three\n" {
+		t.Errorf("Expected the cut to drop the partial first line, got %q", got)
+	}
+}
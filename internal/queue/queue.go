@@ -0,0 +1,156 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queue orders the GPUWorkloads waiting to be scheduled across the cluster, so
+// scarce GPUs go to the most urgent workload rather than to whichever reconciles first.
+package queue
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PriorityRank orders workload priorities from most to least urgent. Unset means normal.
+func PriorityRank(priority string) int {
+	switch priority {
+	case "high":
+		return 0
+	case "low":
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Item is a workload waiting in the queue.
+type Item struct {
+	Key               types.NamespacedName
+	Priority          string
+	CreationTimestamp time.Time
+
+	// GPUCount is the number of GPUs the workload needs on a single node.
+	GPUCount int32
+
+	// NodeSelector restricts the nodes the workload can use.
+	NodeSelector map[string]string
+
+	// failedWithFree is the most GPUs free on a node the workload could use when its last
+	// placement attempt failed, or -1 when it has not failed.
+	failedWithFree int64
+}
+
+// Less reports whether a is scheduled before b: by priority, then by creation time, oldest
+// first. Ties are broken by namespace and name so the order is stable.
+func Less(a, b Item) bool {
+	if ra, rb := PriorityRank(a.Priority), PriorityRank(b.Priority); ra != rb {
+		return ra < rb
+	}
+	if !a.CreationTimestamp.Equal(b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(b.CreationTimestamp)
+	}
+	if a.Key.Namespace != b.Key.Namespace {
+		return a.Key.Namespace < b.Key.Namespace
+	}
+	return a.Key.Name < b.Key.Name
+}
+
+// HoldsBack reports whether the item should be placed before the workloads queued behind it
+// while freeGPUs are free on a node it can use: it fits, and more GPUs are free than when its
+// last attempt failed. An item that cannot be placed for other reasons than GPUs thus only
+// holds the queue back once per change in capacity, instead of starving it.
+func (i Item) HoldsBack(freeGPUs int64) bool {
+	return freeGPUs >= int64(i.GPUCount) && freeGPUs > i.failedWithFree
+}
+
+// Queue is a concurrency-safe set of waiting workloads kept in scheduling order.
+type Queue struct {
+	mu    sync.Mutex
+	items map[types.NamespacedName]Item
+}
+
+// New returns an empty Queue.
+func New() *Queue {
+	return &Queue{items: map[types.NamespacedName]Item{}}
+}
+
+// Add queues the workload, or updates it when already queued, keeping the outcome of its
+// last placement attempt.
+func (q *Queue) Add(item Item) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item.failedWithFree = -1
+	if queued, ok := q.items[item.Key]; ok {
+		item.failedWithFree = queued.failedWithFree
+	}
+	q.items[item.Key] = item
+}
+
+// Remove takes the workload off the queue, if queued.
+func (q *Queue) Remove(key types.NamespacedName) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	delete(q.items, key)
+}
+
+// MarkUnplaced records that the workload's placement attempt failed while at most freeGPUs
+// were free on a node it could use.
+func (q *Queue) MarkUnplaced(key types.NamespacedName, freeGPUs int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if item, ok := q.items[key]; ok {
+		item.failedWithFree = freeGPUs
+		q.items[key] = item
+	}
+}
+
+// Len returns the number of queued workloads.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.items)
+}
+
+// Items returns the queued workloads in scheduling order.
+func (q *Queue) Items() []Item {
+	q.mu.Lock()
+	items := make([]Item, 0, len(q.items))
+	for _, item := range q.items {
+		items = append(items, item)
+	}
+	q.mu.Unlock()
+
+	sort.Slice(items, func(i, j int) bool { return Less(items[i], items[j]) })
+	return items
+}
+
+// Ahead returns the workloads scheduled before the keyed one, in scheduling order. A
+// workload that is not queued has every queued workload ahead of it.
+func (q *Queue) Ahead(key types.NamespacedName) []Item {
+	items := q.Items()
+	for i, item := range items {
+		if item.Key == key {
+			return items[:i]
+		}
+	}
+	return items
+}
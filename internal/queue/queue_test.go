@@ -0,0 +1,96 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func item(name, priority string, created time.Time) Item {
+	return Item{Key: types.NamespacedName{Namespace: "default", Name: name}, Priority: priority, CreationTimestamp: created, GPUCount: 2}
+}
+
+func names(items []Item) []string {
+	result := make([]string, 0, len(items))
+	for _, i := range items {
+		result = append(result, i.Key.Name)
+	}
+	return result
+}
+
+func TestQueue_OrdersByPriorityThenAge(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := New()
+	q.Add(item("normal-new", "normal", now.Add(time.Minute)))
+	q.Add(item("low-old", "low", now.Add(-time.Hour)))
+	q.Add(item("high", "high", now.Add(time.Hour)))
+	q.Add(item("unset-old", "", now))
+	q.Add(item("b-tie", "normal", now.Add(time.Minute)))
+
+	want := []string{"high", "unset-old", "b-tie", "normal-new", "low-old"}
+	got := names(q.Items())
+	if len(got) != len(want) {
+		t.Fatalf("Items() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Items() = %v, want %v", got, want)
+		}
+	}
+
+	if ahead := names(q.Ahead(types.NamespacedName{Namespace: "default", Name: "unset-old"})); len(ahead) != 1 || ahead[0] != "high" {
+		t.Errorf("Ahead(unset-old) = %v, want [high]", ahead)
+	}
+	if ahead := q.Ahead(types.NamespacedName{Namespace: "default", Name: "missing"}); len(ahead) != 5 {
+		t.Errorf("Expected every queued item ahead of an unqueued workload, got %d", len(ahead))
+	}
+
+	q.Remove(types.NamespacedName{Namespace: "default", Name: "high"})
+	if q.Len() != 4 {
+		t.Errorf("Expected 4 items after Remove, got %d", q.Len())
+	}
+}
+
+func TestQueue_UnplacedItemsHoldBackOnlyWhenCapacityGrows(t *testing.T) {
+	q := New()
+	key := types.NamespacedName{Namespace: "default", Name: "w"}
+	q.Add(Item{Key: key, GPUCount: 2})
+
+	holdsBack := func(free int64) bool {
+		t.Helper()
+		return q.Ahead(types.NamespacedName{Name: "behind"})[0].HoldsBack(free)
+	}
+	if holdsBack(1) {
+		t.Error("Expected an item that does not fit not to hold back the queue")
+	}
+	if !holdsBack(2) {
+		t.Error("Expected an item that fits to hold back the queue")
+	}
+
+	// Failing with 4 free GPUs, e.g. for lack of memory, releases the queue until more are free
+	q.MarkUnplaced(key, 4)
+	q.Add(Item{Key: key, GPUCount: 2})
+	if holdsBack(4) {
+		t.Error("Expected an item that failed with as many free GPUs not to hold back the queue")
+	}
+	if !holdsBack(6) {
+		t.Error("Expected an item to hold back the queue again once more GPUs are free")
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"hash/fnv"
+)
+
+// InCanary reports whether the workload identified by key is routed to the canary strategy
+// when percent of workloads are. The decision is a hash of the key, so a workload takes
+// the same path on every retry.
+func InCanary(key string, percent int) bool {
+	if percent <= 0 {
+		return false
+	}
+	if percent >= 100 {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int(h.Sum32()%100) < percent
+}
+
+// IsStrategyName reports whether Factory knows the strategy.
+func IsStrategyName(name string) bool {
+	for _, known := range StrategyNames {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInCanary_SplitApproximatesPercent(t *testing.T) {
+	const workloads = 10000
+	for _, percent := range []int{0, 5, 20, 50, 100} {
+		routed := 0
+		for i := 0; i < workloads; i++ {
+			if InCanary(fmt.Sprintf("3f2a%04d-8c1e-4b7d-9a51-%012d", i, i*7919), percent) {
+				routed++
+			}
+		}
+		got := float64(routed) * 100 / workloads
+		if got < float64(percent)-2 || got > float64(percent)+2 {
+			t.Errorf("InCanary(%d%%) routed %.1f%% of workloads", percent, got)
+		}
+	}
+}
+
+func TestInCanary_IsStablePerWorkload(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("workload-%d", i)
+		if InCanary(key, 30) != InCanary(key, 30) {
+			t.Fatalf("Expected %s to take the same path every time", key)
+		}
+	}
+}
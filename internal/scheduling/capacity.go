@@ -0,0 +1,56 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CapacityProvider reports how many GPUs a node can still accept. Implementations may be
+// backed by node allocatable resources, DCGM, the metrics API or a reservation ledger;
+// strategies only ever read capacity through it.
+type CapacityProvider interface {
+	// AvailableGPUs returns the GPUs available for new workloads on the node.
+	AvailableGPUs(node *corev1.Node) int64
+}
+
+// AllocatableCapacity is the default CapacityProvider. It reads the node's allocatable GPUs,
+// scaled by its over-commit ratio.
+type AllocatableCapacity struct{}
+
+var _ CapacityProvider = AllocatableCapacity{}
+
+// AvailableGPUs implements CapacityProvider.
+func (AllocatableCapacity) AvailableGPUs(node *corev1.Node) int64 {
+	return getAvailableGPUs(node)
+}
+
+// CapacityFunc adapts a function to a CapacityProvider.
+type CapacityFunc func(node *corev1.Node) int64
+
+// AvailableGPUs implements CapacityProvider.
+func (f CapacityFunc) AvailableGPUs(node *corev1.Node) int64 {
+	return f(node)
+}
+
+// capacityOrDefault returns the provider, or AllocatableCapacity when it is nil.
+func capacityOrDefault(capacity CapacityProvider) CapacityProvider {
+	if capacity == nil {
+		return AllocatableCapacity{}
+	}
+	return capacity
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// fakeCapacity reports fixed free GPUs per node name, ignoring node allocatable.
+func fakeCapacity(free map[string]int64) CapacityProvider {
+	return CapacityFunc(func(node *corev1.Node) int64 { return free[node.Name] })
+}
+
+func TestFactory_StrategiesReadCapacityProvider(t *testing.T) {
+	// node1 advertises the most GPUs, but the provider says most are in use
+	nodes := []corev1.Node{createMockNode("node1", 8), createMockNode("node2", 4)}
+	opts := Options{Capacity: fakeCapacity(map[string]int64{"node1": 1, "node2": 3})}
+
+	for _, name := range []string{"leastLoaded", "random", "costOptimized", "numaAware"} {
+		t.Run(name, func(t *testing.T) {
+			strategy, err := Factory(name, logr.Discard(), opts)
+			if err != nil {
+				t.Fatalf("Factory() error = %v", err)
+			}
+			selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+			if err != nil {
+				t.Fatalf("ChooseNode() error = %v", err)
+			}
+			if selected.Name != "node2" {
+				t.Errorf("Expected node2, the only node with 2 free GPUs, got %s", selected.Name)
+			}
+		})
+	}
+}
+
+func TestFactory_CapacityProviderRejectsFullNodes(t *testing.T) {
+	nodes := []corev1.Node{createMockNode("node1", 8)}
+	opts := Options{Capacity: fakeCapacity(map[string]int64{"node1": 0})}
+
+	strategy, _ := Factory("leastLoaded", logr.Discard(), opts)
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1)); err == nil {
+		t.Error("Expected an error when the provider reports no free GPUs")
+	}
+}
+
+func TestCapacityRejection_UsesCapacityProvider(t *testing.T) {
+	node := createMockNode("node1", 8)
+	capacity := fakeCapacity(map[string]int64{"node1": 2})
+
+	reason, detail, rejected := CapacityRejection(&node, createMockGPUWorkload(4), capacity)
+	if !rejected || reason != RejectionInsufficientGPUs || detail != "2 available, 4 requested" {
+		t.Errorf("CapacityRejection() = (%q, %q, %v), want insufficient_gpus with the provider's capacity", reason, detail, rejected)
+	}
+}
+
+func TestAllocatableCapacity_MatchesAvailableGPUs(t *testing.T) {
+	node := createMockNode("node1", 4)
+	node.Annotations = map[string]string{OvercommitRatioAnnotation: "2"}
+	if got, want := (AllocatableCapacity{}).AvailableGPUs(&node), AvailableGPUs(&node); got != want {
+		t.Errorf("AllocatableCapacity reports %d GPUs, want %d", got, want)
+	}
+}
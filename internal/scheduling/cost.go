@@ -0,0 +1,59 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CostPerHourLabel is the node label holding the node's hourly cost as a decimal number.
+const CostPerHourLabel = "gpu-orchestrator/cost-per-hour"
+
+// GetNodeCostPerHour returns the hourly cost advertised by the node's cost label.
+// The second return value is false when the label is missing or not a valid number.
+func GetNodeCostPerHour(node *corev1.Node) (float64, bool) {
+	if node.Labels == nil {
+		return 0, false
+	}
+	value, exists := node.Labels[CostPerHourLabel]
+	if !exists {
+		return 0, false
+	}
+	cost, err := strconv.ParseFloat(value, 64)
+	if err != nil || cost < 0 {
+		return 0, false
+	}
+	return cost, true
+}
+
+// EstimateCostPerHour estimates the hourly cost of running gpuCount GPUs on the node.
+// The node's hourly cost is split evenly across its GPUs, so a workload using half
+// of a node's GPUs is charged half of the node's cost.
+func EstimateCostPerHour(node *corev1.Node, gpuCount int32) (float64, bool) {
+	cost, ok := GetNodeCostPerHour(node)
+	if !ok {
+		return 0, false
+	}
+
+	nodeGPUs := getAvailableGPUs(node)
+	if nodeGPUs <= 0 || int64(gpuCount) >= nodeGPUs {
+		return cost, true
+	}
+	return cost * float64(gpuCount) / float64(nodeGPUs), true
+}
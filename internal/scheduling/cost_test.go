@@ -0,0 +1,63 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"testing"
+)
+
+func TestEstimateCostPerHour_UsesNodeLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		label    string
+		nodeGPUs int64
+		gpuCount int32
+		expected float64
+	}{
+		{"full node", "8.00", 4, 4, 8.0},
+		{"half node", "8.00", 4, 2, 4.0},
+		{"single GPU of eight", "32", 8, 1, 4.0},
+		{"request larger than node", "10", 2, 4, 10.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := createMockNode("node1", tt.nodeGPUs)
+			node.Labels = map[string]string{CostPerHourLabel: tt.label}
+
+			cost, ok := EstimateCostPerHour(&node, tt.gpuCount)
+			if !ok {
+				t.Fatalf("EstimateCostPerHour() reported no cost for label %q", tt.label)
+			}
+			if cost != tt.expected {
+				t.Errorf("EstimateCostPerHour() = %v, want %v", cost, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEstimateCostPerHour_MissingOrInvalidLabel(t *testing.T) {
+	node := createMockNode("node1", 4)
+	if _, ok := EstimateCostPerHour(&node, 1); ok {
+		t.Error("Expected no cost for node without a cost label")
+	}
+
+	node.Labels = map[string]string{CostPerHourLabel: "cheap"}
+	if _, ok := EstimateCostPerHour(&node, 1); ok {
+		t.Error("Expected no cost for node with a non-numeric cost label")
+	}
+}
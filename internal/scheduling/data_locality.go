@@ -0,0 +1,85 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DataLocalityStrategy places workloads next to their data: it picks the least loaded
+// fitting node carrying the workload's DataLocalityLabel, which marks nodes that have the
+// workload's dataset cached. It falls back to LeastLoadedStrategy when no such node fits or
+// the workload names no label.
+type DataLocalityStrategy struct {
+	logger   logr.Logger
+	capacity CapacityProvider
+}
+
+var _ Strategy = &DataLocalityStrategy{}
+
+// NewDataLocalityStrategy creates a new DataLocalityStrategy.
+func NewDataLocalityStrategy(logger logr.Logger) *DataLocalityStrategy {
+	return &DataLocalityStrategy{logger: logger, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the least loaded fitting node with the dataset cached, otherwise the
+// least loaded fitting node.
+func (s *DataLocalityStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	if label := gw.Spec.DataLocalityLabel; label != "" {
+		bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+			availableGPUs := capacity.AvailableGPUs(node)
+			return []int64{availableGPUs}, hasDataLocalityLabel(node, label) && availableGPUs >= int64(gw.RequestedGPUCount())
+		})
+		if bestNode != nil {
+			s.logger.Info("Selected node with cached data using DataLocalityStrategy", "node", bestNode.Name,
+				"label", label, "availableGPUs", score[0])
+			return bestNode, nil
+		}
+		s.logger.Info("No node with cached data fits, falling back to LeastLoadedStrategy", "label", label)
+	}
+
+	fallback := &LeastLoadedStrategy{logger: s.logger, capacity: s.capacity}
+	return fallback.ChooseNode(ctx, nodes, pods, gw)
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *DataLocalityStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *DataLocalityStrategy) Name() string {
+	return "dataLocality"
+}
+
+// hasDataLocalityLabel reports whether the node carries the label, given as "key=value" or
+// as a bare key matching any value.
+func hasDataLocalityLabel(node *corev1.Node, label string) bool {
+	key, value, hasValue := strings.Cut(label, "=")
+	actual, ok := node.Labels[key]
+	return ok && (!hasValue || actual == value)
+}
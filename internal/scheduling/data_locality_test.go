@@ -0,0 +1,88 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// createDatasetNode returns a GPU node caching the given dataset.
+func createDatasetNode(name, dataset string, gpus int64) corev1.Node {
+	node := createMockNode(name, gpus)
+	node.Labels = map[string]string{"dataset": dataset}
+	return node
+}
+
+func TestDataLocalityStrategy_PrefersNodesCachingTheDataset(t *testing.T) {
+	strategy := NewDataLocalityStrategy(logr.Discard())
+	nodes := []corev1.Node{
+		createMockNode("uncached", 8),
+		createDatasetNode("coco", "coco", 8),
+		createDatasetNode("imagenet-small", "imagenet", 2),
+		createDatasetNode("imagenet-large", "imagenet", 4),
+	}
+	gw := createMockGPUWorkload(2)
+	gw.Spec.DataLocalityLabel = "dataset=imagenet"
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, gw)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "imagenet-large" {
+		t.Errorf("Expected the least loaded imagenet node, got %s", selected.Name)
+	}
+
+	// A bare key matches any dataset
+	gw.Spec.DataLocalityLabel = "dataset"
+	gw.Spec.GPUCount = 8
+	selected, err = strategy.ChooseNode(context.Background(), nodes, nil, gw)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "coco" {
+		t.Errorf("Expected the coco node, got %s", selected.Name)
+	}
+}
+
+func TestDataLocalityStrategy_FallsBackToLeastLoaded(t *testing.T) {
+	strategy := NewDataLocalityStrategy(logr.Discard())
+	nodes := []corev1.Node{
+		createMockNode("small", 2),
+		createMockNode("large", 8),
+		createDatasetNode("imagenet", "imagenet", 2),
+	}
+
+	for name, label := range map[string]string{
+		"dataset not cached": "dataset=laion",
+		"cached node full":   "dataset=imagenet",
+		"no label":           "",
+	} {
+		gw := createMockGPUWorkload(4)
+		gw.Spec.DataLocalityLabel = label
+		selected, err := strategy.ChooseNode(context.Background(), nodes, nil, gw)
+		if err != nil {
+			t.Fatalf("%s: ChooseNode() error = %v", name, err)
+		}
+		if selected.Name != "large" {
+			t.Errorf("%s: expected the least loaded node, got %s", name, selected.Name)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NamespaceUsage holds the GPUs each namespace's placed workloads currently hold, keyed by namespace.
+type NamespaceUsage map[string]int64
+
+type namespaceUsageKey struct{}
+
+// WithNamespaceUsage returns a context carrying the per-namespace GPU usage FairShareStrategy
+// weighs placements by. The controller computes it before choosing nodes.
+func WithNamespaceUsage(ctx context.Context, usage NamespaceUsage) context.Context {
+	return context.WithValue(ctx, namespaceUsageKey{}, usage)
+}
+
+// NamespaceUsageFromContext returns the per-namespace GPU usage carried by ctx, or nil.
+func NamespaceUsageFromContext(ctx context.Context) NamespaceUsage {
+	usage, _ := ctx.Value(namespaceUsageKey{}).(NamespaceUsage)
+	return usage
+}
+
+// FairShareStrategy balances GPUs across the namespaces sharing a cluster. A namespace's
+// fair share is the nodes' GPUs divided evenly among the namespaces holding GPUs and the
+// requesting one. Workloads of namespaces within their share are spread onto the least
+// loaded fitting node, while those of namespaces already over it are packed onto the
+// tightest fitting node, keeping idle nodes free for namespaces under their share. Without
+// usage in the context every namespace is within its share.
+type FairShareStrategy struct {
+	logger   logr.Logger
+	capacity CapacityProvider
+}
+
+var _ Strategy = &FairShareStrategy{}
+
+// NewFairShareStrategy creates a new FairShareStrategy.
+func NewFairShareStrategy(logger logr.Logger) *FairShareStrategy {
+	return &FairShareStrategy{logger: logger, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the least loaded fitting node for namespaces within their fair share,
+// and the most loaded fitting node for namespaces over it.
+func (s *FairShareStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	usage := NamespaceUsageFromContext(ctx)
+	used := usage[gw.Namespace]
+	share := fairShare(nodes, usage, gw.Namespace)
+	overShare := used+int64(gw.RequestedGPUCount()) > share
+
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		fits := availableGPUs >= int64(gw.RequestedGPUCount())
+		if overShare {
+			return []int64{-availableGPUs}, fits
+		}
+		return []int64{availableGPUs}, fits
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	availableGPUs := score[0]
+	if overShare {
+		availableGPUs = -availableGPUs
+	}
+	s.logger.Info("Selected node using FairShareStrategy", "node", bestNode.Name, "availableGPUs", availableGPUs,
+		"namespace", gw.Namespace, "namespaceGPUs", used, "fairShare", share, "overShare", overShare)
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *FairShareStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *FairShareStrategy) Name() string {
+	return "fairShare"
+}
+
+// fairShare returns the GPUs each namespace is entitled to: the nodes' GPUs divided by the
+// number of namespaces holding GPUs, counting the requesting namespace.
+func fairShare(nodes []corev1.Node, usage NamespaceUsage, namespace string) int64 {
+	var total int64
+	for i := range nodes {
+		total += getAvailableGPUs(&nodes[i])
+	}
+	namespaces := int64(1)
+	for ns, gpus := range usage {
+		if ns != namespace && gpus > 0 {
+			namespaces++
+		}
+	}
+	return total / namespaces
+}
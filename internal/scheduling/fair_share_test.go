@@ -0,0 +1,69 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestFairShareStrategy_PacksNamespacesOverTheirShare(t *testing.T) {
+	strategy := NewFairShareStrategy(logr.Discard())
+	nodes := []corev1.Node{createMockNode("large", 8), createMockNode("small", 2)}
+	// 10 GPUs shared by team-a and team-b leave each a share of 5
+	ctx := WithNamespaceUsage(context.Background(), NamespaceUsage{"team-a": 6, "team-b": 2})
+
+	greedy := createMockGPUWorkload(1)
+	greedy.Namespace = "team-a"
+	selected, err := strategy.ChooseNode(ctx, nodes, nil, greedy)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "small" {
+		t.Errorf("Expected team-a, over its share, to be packed onto small, got %s", selected.Name)
+	}
+
+	modest := createMockGPUWorkload(1)
+	modest.Namespace = "team-b"
+	selected, err = strategy.ChooseNode(ctx, nodes, nil, modest)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "large" {
+		t.Errorf("Expected team-b, within its share, on the least loaded large node, got %s", selected.Name)
+	}
+}
+
+func TestFairShareStrategy_WithoutUsageBehavesLikeLeastLoaded(t *testing.T) {
+	strategy := NewFairShareStrategy(logr.Discard())
+	nodes := []corev1.Node{createMockNode("small", 2), createMockNode("large", 8)}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "large" {
+		t.Errorf("Expected large, got %s", selected.Name)
+	}
+
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(16)); err == nil {
+		t.Error("Expected an error when no node has enough GPUs")
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// chooseReplicaNodes places the replicas of a gang one at a time with the strategy's
+// ChooseNode, booking each replica's GPUs on its node before placing the next, so a node
+// only hosts several replicas while it has room for all of them. Nodes are returned from
+// nodes, indexed by replica, and only when every replica fits.
+func chooseReplicaNodes(ctx context.Context, strategy Strategy, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	if replicas < 1 {
+		replicas = 1
+	}
+
+	// Book replicas on copies, leaving the caller's nodes and pods untouched
+	candidates := make([]corev1.Node, len(nodes))
+	copy(candidates, nodes)
+	booked := make(NodePods, len(pods))
+	for name, nodePods := range pods {
+		booked[name] = nodePods
+	}
+
+	selected := make([]*corev1.Node, 0, replicas)
+	for i := 0; i < replicas; i++ {
+		node, err := strategy.ChooseNode(ctx, candidates, booked, gw)
+		if err != nil {
+			var schedErr *SchedulingError
+			if errors.As(err, &schedErr) {
+				return nil, newSchedulingError(schedErr.Reason, "only %d of %d replicas fit: %s", i, replicas, schedErr.Message)
+			}
+			return nil, err
+		}
+		index := nodeIndex(candidates, node.Name)
+		if index < 0 {
+			return nil, fmt.Errorf("strategy %s selected unknown node %s", strategy.Name(), node.Name)
+		}
+		selected = append(selected, &nodes[index])
+		bookReplica(&candidates[index], booked, gw)
+	}
+	return selected, nil
+}
+
+// bookReplica takes a replica's GPUs off the node: MIG slices listed in its slices annotation
+// from the annotation, allocatable MIG slices and whole GPUs through a placeholder pod bound
+// to it.
+func bookReplica(node *corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) {
+	requested := int64(gw.RequestedGPUCount())
+	profile := gw.Spec.MIGProfile
+	if slices := annotatedMIGSlices(node); profile != "" {
+		if _, listed := slices[profile]; listed {
+			slices[profile] -= requested
+			*node = *node.DeepCopy()
+			node.Annotations[MIGSlicesAnnotation] = formatMIGSlices(slices)
+			return
+		}
+	}
+
+	name, ok := VendorResourceName(gw.Spec.GPUVendor)
+	if !ok {
+		name, _ = VendorResourceName(VendorNVIDIA)
+	}
+	if profile != "" {
+		name = MIGResourceName(profile)
+	}
+	quantity := *resource.NewQuantity(requested, resource.DecimalSI)
+	placeholder := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-replica-%d", gw.Name, len(pods[node.Name]))},
+		Spec: corev1.PodSpec{
+			NodeName: node.Name,
+			Containers: []corev1.Container{{
+				Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{name: quantity}},
+			}},
+		},
+	}
+	// Append to a fresh slice so the caller's pods are never written to
+	existing := pods[node.Name]
+	pods[node.Name] = append(existing[:len(existing):len(existing)], placeholder)
+}
+
+// formatMIGSlices renders slices in the MIGSlicesAnnotation format, ordered by profile.
+func formatMIGSlices(slices map[string]int64) string {
+	entries := make([]string, 0, len(slices))
+	for profile, count := range slices {
+		entries = append(entries, profile+"="+strconv.FormatInt(count, 10))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// nodeIndex returns the index of the named node, or -1.
+func nodeIndex(nodes []corev1.Node, name string) int {
+	for i := range nodes {
+		if nodes[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func nodeNames(nodes []*corev1.Node) []string {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Name
+	}
+	return names
+}
+
+func TestChooseNodes_SpreadsReplicasAcrossNodes(t *testing.T) {
+	strategy := NewLeastLoadedStrategy(logr.Discard())
+	nodes := []corev1.Node{createMockNode("node1", 4), createMockNode("node2", 2)}
+
+	// Booking each replica leaves node1 with 2 GPUs, tied with node2, then node2 with none
+	selected, err := strategy.ChooseNodes(context.Background(), nodes, nil, createMockGPUWorkload(2), 3)
+	if err != nil {
+		t.Fatalf("ChooseNodes() error = %v", err)
+	}
+	counts := map[string]int{}
+	for _, name := range nodeNames(selected) {
+		counts[name]++
+	}
+	if len(selected) != 3 || counts["node1"] != 2 || counts["node2"] != 1 {
+		t.Errorf("Expected two replicas on node1 and one on node2, got %v", nodeNames(selected))
+	}
+	if selected[0] != &nodes[0] {
+		t.Error("Expected the selected nodes to point into the given nodes")
+	}
+}
+
+func TestChooseNodes_BinPackFillsNodeBeforeNext(t *testing.T) {
+	strategy := NewBinPackStrategy(logr.Discard())
+	nodes := []corev1.Node{createMockNode("node1", 8), createMockNode("node2", 4)}
+
+	selected, err := strategy.ChooseNodes(context.Background(), nodes, nil, createMockGPUWorkload(2), 3)
+	if err != nil {
+		t.Fatalf("ChooseNodes() error = %v", err)
+	}
+	if names := nodeNames(selected); len(names) != 3 || names[0] != "node2" || names[1] != "node2" || names[2] != "node1" {
+		t.Errorf("Expected node2 to be filled before node1, got %v", names)
+	}
+}
+
+func TestChooseNodes_FailsWithoutRoomForEveryReplica(t *testing.T) {
+	strategy := NewLeastLoadedStrategy(logr.Discard())
+	nodes := []corev1.Node{createMockNode("node1", 4), createMockNode("node2", 2)}
+	pods := NodePods{"node1": {createMockGPUPod("busy", "node1", 2, corev1.PodRunning)}}
+
+	selected, err := strategy.ChooseNodes(context.Background(), nodes, pods, createMockGPUWorkload(2), 3)
+	if err == nil {
+		t.Fatalf("Expected an error, got nodes %v", nodeNames(selected))
+	}
+	var schedErr *SchedulingError
+	if !errors.As(err, &schedErr) || schedErr.Reason != RejectionInsufficientGPUs {
+		t.Errorf("Expected an insufficient_gpus scheduling error, got %v", err)
+	}
+	if len(pods["node1"]) != 1 {
+		t.Errorf("Expected the given pods to be left untouched, got %d on node1", len(pods["node1"]))
+	}
+}
+
+func TestChooseNodes_BooksMIGSlices(t *testing.T) {
+	strategy := NewMIGPartitionStrategy(logr.Discard())
+	node1 := createMockNode("node1", 1)
+	node1.Annotations = map[string]string{MIGSlicesAnnotation: "1g.10gb=3"}
+	node2 := createMockNode("node2", 1)
+	node2.Annotations = map[string]string{MIGSlicesAnnotation: "1g.10gb=1"}
+	nodes := []corev1.Node{node1, node2}
+
+	gw := createMockGPUWorkload(2)
+	gw.Spec.MIGProfile = "1g.10gb"
+	if selected, err := strategy.ChooseNodes(context.Background(), nodes, nil, gw, 2); err == nil {
+		t.Errorf("Expected two 2-slice replicas not to fit 4 slices split 3/1, got %v", nodeNames(selected))
+	}
+
+	gw.Spec.GPUCount = 1
+	selected, err := strategy.ChooseNodes(context.Background(), nodes, nil, gw, 4)
+	if err != nil {
+		t.Fatalf("ChooseNodes() error = %v", err)
+	}
+	if len(selected) != 4 {
+		t.Errorf("Expected 4 replicas, got %v", nodeNames(selected))
+	}
+	if nodes[0].Annotations[MIGSlicesAnnotation] != "1g.10gb=3" {
+		t.Errorf("Expected the given nodes to be left untouched, got %q", nodes[0].Annotations[MIGSlicesAnnotation])
+	}
+}
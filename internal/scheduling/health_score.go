@@ -0,0 +1,203 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GPUTemperatureAnnotation is the node annotation holding the hottest GPU's temperature in
+// degrees Celsius, as published by a monitoring agent such as the DCGM exporter.
+const GPUTemperatureAnnotation = "gpu-orchestrator/gpu-temperature"
+
+const (
+	// healthyGPUTemperature is the temperature at or below which a node rates fully healthy.
+	healthyGPUTemperature = 40.0
+
+	// criticalGPUTemperature is the temperature at or above which a node rates unhealthy.
+	criticalGPUTemperature = 90.0
+)
+
+// healthPressureConditions are the node conditions that lower the pressure signal. Nodes
+// reporting them only reach the strategy when the controller is told to ignore them.
+var healthPressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+}
+
+// HealthScoreWeights weighs the signals HealthScoreStrategy combines. Weights are relative;
+// a zero weight ignores the signal. The zero value weighs every signal equally.
+type HealthScoreWeights struct {
+	// Utilization rates nodes by the share of their GPUs still free.
+	Utilization float64
+
+	// Temperature rates nodes by their GPUTemperatureAnnotation.
+	Temperature float64
+
+	// Failures rates nodes by the share of the workloads placed on them that succeeded.
+	Failures float64
+
+	// Pressure rates nodes reporting a memory, disk or PID pressure condition unhealthy.
+	Pressure float64
+}
+
+func (w HealthScoreWeights) withDefaults() HealthScoreWeights {
+	if w == (HealthScoreWeights{}) {
+		return HealthScoreWeights{Utilization: 1, Temperature: 1, Failures: 1, Pressure: 1}
+	}
+	return w
+}
+
+// ParseHealthScoreWeights parses weights of the form "utilization=2,temperature=1,failures=1,pressure=0".
+// Signals left out weigh 0, unless the value is empty, which weighs every signal equally.
+func ParseHealthScoreWeights(value string) (HealthScoreWeights, error) {
+	var weights HealthScoreWeights
+	if strings.TrimSpace(value) == "" {
+		return weights, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		name, weightStr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return HealthScoreWeights{}, fmt.Errorf("invalid health score weight %q, expected signal=weight", entry)
+		}
+		weight, err := strconv.ParseFloat(weightStr, 64)
+		if err != nil || weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return HealthScoreWeights{}, fmt.Errorf("invalid weight %q for signal %q", weightStr, name)
+		}
+		switch name {
+		case "utilization":
+			weights.Utilization = weight
+		case "temperature":
+			weights.Temperature = weight
+		case "failures":
+			weights.Failures = weight
+		case "pressure":
+			weights.Pressure = weight
+		default:
+			return HealthScoreWeights{}, fmt.Errorf("unknown health signal %q, must be one of utilization, temperature, failures, pressure", name)
+		}
+	}
+	if weights == (HealthScoreWeights{}) {
+		return HealthScoreWeights{}, fmt.Errorf("at least one health score weight must be positive")
+	}
+	return weights, nil
+}
+
+// HealthScoreStrategy combines several node signals, each rated between 0 (unhealthy) and 1
+// (healthy), into a weighted average and selects the fitting node with the highest score.
+// Nodes with equal scores are ranked by the most available GPUs. Signals a node does not
+// report, such as a missing temperature, rate 0.5.
+type HealthScoreStrategy struct {
+	logger   logr.Logger
+	weights  HealthScoreWeights
+	tracker  *ReliabilityTracker
+	capacity CapacityProvider
+}
+
+var _ Strategy = &HealthScoreStrategy{}
+
+// NewHealthScoreStrategy creates a new HealthScoreStrategy reading workload outcomes from tracker.
+func NewHealthScoreStrategy(logger logr.Logger, weights HealthScoreWeights, tracker *ReliabilityTracker) *HealthScoreStrategy {
+	return &HealthScoreStrategy{logger: logger, weights: weights.withDefaults(), tracker: tracker, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the fitting node with the highest composite health score.
+func (s *HealthScoreStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	// Score in thousandths so it stays an integer
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		health := int64(math.Round(s.score(node, availableGPUs, s.capacity.AvailableGPUs(node)) * 1000))
+		return []int64{health, availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	s.logger.Info("Selected node using HealthScoreStrategy", "node", bestNode.Name,
+		"healthScore", float64(score[0])/1000, "availableGPUs", score[1])
+	return bestNode, nil
+}
+
+// score returns the node's weighted health between 0 and 1, given the GPUs still available
+// on it out of its total.
+func (s *HealthScoreStrategy) score(node *corev1.Node, availableGPUs, totalGPUs int64) float64 {
+	weights := s.weights
+
+	utilization := 0.5
+	if totalGPUs > 0 {
+		utilization = math.Max(0, math.Min(1, float64(availableGPUs)/float64(totalGPUs)))
+	}
+	failures := 0.5
+	if s.tracker != nil {
+		failures = s.tracker.SuccessRate(node.Name)
+	}
+
+	total := weights.Utilization*utilization +
+		weights.Temperature*temperatureHealth(node) +
+		weights.Failures*failures +
+		weights.Pressure*pressureHealth(node)
+	return total / (weights.Utilization + weights.Temperature + weights.Failures + weights.Pressure)
+}
+
+// temperatureHealth rates the node's GPU temperature: 1 up to healthyGPUTemperature, falling
+// linearly to 0 at criticalGPUTemperature, and 0.5 when the temperature is unknown.
+func temperatureHealth(node *corev1.Node) float64 {
+	temperature, err := strconv.ParseFloat(node.Annotations[GPUTemperatureAnnotation], 64)
+	if err != nil || math.IsNaN(temperature) {
+		return 0.5
+	}
+	health := (criticalGPUTemperature - temperature) / (criticalGPUTemperature - healthyGPUTemperature)
+	return math.Max(0, math.Min(1, health))
+}
+
+// pressureHealth rates a node reporting any pressure condition 0, and others 1.
+func pressureHealth(node *corev1.Node) float64 {
+	for _, condition := range node.Status.Conditions {
+		for _, pressure := range healthPressureConditions {
+			if condition.Type == pressure && condition.Status == corev1.ConditionTrue {
+				return 0
+			}
+		}
+	}
+	return 1
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *HealthScoreStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *HealthScoreStrategy) Name() string {
+	return "healthScore"
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// createHotNode returns a GPU node whose GPUs report the given temperature.
+func createHotNode(name string, gpus int64, temperature string) corev1.Node {
+	node := createMockNode(name, gpus)
+	node.Annotations = map[string]string{GPUTemperatureAnnotation: temperature}
+	return node
+}
+
+func TestHealthScoreStrategy_PicksHealthiestNode(t *testing.T) {
+	strategy := NewHealthScoreStrategy(logr.Discard(), HealthScoreWeights{}, nil)
+
+	// The hot node has the most free GPUs, but runs near its thermal limit
+	nodes := []corev1.Node{createHotNode("hot", 8, "85"), createHotNode("cool", 4, "45")}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "cool" {
+		t.Errorf("Expected cool to be selected, got %s", selected.Name)
+	}
+
+	// Pressure and recent failures count against a node just the same
+	pressured := createHotNode("pressured", 4, "45")
+	pressured.Status.Conditions = append(pressured.Status.Conditions, corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue})
+	flaky := createHotNode("flaky", 4, "45")
+	steady := createHotNode("steady", 4, "50")
+	tracker := NewReliabilityTracker()
+	recordOutcomes(tracker, "flaky", 1, 9)
+	strategy = NewHealthScoreStrategy(logr.Discard(), HealthScoreWeights{}, tracker)
+	selected, err = strategy.ChooseNode(context.Background(), []corev1.Node{pressured, flaky, steady}, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "steady" {
+		t.Errorf("Expected steady to be selected, got %s", selected.Name)
+	}
+
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(16)); err == nil {
+		t.Error("Expected an error when no node has enough GPUs")
+	}
+}
+
+func TestHealthScoreStrategy_WeightsChangeSelection(t *testing.T) {
+	// idle is hot with every GPU free; busy is cool with 3 of its 4 GPUs in use
+	nodes := []corev1.Node{createHotNode("idle", 4, "85"), createHotNode("busy", 4, "40")}
+	pods := NodePods{"busy": {createMockGPUPod("p", "busy", 3, corev1.PodRunning)}}
+
+	tests := []struct {
+		name     string
+		weights  HealthScoreWeights
+		expected string
+	}{
+		{"equal weights", HealthScoreWeights{}, "busy"},
+		{"utilization dominates", HealthScoreWeights{Utilization: 5, Temperature: 1}, "idle"},
+		{"temperature only", HealthScoreWeights{Temperature: 1}, "busy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := Factory("healthScore", logr.Discard(), Options{HealthScore: HealthScoreOptions{Weights: tt.weights}})
+			if err != nil {
+				t.Fatalf("Factory() error = %v", err)
+			}
+			selected, err := strategy.ChooseNode(context.Background(), nodes, pods, createMockGPUWorkload(1))
+			if err != nil {
+				t.Fatalf("ChooseNode() error = %v", err)
+			}
+			if selected.Name != tt.expected {
+				t.Errorf("Expected %s to be selected, got %s", tt.expected, selected.Name)
+			}
+		})
+	}
+}
+
+func TestParseHealthScoreWeights(t *testing.T) {
+	weights, err := ParseHealthScoreWeights("utilization=2, temperature=0.5,pressure=0")
+	if err != nil {
+		t.Fatalf("ParseHealthScoreWeights() error = %v", err)
+	}
+	if weights != (HealthScoreWeights{Utilization: 2, Temperature: 0.5}) {
+		t.Errorf("Unexpected weights %+v", weights)
+	}
+
+	if weights, err := ParseHealthScoreWeights(""); err != nil || weights != (HealthScoreWeights{}) {
+		t.Errorf("Expected empty weights to keep the defaults, got %+v, %v", weights, err)
+	}
+	for _, invalid := range []string{"noise=1", "utilization", "utilization=-1", "failures=x", "pressure=0"} {
+		if _, err := ParseHealthScoreWeights(invalid); err == nil {
+			t.Errorf("Expected %q to be rejected", invalid)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MIGSlicesAnnotation is the node annotation listing the MIG slices available per profile,
+// e.g. "1g.10gb=7,3g.40gb=2". Nodes with several partition layouts list every profile
+// that can currently be scheduled.
+const MIGSlicesAnnotation = "gpu-orchestrator/mig-slices"
+
+// MIGResourcePrefix prefixes the extended resources the NVIDIA device plugin advertises for
+// MIG slices with its mixed strategy, e.g. nvidia.com/mig-1g.5gb.
+const MIGResourcePrefix = "nvidia.com/mig-"
+
+// MIGResourceName returns the extended resource of a MIG profile.
+func MIGResourceName(profile string) corev1.ResourceName {
+	return corev1.ResourceName(MIGResourcePrefix + profile)
+}
+
+// GetMIGSlices returns the MIG slices of the node by profile: those listed in its slices
+// annotation, and the allocatable MIG resources of profiles the annotation does not list.
+// Malformed entries are ignored.
+func GetMIGSlices(node *corev1.Node) map[string]int64 {
+	slices := annotatedMIGSlices(node)
+	for name, quantity := range node.Status.Allocatable {
+		profile, ok := strings.CutPrefix(string(name), MIGResourcePrefix)
+		if !ok || profile == "" {
+			continue
+		}
+		if _, listed := slices[profile]; !listed && quantity.Value() > 0 {
+			slices[profile] = quantity.Value()
+		}
+	}
+	return slices
+}
+
+// AvailableMIGSlices returns the free slices of a MIG profile on the node. The slices
+// annotation already reports free slices; allocatable MIG resources are reduced by what the
+// node's pods request.
+func AvailableMIGSlices(node *corev1.Node, pods []corev1.Pod, profile string) int64 {
+	if slices, ok := annotatedMIGSlices(node)[profile]; ok {
+		return slices
+	}
+	name := MIGResourceName(profile)
+	allocatable := node.Status.Allocatable[name]
+	free := allocatable.Value() - podRequests(pods, func(container *corev1.Container) int64 {
+		return containerResource(container, name)
+	})
+	if free < 0 {
+		return 0
+	}
+	return free
+}
+
+// annotatedMIGSlices parses the node's MIG slices annotation into a map of profile to
+// available slices. Malformed entries are ignored.
+func annotatedMIGSlices(node *corev1.Node) map[string]int64 {
+	slices := map[string]int64{}
+	if node.Annotations == nil {
+		return slices
+	}
+
+	for _, entry := range strings.Split(node.Annotations[MIGSlicesAnnotation], ",") {
+		profile, countStr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || profile == "" {
+			continue
+		}
+		count, err := strconv.ParseInt(countStr, 10, 64)
+		if err != nil || count < 0 {
+			continue
+		}
+		slices[profile] = count
+	}
+	return slices
+}
+
+// MIGPartitionStrategy places workloads requesting a MIG profile on the node whose
+// partition layout offers that profile. Among fitting nodes it picks the one with the
+// fewest spare slices of the profile, keeping larger partition sets free.
+type MIGPartitionStrategy struct {
+	logger logr.Logger
+}
+
+var _ Strategy = &MIGPartitionStrategy{}
+
+// NewMIGPartitionStrategy creates a new MIGPartitionStrategy.
+func NewMIGPartitionStrategy(logger logr.Logger) *MIGPartitionStrategy {
+	return &MIGPartitionStrategy{logger: logger}
+}
+
+// ChooseNode selects the best-fitting node offering the workload's MIG profile.
+func (s *MIGPartitionStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+
+	profile := gw.Spec.MIGProfile
+	if profile == "" {
+		return nil, fmt.Errorf("migPartition strategy requires spec.migProfile to be set")
+	}
+
+	// Fewer spare slices score higher
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		available := AvailableMIGSlices(node, pods[node.Name], profile)
+		return []int64{int64(gw.RequestedGPUCount()) - available}, available >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionMIGProfileUnavailable, "no node offers %d slices of MIG profile %s", gw.RequestedGPUCount(), profile)
+	}
+
+	s.logger.Info("Selected node using MIGPartitionStrategy", "node", bestNode.Name, "profile", profile, "spareSlices", -score[0])
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *MIGPartitionStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *MIGPartitionStrategy) Name() string {
+	return "migPartition"
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func createMockMIGNode(name, slices string) corev1.Node {
+	node := createMockNode(name, 0)
+	node.Annotations = map[string]string{MIGSlicesAnnotation: slices}
+	return node
+}
+
+// createMockAllocatableMIGNode creates a node whose device plugin advertises MIG slices as
+// allocatable resources, such as nvidia.com/mig-1g.5gb, rather than whole GPUs.
+func createMockAllocatableMIGNode(name string, slices map[string]int64) corev1.Node {
+	node := createMockNode(name, 0)
+	for profile, count := range slices {
+		quantity := *resource.NewQuantity(count, resource.DecimalSI)
+		node.Status.Capacity[MIGResourceName(profile)] = quantity
+		node.Status.Allocatable[MIGResourceName(profile)] = quantity
+	}
+	return node
+}
+
+// createMockMIGPod creates a pod on the node requesting slices of a MIG profile.
+func createMockMIGPod(name, nodeName, profile string, slices int64) corev1.Pod {
+	pod := createMockGPUPod(name, nodeName, 0, corev1.PodRunning)
+	pod.Spec.Containers[0].Resources = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{MIGResourceName(profile): *resource.NewQuantity(slices, resource.DecimalSI)},
+	}
+	return pod
+}
+
+func TestGetMIGSlices(t *testing.T) {
+	node := createMockMIGNode("h100", "1g.10gb=7, 3g.40gb=2,bogus,4g.40gb=x")
+	slices := GetMIGSlices(&node)
+
+	if slices["1g.10gb"] != 7 || slices["3g.40gb"] != 2 {
+		t.Errorf("Unexpected MIG slices: %v", slices)
+	}
+	if len(slices) != 2 {
+		t.Errorf("Expected malformed entries to be ignored, got %v", slices)
+	}
+}
+
+func TestMIGPartitionStrategy_PicksNodeOfferingProfile(t *testing.T) {
+	strategy := NewMIGPartitionStrategy(logr.Discard())
+
+	nodes := []corev1.Node{
+		createMockMIGNode("small-slices", "1g.10gb=7"),
+		createMockMIGNode("mixed", "1g.10gb=4,3g.40gb=1"),
+		createMockMIGNode("large-slices", "3g.40gb=2,7g.80gb=1"),
+	}
+
+	workload := createMockGPUWorkload(1)
+	workload.Spec.MIGProfile = "3g.40gb"
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	// Both mixed and large-slices offer 3g.40gb; mixed is the tighter fit
+	if selected.Name != "mixed" {
+		t.Errorf("Expected mixed (best fit for 3g.40gb), got %s", selected.Name)
+	}
+
+	workload.Spec.GPUCount = 2
+	selected, err = strategy.ChooseNode(context.Background(), nodes, nil, workload)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "large-slices" {
+		t.Errorf("Expected large-slices (only node with two 3g.40gb slices), got %s", selected.Name)
+	}
+}
+
+func TestMIGPartitionStrategy_NoMatchingProfile(t *testing.T) {
+	strategy := NewMIGPartitionStrategy(logr.Discard())
+
+	nodes := []corev1.Node{createMockMIGNode("small-slices", "1g.10gb=7")}
+	workload := createMockGPUWorkload(1)
+	workload.Spec.MIGProfile = "7g.80gb"
+
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, workload); err == nil {
+		t.Error("Expected error when no node offers the MIG profile")
+	}
+
+	workload.Spec.MIGProfile = ""
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, workload); err == nil {
+		t.Error("Expected error when the workload has no MIG profile")
+	}
+}
+
+func TestGetMIGSlices_ReadsAllocatableResources(t *testing.T) {
+	node := createMockAllocatableMIGNode("a100", map[string]int64{"1g.5gb": 7, "3g.20gb": 0})
+	// The annotation, which reports free slices, wins for the profiles it lists
+	node.Annotations = map[string]string{MIGSlicesAnnotation: "2g.10gb=3"}
+	node.Status.Allocatable[MIGResourceName("2g.10gb")] = *resource.NewQuantity(1, resource.DecimalSI)
+
+	slices := GetMIGSlices(&node)
+	if len(slices) != 2 || slices["1g.5gb"] != 7 || slices["2g.10gb"] != 3 {
+		t.Errorf("Unexpected MIG slices: %v", slices)
+	}
+}
+
+func TestMIGPartitionStrategy_CountsAllocatableSlicesInUse(t *testing.T) {
+	strategy := NewMIGPartitionStrategy(logr.Discard())
+	nodes := []corev1.Node{
+		createMockAllocatableMIGNode("busy", map[string]int64{"1g.5gb": 7}),
+		createMockAllocatableMIGNode("spare", map[string]int64{"1g.5gb": 4}),
+	}
+	pods := GroupPodsByNode([]corev1.Pod{
+		createMockMIGPod("inference-a", "busy", "1g.5gb", 3),
+		createMockMIGPod("inference-b", "busy", "1g.5gb", 2),
+		createMockGPUPod("finished", "spare", 0, corev1.PodSucceeded),
+	}, nodes)
+
+	workload := createMockGPUWorkload(2)
+	workload.Spec.MIGProfile = "1g.5gb"
+
+	// busy has 2 free slices, the tighter fit
+	selected, err := strategy.ChooseNode(context.Background(), nodes, pods, workload)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "busy" {
+		t.Errorf("Expected busy (2 free slices), got %s", selected.Name)
+	}
+
+	// Only spare still has 3 free slices
+	workload.Spec.GPUCount = 3
+	selected, err = strategy.ChooseNode(context.Background(), nodes, pods, workload)
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "spare" {
+		t.Errorf("Expected spare, got %s", selected.Name)
+	}
+
+	// Replicas book their slices, so two replicas of 3 slices do not fit
+	if _, err := strategy.ChooseNodes(context.Background(), nodes, pods, workload, 2); err == nil {
+		t.Error("Expected an error when the replicas' slices do not fit")
+	}
+}
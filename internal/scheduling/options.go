@@ -0,0 +1,118 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheapNodeLabel is the node label CostOptimizedStrategy looks for by default.
+const CheapNodeLabel = "gpu-orchestrator/cheap-node"
+
+// Options holds the per-strategy configuration passed through Factory. The zero value
+// keeps every strategy's default behavior.
+type Options struct {
+	// Capacity reports the GPUs available on each node to every strategy. Defaults to
+	// AllocatableCapacity.
+	Capacity CapacityProvider
+
+	// CostOptimized configures the costOptimized strategy.
+	CostOptimized CostOptimizedOptions
+
+	// NUMAAware configures the numaAware strategy.
+	NUMAAware NUMAAwareOptions
+
+	// RequestRateBalance configures the requestRateBalance strategy.
+	RequestRateBalance RequestRateBalanceOptions
+
+	// ReliabilityWeighted configures the reliabilityWeighted strategy. Its tracker also
+	// feeds the failures signal of the healthScore strategy.
+	ReliabilityWeighted ReliabilityWeightedOptions
+
+	// HealthScore configures the healthScore strategy.
+	HealthScore HealthScoreOptions
+}
+
+// CostOptimizedOptions configures CostOptimizedStrategy.
+type CostOptimizedOptions struct {
+	// LabelKey is the node label marking cost-optimized nodes. Defaults to CheapNodeLabel.
+	LabelKey string
+
+	// LabelValue is the value LabelKey must have. Defaults to "true".
+	LabelValue string
+}
+
+func (o CostOptimizedOptions) withDefaults() CostOptimizedOptions {
+	if o.LabelKey == "" {
+		o.LabelKey = CheapNodeLabel
+	}
+	if o.LabelValue == "" {
+		o.LabelValue = "true"
+	}
+	return o
+}
+
+// ParseCostOptimizedLabel parses a "key=value" node label for CostOptimizedOptions.
+// A bare key matches the value "true"; an empty string keeps the defaults.
+func ParseCostOptimizedLabel(label string) (CostOptimizedOptions, error) {
+	key, value, _ := strings.Cut(strings.TrimSpace(label), "=")
+	if key == "" && value != "" {
+		return CostOptimizedOptions{}, fmt.Errorf("label %q has no key", label)
+	}
+	return CostOptimizedOptions{LabelKey: key, LabelValue: value}, nil
+}
+
+// NUMAAwareOptions configures NUMAAwareStrategy.
+type NUMAAwareOptions struct {
+	// GPUsPerNUMALabel is the node label holding the GPUs per NUMA node. Defaults to NUMAGPUsPerNodeLabel.
+	GPUsPerNUMALabel string
+
+	// TopologyPolicyLabel is the node label holding the topology manager policy.
+	// Defaults to TopologyManagerPolicyLabel.
+	TopologyPolicyLabel string
+}
+
+func (o NUMAAwareOptions) withDefaults() NUMAAwareOptions {
+	if o.GPUsPerNUMALabel == "" {
+		o.GPUsPerNUMALabel = NUMAGPUsPerNodeLabel
+	}
+	if o.TopologyPolicyLabel == "" {
+		o.TopologyPolicyLabel = TopologyManagerPolicyLabel
+	}
+	return o
+}
+
+// RequestRateBalanceOptions configures RequestRateBalanceStrategy.
+type RequestRateBalanceOptions struct {
+	// Source reports the requests per second served by each node. Without one the strategy
+	// behaves like leastLoaded.
+	Source RequestRateSource
+}
+
+// ReliabilityWeightedOptions configures ReliabilityWeightedStrategy.
+type ReliabilityWeightedOptions struct {
+	// Tracker holds the outcomes of the workloads placed on each node. Without one the
+	// strategy behaves like leastLoaded.
+	Tracker *ReliabilityTracker
+}
+
+// HealthScoreOptions configures HealthScoreStrategy.
+type HealthScoreOptions struct {
+	// Weights weighs the health signals. The zero value weighs every signal equally.
+	Weights HealthScoreWeights
+}
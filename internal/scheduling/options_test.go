@@ -0,0 +1,103 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCostOptimizedStrategy_CustomLabel(t *testing.T) {
+	opts := Options{CostOptimized: CostOptimizedOptions{LabelKey: "node.example.com/lifecycle", LabelValue: "spot"}}
+	strategy, err := Factory("costOptimized", logr.Discard(), opts)
+	if err != nil {
+		t.Fatalf("Factory() error = %v", err)
+	}
+
+	// The default label no longer marks a node as cheap
+	defaultLabeled := createMockNode("default-labeled", 8)
+	defaultLabeled.Labels = map[string]string{CheapNodeLabel: "true"}
+
+	spot := createMockNode("spot", 2)
+	spot.Labels = map[string]string{"node.example.com/lifecycle": "spot"}
+
+	selected, err := strategy.ChooseNode(context.Background(), []corev1.Node{defaultLabeled, spot}, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "spot" {
+		t.Errorf("Expected spot to be selected, got %s", selected.Name)
+	}
+}
+
+func TestNUMAAwareStrategy_CustomLabels(t *testing.T) {
+	strategy := NewNUMAAwareStrategy(logr.Discard(), NUMAAwareOptions{
+		GPUsPerNUMALabel:    "hw.example.com/gpus-per-numa",
+		TopologyPolicyLabel: "hw.example.com/topology-policy",
+	})
+
+	// Labeled with the default keys, which are ignored
+	defaultLabeled := createMockNode("default-labeled", 8)
+	defaultLabeled.Labels = map[string]string{NUMAGPUsPerNodeLabel: "4"}
+
+	aligned := createMockNode("aligned", 4)
+	aligned.Labels = map[string]string{
+		"hw.example.com/gpus-per-numa":   "4",
+		"hw.example.com/topology-policy": "single-numa-node",
+	}
+
+	selected, err := strategy.ChooseNode(context.Background(), []corev1.Node{defaultLabeled, aligned}, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "aligned" {
+		t.Errorf("Expected aligned to be selected, got %s", selected.Name)
+	}
+}
+
+func TestParseCostOptimizedLabel(t *testing.T) {
+	tests := []struct {
+		label     string
+		wantKey   string
+		wantValue string
+		wantErr   bool
+	}{
+		{"", CheapNodeLabel, "true", false},
+		{"example.com/spot", "example.com/spot", "true", false},
+		{"example.com/tier=economy", "example.com/tier", "economy", false},
+		{"=economy", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			opts, err := ParseCostOptimizedLabel(tt.label)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCostOptimizedLabel(%q) error = %v, wantErr %v", tt.label, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			opts = opts.withDefaults()
+			if opts.LabelKey != tt.wantKey || opts.LabelValue != tt.wantValue {
+				t.Errorf("ParseCostOptimizedLabel(%q) = %s=%s, want %s=%s", tt.label, opts.LabelKey, opts.LabelValue, tt.wantKey, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"math"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// OvercommitRatioAnnotation is the node annotation overriding the global GPU over-commit
+// ratio for that node, e.g. "2" to advertise twice its allocatable GPUs.
+const OvercommitRatioAnnotation = "gpu-orchestrator/gpu-overcommit-ratio"
+
+const (
+	// MinOvercommitRatio is the lowest accepted over-commit ratio; it disables over-commit.
+	MinOvercommitRatio = 1.0
+
+	// MaxOvercommitRatio is the highest accepted over-commit ratio.
+	MaxOvercommitRatio = 10.0
+)
+
+// defaultOvercommitRatio applies to nodes without an over-commit annotation.
+var defaultOvercommitRatio = MinOvercommitRatio
+
+// ClampOvercommitRatio bounds a ratio to [MinOvercommitRatio, MaxOvercommitRatio].
+// NaN is treated as no over-commit.
+func ClampOvercommitRatio(ratio float64) float64 {
+	switch {
+	case math.IsNaN(ratio) || ratio < MinOvercommitRatio:
+		return MinOvercommitRatio
+	case ratio > MaxOvercommitRatio:
+		return MaxOvercommitRatio
+	}
+	return ratio
+}
+
+// ConfigureOvercommit sets the global GPU over-commit ratio and returns the clamped value
+// in effect. It must be called during startup, before any workloads are scheduled.
+func ConfigureOvercommit(ratio float64) float64 {
+	defaultOvercommitRatio = ClampOvercommitRatio(ratio)
+	return defaultOvercommitRatio
+}
+
+// OvercommitRatio returns the over-commit ratio applied to a node: its annotation when
+// valid, otherwise the global ratio.
+func OvercommitRatio(node *corev1.Node) float64 {
+	if value, ok := node.Annotations[OvercommitRatioAnnotation]; ok {
+		if ratio, err := strconv.ParseFloat(value, 64); err == nil {
+			return ClampOvercommitRatio(ratio)
+		}
+	}
+	return defaultOvercommitRatio
+}
+
+// ApplyOvercommit rewrites a node's GPU resources to their over-committed values and pins
+// its ratio to 1, so callers can subtract allocations from the effective capacity.
+func ApplyOvercommit(node *corev1.Node) {
+	ratio := OvercommitRatio(node)
+	if ratio == MinOvercommitRatio {
+		return
+	}
+
+	for _, vendor := range DefaultVendorPreference {
+		name, _ := VendorResourceName(vendor)
+		for _, resources := range []corev1.ResourceList{node.Status.Allocatable, node.Status.Capacity} {
+			if quantity, ok := resources[name]; ok {
+				resources[name] = *resource.NewQuantity(overcommitted(quantity.Value(), ratio), resource.DecimalSI)
+			}
+		}
+	}
+	if gpuLabel, ok := node.Labels["nvidia.com/gpu"]; ok {
+		if count, err := strconv.ParseInt(gpuLabel, 10, 64); err == nil {
+			node.Labels["nvidia.com/gpu"] = strconv.FormatInt(overcommitted(count, ratio), 10)
+		}
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[OvercommitRatioAnnotation] = "1"
+}
+
+// overcommitted scales a GPU count by the ratio, rounding down.
+func overcommitted(gpus int64, ratio float64) int64 {
+	return int64(math.Floor(float64(gpus) * ratio))
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"math"
+	"testing"
+)
+
+func TestClampOvercommitRatio(t *testing.T) {
+	tests := []struct {
+		ratio float64
+		want  float64
+	}{
+		{0.5, MinOvercommitRatio},
+		{1, 1},
+		{2.5, 2.5},
+		{50, MaxOvercommitRatio},
+		{math.NaN(), MinOvercommitRatio},
+	}
+	for _, tt := range tests {
+		if got := ClampOvercommitRatio(tt.ratio); got != tt.want {
+			t.Errorf("ClampOvercommitRatio(%v) = %v, want %v", tt.ratio, got, tt.want)
+		}
+	}
+}
+
+func TestAvailableGPUs_AppliesOvercommitRatio(t *testing.T) {
+	defer ConfigureOvercommit(MinOvercommitRatio)
+
+	node := createVendorNode("node1", "nvidia.com/gpu", 4)
+	if got := AvailableGPUs(&node); got != 4 {
+		t.Errorf("Expected 4 GPUs without over-commit, got %d", got)
+	}
+
+	if ratio := ConfigureOvercommit(1.5); ratio != 1.5 {
+		t.Fatalf("Expected ratio 1.5 in effect, got %v", ratio)
+	}
+	if got := AvailableGPUs(&node); got != 6 {
+		t.Errorf("Expected 6 GPUs with a global ratio of 1.5, got %d", got)
+	}
+
+	node.Annotations = map[string]string{OvercommitRatioAnnotation: "2"}
+	if got := AvailableGPUs(&node); got != 8 {
+		t.Errorf("Expected the node annotation to override the global ratio, got %d", got)
+	}
+
+	node.Annotations[OvercommitRatioAnnotation] = "100"
+	if got := AvailableGPUs(&node); got != 40 {
+		t.Errorf("Expected the annotation to be clamped to %v, got %d GPUs", MaxOvercommitRatio, got)
+	}
+
+	node.Annotations[OvercommitRatioAnnotation] = "lots"
+	if got := AvailableGPUs(&node); got != 6 {
+		t.Errorf("Expected an invalid annotation to fall back to the global ratio, got %d", got)
+	}
+}
+
+func TestApplyOvercommit(t *testing.T) {
+	node := createVendorNode("node1", "nvidia.com/gpu", 3)
+	node.Annotations = map[string]string{OvercommitRatioAnnotation: "2"}
+
+	ApplyOvercommit(&node)
+	if got := VendorGPUs(&node, VendorNVIDIA); got != 6 {
+		t.Errorf("Expected allocatable to be rewritten to 6, got %d", got)
+	}
+	if got := AvailableGPUs(&node); got != 6 {
+		t.Errorf("Expected the ratio not to be applied twice, got %d", got)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NodePods holds the pods bound to each node, keyed by node name. The GPUs their containers
+// request are taken off the node's capacity. A nil NodePods accounts for no pods.
+type NodePods map[string][]corev1.Pod
+
+// GroupPodsByNode returns the pods bound to the named nodes, keyed by node name.
+func GroupPodsByNode(pods []corev1.Pod, nodes []corev1.Node) NodePods {
+	grouped := make(NodePods, len(nodes))
+	for i := range nodes {
+		grouped[nodes[i].Name] = nil
+	}
+	for _, pod := range pods {
+		if _, ok := grouped[pod.Spec.NodeName]; ok {
+			grouped[pod.Spec.NodeName] = append(grouped[pod.Spec.NodeName], pod)
+		}
+	}
+	return grouped
+}
+
+// Capacity returns a CapacityProvider reporting the GPUs of capacity left on each node once
+// its pods' GPU requests are subtracted, floored at zero.
+func (p NodePods) Capacity(capacity CapacityProvider) CapacityProvider {
+	capacity = capacityOrDefault(capacity)
+	if len(p) == 0 {
+		return capacity
+	}
+	return CapacityFunc(func(node *corev1.Node) int64 {
+		free := capacity.AvailableGPUs(node) - PodGPURequests(p[node.Name])
+		if free < 0 {
+			return 0
+		}
+		return free
+	})
+}
+
+// PodGPURequests returns the GPUs held by the pods: the GPU requests, of any supported
+// vendor, of every pod that has not succeeded or failed. As for the scheduler, a pod holds
+// the larger of its containers' total and its largest init container request.
+func PodGPURequests(pods []corev1.Pod) int64 {
+	return podRequests(pods, containerGPUs)
+}
+
+// podRequests sums what the pods holding resources request, as counted per container by
+// count. A pod requests the larger of its containers' sum and its largest init container.
+func podRequests(pods []corev1.Pod, count func(container *corev1.Container) int64) int64 {
+	var total int64
+	for i := range pods {
+		if !HoldsResources(&pods[i]) {
+			continue
+		}
+		var containers, initContainers int64
+		for _, container := range pods[i].Spec.Containers {
+			containers += count(&container)
+		}
+		for _, container := range pods[i].Spec.InitContainers {
+			if requested := count(&container); requested > initContainers {
+				initContainers = requested
+			}
+		}
+		if initContainers > containers {
+			containers = initContainers
+		}
+		total += containers
+	}
+	return total
+}
+
+// HoldsResources reports whether a pod still holds the resources it requested on its node.
+func HoldsResources(pod *corev1.Pod) bool {
+	return pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+// containerGPUs returns the GPUs a container requests, of any vendor.
+func containerGPUs(container *corev1.Container) int64 {
+	var gpus int64
+	for _, vendor := range DefaultVendorPreference {
+		name, _ := VendorResourceName(vendor)
+		gpus += containerResource(container, name)
+	}
+	return gpus
+}
+
+// containerResource returns how much of the named extended resource a container requests.
+// Extended resources may be given as limits only, in which case the request equals the limit.
+func containerResource(container *corev1.Container, name corev1.ResourceName) int64 {
+	quantity, ok := container.Resources.Requests[name]
+	if !ok {
+		quantity, ok = container.Resources.Limits[name]
+	}
+	if !ok {
+		return 0
+	}
+	return quantity.Value()
+}
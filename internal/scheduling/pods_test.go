@@ -0,0 +1,114 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// createMockGPUPod returns a pod bound to nodeName whose container requests gpus NVIDIA GPUs.
+func createMockGPUPod(name, nodeName string, gpus int64, phase corev1.PodPhase) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{"nvidia.com/gpu": *resource.NewQuantity(gpus, resource.DecimalSI)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestPodGPURequests(t *testing.T) {
+	limitsOnly := createMockGPUPod("limits-only", "node1", 0, corev1.PodRunning)
+	limitsOnly.Spec.Containers[0].Resources = corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{"amd.com/gpu": *resource.NewQuantity(2, resource.DecimalSI)},
+	}
+	initHeavy := createMockGPUPod("init-heavy", "node1", 1, corev1.PodPending)
+	initHeavy.Spec.InitContainers = []corev1.Container{{
+		Name: "warm-cache",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{"nvidia.com/gpu": *resource.NewQuantity(3, resource.DecimalSI)},
+		},
+	}}
+
+	pods := []corev1.Pod{
+		createMockGPUPod("running", "node1", 2, corev1.PodRunning),
+		createMockGPUPod("pending", "node1", 1, corev1.PodPending),
+		createMockGPUPod("succeeded", "node1", 4, corev1.PodSucceeded),
+		createMockGPUPod("failed", "node1", 4, corev1.PodFailed),
+		limitsOnly,
+		initHeavy,
+	}
+
+	// 2 running + 1 pending + 2 from limits + 3 from the largest init container
+	if got := PodGPURequests(pods); got != 8 {
+		t.Errorf("PodGPURequests() = %d, want 8", got)
+	}
+}
+
+func TestNodePodsCapacity_SubtractsPodRequests(t *testing.T) {
+	nodes := []corev1.Node{createMockNode("node1", 4), createMockNode("node2", 4)}
+	pods := GroupPodsByNode([]corev1.Pod{
+		createMockGPUPod("a", "node1", 3, corev1.PodRunning),
+		createMockGPUPod("b", "node2", 6, corev1.PodRunning),
+		createMockGPUPod("elsewhere", "node3", 1, corev1.PodRunning),
+	}, nodes)
+
+	capacity := pods.Capacity(nil)
+	if got := capacity.AvailableGPUs(&nodes[0]); got != 1 {
+		t.Errorf("Expected 1 free GPU on node1, got %d", got)
+	}
+	if got := capacity.AvailableGPUs(&nodes[1]); got != 0 {
+		t.Errorf("Expected an oversubscribed node to report 0 free GPUs, got %d", got)
+	}
+	if _, ok := pods["node3"]; ok {
+		t.Error("Expected pods of other nodes to be left out")
+	}
+}
+
+func TestStrategies_AccountForPodsOnNodes(t *testing.T) {
+	// node1 advertises the most GPUs, but a running pod already holds most of them
+	nodes := []corev1.Node{createMockNode("node1", 8), createMockNode("node2", 4)}
+	pods := GroupPodsByNode([]corev1.Pod{createMockGPUPod("busy", "node1", 7, corev1.PodRunning)}, nodes)
+
+	for _, name := range []string{"leastLoaded", "binPack", "random", "costOptimized", "numaAware"} {
+		t.Run(name, func(t *testing.T) {
+			strategy, err := Factory(name, logr.Discard(), Options{})
+			if err != nil {
+				t.Fatalf("Factory() error = %v", err)
+			}
+			selected, err := strategy.ChooseNode(context.Background(), nodes, pods, createMockGPUWorkload(2))
+			if err != nil {
+				t.Fatalf("ChooseNode() error = %v", err)
+			}
+			if selected.Name != "node2" {
+				t.Errorf("Expected node2, the only node with 2 free GPUs, got %s", selected.Name)
+			}
+		})
+	}
+}
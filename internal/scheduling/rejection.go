@@ -0,0 +1,140 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"errors"
+	"fmt"
+
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RejectionReason is a machine-readable reason a node cannot host a workload.
+type RejectionReason string
+
+const (
+	// RejectionNoGPUs means the node advertises no GPUs at all.
+	RejectionNoGPUs RejectionReason = "no_gpus"
+
+	// RejectionNotReady means the node's Ready condition is not true.
+	RejectionNotReady RejectionReason = "not_ready"
+
+	// RejectionUnderPressure means the node reports memory, disk or PID pressure, so new pods
+	// risk being evicted.
+	RejectionUnderPressure RejectionReason = "under_pressure"
+
+	// RejectionCordoned means the node is marked unschedulable.
+	RejectionCordoned RejectionReason = "cordoned"
+
+	// RejectionControlPlane means the node is a control-plane node and those are excluded.
+	RejectionControlPlane RejectionReason = "control_plane"
+
+	// RejectionTainted means the node carries a taint that would evict or block the workload.
+	RejectionTainted RejectionReason = "tainted"
+
+	// RejectionNodeSelectorMismatch means the node lacks a label of the workload's node selector.
+	RejectionNodeSelectorMismatch RejectionReason = "node_selector_mismatch"
+
+	// RejectionWrongVendor means the node has no GPUs of the workload's vendor.
+	RejectionWrongVendor RejectionReason = "wrong_vendor"
+
+	// RejectionInsufficientGPUs means the node has fewer available GPUs than requested.
+	RejectionInsufficientGPUs RejectionReason = "insufficient_gpus"
+
+	// RejectionInsufficientGPUMemory means the node's GPUs have less memory than the workload's minimum.
+	RejectionInsufficientGPUMemory RejectionReason = "insufficient_gpu_memory"
+
+	// RejectionInsufficientResources means the node cannot hold the pod's CPU or memory
+	// requests, including its RuntimeClass overhead.
+	RejectionInsufficientResources RejectionReason = "insufficient_resources"
+
+	// RejectionMIGProfileUnavailable means the node offers too few slices of the requested MIG profile.
+	RejectionMIGProfileUnavailable RejectionReason = "mig_profile_unavailable"
+)
+
+// ErrNoNodes is returned by strategies given no nodes to choose from.
+var ErrNoNodes = errors.New("no suitable nodes available for GPU workload")
+
+// Scheduling failure reasons, distinguishing a cluster without GPU nodes to choose from,
+// which may need nodes added, from one whose GPU nodes are all full, which may free up soon.
+const (
+	// FailureNoGPUNodes means there were no GPU nodes to place the workload on.
+	FailureNoGPUNodes = "no_gpu_nodes"
+
+	// FailureAllNodesFull means GPU nodes exist but none has room for the workload.
+	FailureAllNodesFull = "all_nodes_full"
+)
+
+// FailureReason classifies an error returned by a strategy: FailureNoGPUNodes when it had
+// no nodes to choose from and FailureAllNodesFull when every node was rejected for lack of
+// capacity. It returns false for any other error.
+func FailureReason(err error) (string, bool) {
+	var schedErr *SchedulingError
+	switch {
+	case errors.Is(err, ErrNoNodes):
+		return FailureNoGPUNodes, true
+	case errors.As(err, &schedErr):
+		return FailureAllNodesFull, true
+	}
+	return "", false
+}
+
+// SchedulingError is returned by strategies when no node fits a workload. Reason is the
+// rejection that applied to the candidates.
+type SchedulingError struct {
+	Reason  RejectionReason
+	Message string
+}
+
+// Error implements the error interface.
+func (e *SchedulingError) Error() string {
+	return e.Message
+}
+
+func newSchedulingError(reason RejectionReason, format string, args ...interface{}) *SchedulingError {
+	return &SchedulingError{Reason: reason, Message: fmt.Sprintf(format, args...)}
+}
+
+// CapacityRejection reports why a schedulable GPU node cannot fit the workload's GPU request,
+// with a short detail such as "2 available, 4 requested". Available GPUs are read from
+// capacity, or node allocatable when it is nil. It returns false when the node fits.
+func CapacityRejection(node *corev1.Node, gw *gpuv1alpha1.GPUWorkload, capacity CapacityProvider) (RejectionReason, string, bool) {
+	requested := int64(gw.RequestedGPUCount())
+
+	// Workloads with a MIG profile default to the migPartition strategy
+	if gw.Spec.MIGProfile != "" && (gw.Spec.SchedulingStrategy == "" || gw.Spec.SchedulingStrategy == "migPartition") {
+		available := GetMIGSlices(node)[gw.Spec.MIGProfile]
+		if available < requested {
+			return RejectionMIGProfileUnavailable, fmt.Sprintf("%d %s slices available, %d requested", available, gw.Spec.MIGProfile, requested), true
+		}
+		return "", "", false
+	}
+
+	// Nodes carry a single GPU vendor, so the capacity is that of the pinned vendor when it matches
+	if vendor := gw.Spec.GPUVendor; vendor != "" && vendor != VendorAuto && VendorGPUs(node, vendor) == 0 {
+		return RejectionWrongVendor, fmt.Sprintf("no %s GPUs", vendor), true
+	}
+	if !meetsGPUMemory(node, gw) {
+		return RejectionInsufficientGPUMemory, fmt.Sprintf("%dGB per GPU, %dGB required", getNodeGPUMemoryGB(node), gw.Spec.MinGPUMemoryGB), true
+	}
+	available := capacityOrDefault(capacity).AvailableGPUs(node)
+	if available < requested {
+		return RejectionInsufficientGPUs, fmt.Sprintf("%d available, %d requested", available, requested), true
+	}
+	return "", "", false
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCapacityRejection(t *testing.T) {
+	nvidia := createVendorNode("nvidia-node", "nvidia.com/gpu", 2)
+	migNode := createVendorNode("mig-node", "nvidia.com/gpu", 1)
+	migNode.Annotations = map[string]string{MIGSlicesAnnotation: "1g.10gb=3"}
+
+	tests := []struct {
+		name   string
+		node   corev1.Node
+		spec   gpuv1alpha1.GPUWorkloadSpec
+		reason RejectionReason
+		detail string
+	}{
+		{"fits", nvidia, gpuv1alpha1.GPUWorkloadSpec{GPUCount: 2}, "", ""},
+		{"insufficient", nvidia, gpuv1alpha1.GPUWorkloadSpec{GPUCount: 4}, RejectionInsufficientGPUs, "2 available, 4 requested"},
+		{"gpu memory", nvidia, gpuv1alpha1.GPUWorkloadSpec{GPUCount: 1, MinGPUMemoryGB: 40}, RejectionInsufficientGPUMemory, "0GB per GPU, 40GB required"},
+		{"wrong vendor", nvidia, gpuv1alpha1.GPUWorkloadSpec{GPUCount: 1, GPUVendor: VendorAMD}, RejectionWrongVendor, "no amd GPUs"},
+		{"mig fits", migNode, gpuv1alpha1.GPUWorkloadSpec{GPUCount: 3, MIGProfile: "1g.10gb"}, "", ""},
+		{"mig short", migNode, gpuv1alpha1.GPUWorkloadSpec{GPUCount: 4, MIGProfile: "1g.10gb"}, RejectionMIGProfileUnavailable, "3 1g.10gb slices available, 4 requested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gw := &gpuv1alpha1.GPUWorkload{Spec: tt.spec}
+			reason, detail, rejected := CapacityRejection(&tt.node, gw, nil)
+			if rejected != (tt.reason != "") || reason != tt.reason || detail != tt.detail {
+				t.Errorf("CapacityRejection() = (%q, %q, %v), want (%q, %q)", reason, detail, rejected, tt.reason, tt.detail)
+			}
+		})
+	}
+}
+
+func TestStrategiesReturnSchedulingError(t *testing.T) {
+	nodes := []corev1.Node{createVendorNode("node1", "nvidia.com/gpu", 1)}
+	gw := &gpuv1alpha1.GPUWorkload{Spec: gpuv1alpha1.GPUWorkloadSpec{GPUCount: 4}}
+
+	for _, name := range StrategyNames {
+		if name == "migPartition" {
+			continue
+		}
+		strategy, _ := Factory(name, logr.Discard(), Options{})
+		_, err := strategy.ChooseNode(context.Background(), nodes, nil, gw)
+
+		var schedErr *SchedulingError
+		if !errors.As(err, &schedErr) || schedErr.Reason != RejectionInsufficientGPUs {
+			t.Errorf("%s: expected a SchedulingError with reason %s, got %v", name, RejectionInsufficientGPUs, err)
+		}
+	}
+}
+
+func TestFailureReason(t *testing.T) {
+	strategy := NewLeastLoadedStrategy(logr.Discard())
+
+	_, err := strategy.ChooseNode(context.Background(), nil, nil, createMockGPUWorkload(1))
+	if reason, ok := FailureReason(err); !ok || reason != FailureNoGPUNodes {
+		t.Errorf("Expected %s without nodes, got %q", FailureNoGPUNodes, reason)
+	}
+
+	_, err = strategy.ChooseNode(context.Background(), []corev1.Node{createMockNode("node1", 2)}, nil, createMockGPUWorkload(4))
+	if reason, ok := FailureReason(err); !ok || reason != FailureAllNodesFull {
+		t.Errorf("Expected %s when the node is too small, got %q", FailureAllNodesFull, reason)
+	}
+
+	if _, ok := FailureReason(errors.New("connection refused")); ok {
+		t.Error("Expected other errors not to be classified")
+	}
+}
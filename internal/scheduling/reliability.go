@@ -0,0 +1,172 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NodeOutcomes counts how the workloads placed on a node finished.
+type NodeOutcomes struct {
+	Succeeded int64 `json:"succeeded"`
+	Failed    int64 `json:"failed"`
+}
+
+// ReliabilityTracker counts, per node, the placed workloads that succeeded and failed. It is
+// safe for concurrent use.
+type ReliabilityTracker struct {
+	mu       sync.Mutex
+	outcomes map[string]NodeOutcomes
+}
+
+// NewReliabilityTracker creates an empty ReliabilityTracker.
+func NewReliabilityTracker() *ReliabilityTracker {
+	return &ReliabilityTracker{outcomes: map[string]NodeOutcomes{}}
+}
+
+// RecordOutcome counts a workload that finished on the node.
+func (t *ReliabilityTracker) RecordOutcome(nodeName string, succeeded bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	outcomes := t.outcomes[nodeName]
+	if succeeded {
+		outcomes.Succeeded++
+	} else {
+		outcomes.Failed++
+	}
+	t.outcomes[nodeName] = outcomes
+}
+
+// SuccessRate returns the share of the node's workloads that succeeded, smoothed so a node
+// without history rates 0.5 and a few outcomes do not swing it to either extreme.
+func (t *ReliabilityTracker) SuccessRate(nodeName string) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	outcomes := t.outcomes[nodeName]
+	return float64(outcomes.Succeeded+1) / float64(outcomes.Succeeded+outcomes.Failed+2)
+}
+
+// Load replaces the tracked outcomes with those saved at path. A missing file leaves the
+// tracker empty.
+func (t *ReliabilityTracker) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	outcomes := map[string]NodeOutcomes{}
+	if err := json.Unmarshal(data, &outcomes); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.outcomes = outcomes
+	return nil
+}
+
+// Save writes the tracked outcomes to path, replacing the file atomically.
+func (t *ReliabilityTracker) Save(path string) error {
+	t.mu.Lock()
+	data, err := json.Marshal(t.outcomes)
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ReliabilityWeightedStrategy weights each fitting node's available GPUs by the share of
+// the workloads placed on it that succeeded, so nodes that frequently produce failed pods
+// are passed over unless they offer much more room. Without a tracker every node rates the
+// same and the strategy behaves like LeastLoadedStrategy.
+type ReliabilityWeightedStrategy struct {
+	logger   logr.Logger
+	tracker  *ReliabilityTracker
+	capacity CapacityProvider
+}
+
+var _ Strategy = &ReliabilityWeightedStrategy{}
+
+// NewReliabilityWeightedStrategy creates a new ReliabilityWeightedStrategy reading outcomes from tracker.
+func NewReliabilityWeightedStrategy(logger logr.Logger, tracker *ReliabilityTracker) *ReliabilityWeightedStrategy {
+	return &ReliabilityWeightedStrategy{logger: logger, tracker: tracker, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the fitting node with the most available GPUs weighted by its success rate.
+func (s *ReliabilityWeightedStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	tracker := s.tracker
+	if tracker == nil {
+		tracker = NewReliabilityTracker()
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	// Weight in thousandths of GPUs so the score stays an integer
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		weighted := int64(math.Round(tracker.SuccessRate(node.Name) * 1000 * float64(availableGPUs)))
+		return []int64{weighted, availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	s.logger.Info("Selected node using ReliabilityWeightedStrategy", "node", bestNode.Name,
+		"successRate", tracker.SuccessRate(bestNode.Name), "availableGPUs", score[1])
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *ReliabilityWeightedStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *ReliabilityWeightedStrategy) Name() string {
+	return "reliabilityWeighted"
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// recordOutcomes records the given numbers of successes and failures on the node.
+func recordOutcomes(tracker *ReliabilityTracker, node string, succeeded, failed int) {
+	for i := 0; i < succeeded; i++ {
+		tracker.RecordOutcome(node, true)
+	}
+	for i := 0; i < failed; i++ {
+		tracker.RecordOutcome(node, false)
+	}
+}
+
+func TestReliabilityWeightedStrategy_DeprioritizesFlakyNode(t *testing.T) {
+	tracker := NewReliabilityTracker()
+	recordOutcomes(tracker, "flaky", 2, 8)
+	recordOutcomes(tracker, "reliable", 9, 1)
+	strategy := NewReliabilityWeightedStrategy(logr.Discard(), tracker)
+
+	// The flaky node sorts first by name and has the same capacity
+	nodes := []corev1.Node{createMockNode("flaky", 4), createMockNode("reliable", 4)}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "reliable" {
+		t.Errorf("Expected the reliable node to be selected, got %s", selected.Name)
+	}
+
+	// A node without history rates between the two
+	nodes = []corev1.Node{createMockNode("flaky", 4), createMockNode("new", 4)}
+	selected, err = strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "new" {
+		t.Errorf("Expected the new node to be preferred over the flaky one, got %s", selected.Name)
+	}
+}
+
+func TestReliabilityWeightedStrategy_WeighsCapacity(t *testing.T) {
+	tracker := NewReliabilityTracker()
+	recordOutcomes(tracker, "large", 1, 1)
+	recordOutcomes(tracker, "small", 8, 0)
+	strategy := NewReliabilityWeightedStrategy(logr.Discard(), tracker)
+
+	// 8 GPUs at 0.5 outweigh 2 GPUs at 0.9; a workload too big for the small node ignores it
+	nodes := []corev1.Node{createMockNode("large", 8), createMockNode("small", 2)}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "large" {
+		t.Errorf("Expected the larger node to be selected, got %s", selected.Name)
+	}
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(16)); err == nil {
+		t.Error("Expected an error when no node has enough GPUs")
+	}
+}
+
+func TestReliabilityWeightedStrategy_WithoutTrackerIsLeastLoaded(t *testing.T) {
+	strategy, err := Factory("reliabilityWeighted", logr.Discard(), Options{})
+	if err != nil {
+		t.Fatalf("Factory() error = %v", err)
+	}
+	nodes := []corev1.Node{createMockNode("node1", 2), createMockNode("node2", 8)}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "node2" {
+		t.Errorf("Expected the least loaded node2, got %s", selected.Name)
+	}
+}
+
+func TestReliabilityTracker_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reliability.json")
+	tracker := NewReliabilityTracker()
+	if err := tracker.Load(path); err != nil {
+		t.Fatalf("Load() of a missing file error = %v", err)
+	}
+	recordOutcomes(tracker, "node1", 3, 1)
+	if err := tracker.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	restored := NewReliabilityTracker()
+	if err := restored.Load(path); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got, want := restored.SuccessRate("node1"), tracker.SuccessRate("node1"); got != want {
+		t.Errorf("Expected the restored success rate %v, got %v", want, got)
+	}
+}
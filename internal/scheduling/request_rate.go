@@ -0,0 +1,210 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// RequestRateSource reports the inference requests per second currently served by each node.
+type RequestRateSource interface {
+	// NodeRequestRates returns the current requests per second keyed by node name. Nodes
+	// missing from the result are treated as serving no requests.
+	NodeRequestRates(ctx context.Context) (map[string]float64, error)
+}
+
+// RequestRateFunc adapts a function to a RequestRateSource.
+type RequestRateFunc func(ctx context.Context) (map[string]float64, error)
+
+// NodeRequestRates calls f.
+func (f RequestRateFunc) NodeRequestRates(ctx context.Context) (map[string]float64, error) {
+	return f(ctx)
+}
+
+// RequestRateBalanceStrategy places new replicas of autoscaled inference workloads on the
+// fitting node serving the fewest requests per second, so traffic rather than GPU count
+// is balanced. Nodes with equal rates are ranked by the most available GPUs. It falls back
+// to LeastLoadedStrategy when no request rates can be read.
+type RequestRateBalanceStrategy struct {
+	logger   logr.Logger
+	source   RequestRateSource
+	capacity CapacityProvider
+}
+
+var _ Strategy = &RequestRateBalanceStrategy{}
+
+// NewRequestRateBalanceStrategy creates a new RequestRateBalanceStrategy reading rates from source.
+func NewRequestRateBalanceStrategy(logger logr.Logger, source RequestRateSource) *RequestRateBalanceStrategy {
+	return &RequestRateBalanceStrategy{logger: logger, source: source, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the fitting node with the lowest request rate.
+func (s *RequestRateBalanceStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+
+	var rates map[string]float64
+	var err error
+	if s.source == nil {
+		err = fmt.Errorf("no request rate source configured")
+	} else {
+		rates, err = s.source.NodeRequestRates(ctx)
+	}
+	if err != nil {
+		s.logger.Info("Request rates unavailable, falling back to LeastLoadedStrategy", "error", err.Error())
+		fallback := &LeastLoadedStrategy{logger: s.logger, capacity: s.capacity}
+		return fallback.ChooseNode(ctx, nodes, pods, gw)
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	// Compare rates in milli-requests per second, negated so the least busy node scores highest
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		milliRPS := int64(math.Round(rates[node.Name] * 1000))
+		return []int64{-milliRPS, availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	s.logger.Info("Selected node using RequestRateBalanceStrategy", "node", bestNode.Name,
+		"requestsPerSecond", float64(-score[0])/1000, "availableGPUs", score[1])
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *RequestRateBalanceStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *RequestRateBalanceStrategy) Name() string {
+	return "requestRateBalance"
+}
+
+// DefaultRequestRateQuery is the PromQL query PrometheusRequestRates runs by default: the
+// per-node rate of inference requests over the last minute.
+const DefaultRequestRateQuery = `sum by (node) (rate(inference_requests_total[1m]))`
+
+// PrometheusRequestRates reads per-node request rates from the Prometheus HTTP API. Query
+// must return an instant vector with one sample per node, labeled with NodeLabel.
+type PrometheusRequestRates struct {
+	// Endpoint is the base URL of the Prometheus server, e.g. http://prometheus:9090.
+	Endpoint string
+
+	// Query is the PromQL query returning requests per second by node. Defaults to DefaultRequestRateQuery.
+	Query string
+
+	// NodeLabel is the series label holding the node name. Defaults to "node".
+	NodeLabel string
+
+	HTTPClient *http.Client
+}
+
+var _ RequestRateSource = &PrometheusRequestRates{}
+
+// NewPrometheusRequestRates creates a PrometheusRequestRates for the given endpoint and
+// query. An empty query uses DefaultRequestRateQuery.
+func NewPrometheusRequestRates(endpoint, query string) *PrometheusRequestRates {
+	if query == "" {
+		query = DefaultRequestRateQuery
+	}
+	return &PrometheusRequestRates{
+		Endpoint:   endpoint,
+		Query:      query,
+		NodeLabel:  "node",
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// prometheusQueryResponse is the subset of a Prometheus instant query response that is read.
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  [2]interface{}    `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// NodeRequestRates runs the query and returns its samples keyed by node name. Samples
+// without a node label or with a non-numeric value are ignored.
+func (p *PrometheusRequestRates) NodeRequestRates(ctx context.Context) (map[string]float64, error) {
+	u, err := url.Parse(p.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Prometheus endpoint: %w", err)
+	}
+	u = u.JoinPath("api", "v1", "query")
+	u.RawQuery = url.Values{"query": {p.Query}}.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result prometheusQueryResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4*1024*1024)).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decoding prometheus response (%s): %w", resp.Status, err)
+	}
+	if result.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", result.Error)
+	}
+	if result.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("prometheus query returned a %s, expected a vector", result.Data.ResultType)
+	}
+
+	nodeLabel := p.NodeLabel
+	if nodeLabel == "" {
+		nodeLabel = "node"
+	}
+	rates := make(map[string]float64, len(result.Data.Result))
+	for _, sample := range result.Data.Result {
+		node := sample.Metric[nodeLabel]
+		value, ok := sample.Value[1].(string)
+		if node == "" || !ok {
+			continue
+		}
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(rate) || rate < 0 {
+			continue
+		}
+		rates[node] += rate
+	}
+	return rates, nil
+}
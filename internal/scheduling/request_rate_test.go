@@ -0,0 +1,161 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// mockRequestRates serves fixed per-node request rates.
+func mockRequestRates(rates map[string]float64) RequestRateSource {
+	return RequestRateFunc(func(ctx context.Context) (map[string]float64, error) {
+		return rates, nil
+	})
+}
+
+func TestRequestRateBalanceStrategy_ChoosesLeastBusyNode(t *testing.T) {
+	strategy := NewRequestRateBalanceStrategy(logr.Discard(), mockRequestRates(map[string]float64{
+		"busy":   120.5,
+		"quiet":  3.2,
+		"medium": 40,
+	}))
+
+	// The busy node has the most free GPUs but serves the most traffic
+	nodes := []corev1.Node{createMockNode("busy", 8), createMockNode("quiet", 2), createMockNode("medium", 4)}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "quiet" {
+		t.Errorf("Expected quiet to be selected, got %s", selected.Name)
+	}
+}
+
+func TestRequestRateBalanceStrategy_SkipsNodesWithoutCapacity(t *testing.T) {
+	strategy := NewRequestRateBalanceStrategy(logr.Discard(), mockRequestRates(map[string]float64{
+		"quiet":  0.5,
+		"medium": 40,
+		"busy":   120,
+	}))
+	nodes := []corev1.Node{createMockNode("quiet", 1), createMockNode("medium", 4), createMockNode("busy", 8)}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "medium" {
+		t.Errorf("Expected medium to be selected, got %s", selected.Name)
+	}
+
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(16)); err == nil {
+		t.Error("Expected an error when no node has enough GPUs")
+	}
+}
+
+func TestRequestRateBalanceStrategy_NodesWithoutTrafficAreIdle(t *testing.T) {
+	// A node missing from the rates serves no requests; equal rates prefer free GPUs
+	strategy := NewRequestRateBalanceStrategy(logr.Discard(), mockRequestRates(map[string]float64{"serving": 10}))
+	nodes := []corev1.Node{createMockNode("serving", 8), createMockNode("new-small", 2), createMockNode("new-large", 4)}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "new-large" {
+		t.Errorf("Expected new-large to be selected, got %s", selected.Name)
+	}
+}
+
+func TestRequestRateBalanceStrategy_FallsBackToLeastLoaded(t *testing.T) {
+	nodes := []corev1.Node{createMockNode("node1", 2), createMockNode("node2", 8)}
+
+	failing := RequestRateFunc(func(ctx context.Context) (map[string]float64, error) {
+		return nil, errors.New("prometheus unreachable")
+	})
+	for name, strategy := range map[string]*RequestRateBalanceStrategy{
+		"source error": NewRequestRateBalanceStrategy(logr.Discard(), failing),
+		"no source":    NewRequestRateBalanceStrategy(logr.Discard(), nil),
+	} {
+		selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+		if err != nil {
+			t.Fatalf("%s: ChooseNode() error = %v", name, err)
+		}
+		if selected.Name != "node2" {
+			t.Errorf("%s: expected the least loaded node2, got %s", name, selected.Name)
+		}
+	}
+}
+
+func TestFactory_RequestRateBalanceUsesConfiguredSource(t *testing.T) {
+	opts := Options{RequestRateBalance: RequestRateBalanceOptions{Source: mockRequestRates(map[string]float64{"node1": 50, "node2": 5})}}
+	strategy, err := Factory("requestRateBalance", logr.Discard(), opts)
+	if err != nil {
+		t.Fatalf("Factory() error = %v", err)
+	}
+
+	nodes := []corev1.Node{createMockNode("node1", 8), createMockNode("node2", 2)}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "node2" {
+		t.Errorf("Expected node2 to be selected, got %s", selected.Name)
+	}
+}
+
+func TestPrometheusRequestRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" || r.URL.Query().Get("query") != DefaultRequestRateQuery {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"node":"node1"},"value":[1700000000,"12.5"]},
+			{"metric":{"node":"node2"},"value":[1700000000,"0"]},
+			{"metric":{},"value":[1700000000,"99"]},
+			{"metric":{"node":"node3"},"value":[1700000000,"NaN"]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	rates, err := NewPrometheusRequestRates(server.URL, "").NodeRequestRates(context.Background())
+	if err != nil {
+		t.Fatalf("NodeRequestRates() error = %v", err)
+	}
+	if len(rates) != 2 || rates["node1"] != 12.5 || rates["node2"] != 0 {
+		t.Errorf("Unexpected rates: %v", rates)
+	}
+}
+
+func TestPrometheusRequestRates_QueryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewPrometheusRequestRates(server.URL, "bad(").NodeRequestRates(context.Background()); err == nil {
+		t.Error("Expected an error for a failed query")
+	}
+}
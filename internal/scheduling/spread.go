@@ -0,0 +1,113 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ModelPlacements holds how many placed replicas of a model each node runs, keyed by node name.
+type ModelPlacements map[string]int64
+
+type modelPlacementsKey struct{}
+
+// WithModelPlacements returns a context carrying the placements of the workload's model
+// SpreadStrategy spreads replicas by. The controller computes them before choosing nodes.
+func WithModelPlacements(ctx context.Context, placements ModelPlacements) context.Context {
+	return context.WithValue(ctx, modelPlacementsKey{}, placements)
+}
+
+// ModelPlacementsFromContext returns the model placements carried by ctx, or nil.
+func ModelPlacementsFromContext(ctx context.Context) ModelPlacements {
+	placements, _ := ctx.Value(modelPlacementsKey{}).(ModelPlacements)
+	return placements
+}
+
+// SpreadStrategy spreads the replicas of a model across nodes for high availability. Among
+// the fitting nodes it picks the one running the fewest replicas of the workload's model, so
+// a node not yet hosting the model wins whenever one fits. Nodes running as many replicas are
+// ranked by the most available GPUs. Without placements in the context it behaves like
+// LeastLoadedStrategy.
+type SpreadStrategy struct {
+	logger   logr.Logger
+	capacity CapacityProvider
+}
+
+var _ Strategy = &SpreadStrategy{}
+
+// NewSpreadStrategy creates a new SpreadStrategy.
+func NewSpreadStrategy(logger logr.Logger) *SpreadStrategy {
+	return &SpreadStrategy{logger: logger, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the fitting node running the fewest replicas of the workload's model.
+func (s *SpreadStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	capacity := pods.Capacity(s.capacity)
+	placements := ModelPlacementsFromContext(ctx)
+
+	// Fewer replicas of the model score higher
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		return []int64{-placements[node.Name], availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	s.logger.Info("Selected node using SpreadStrategy", "node", bestNode.Name, "model", gw.Spec.ModelName,
+		"modelReplicas", -score[0], "availableGPUs", score[1])
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas, counting the replicas
+// already chosen as placements of the model so a gang is spread too.
+func (s *SpreadStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	placements := ModelPlacements{}
+	for node, count := range ModelPlacementsFromContext(ctx) {
+		placements[node] = count
+	}
+	ctx = WithModelPlacements(ctx, placements)
+	return chooseReplicaNodes(ctx, &spreadReplicas{SpreadStrategy: s, placements: placements}, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *SpreadStrategy) Name() string {
+	return "spread"
+}
+
+// spreadReplicas records each node SpreadStrategy chooses as a placement of the model.
+type spreadReplicas struct {
+	*SpreadStrategy
+	placements ModelPlacements
+}
+
+// ChooseNode selects a node and records it as running another replica of the model.
+func (s *spreadReplicas) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	node, err := s.SpreadStrategy.ChooseNode(ctx, nodes, pods, gw)
+	if err == nil {
+		s.placements[node.Name]++
+	}
+	return node, err
+}
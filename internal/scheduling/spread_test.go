@@ -0,0 +1,80 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSpreadStrategy_AvoidsNodesRunningTheModel(t *testing.T) {
+	strategy := NewSpreadStrategy(logr.Discard())
+	// The node already running the model has the most free GPUs
+	nodes := []corev1.Node{createMockNode("hosting", 8), createMockNode("other", 2)}
+	ctx := WithModelPlacements(context.Background(), ModelPlacements{"hosting": 1})
+
+	selected, err := strategy.ChooseNode(ctx, nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "other" {
+		t.Errorf("Expected other, which does not run the model yet, got %s", selected.Name)
+	}
+
+	// When only the hosting node fits, it is still chosen
+	selected, err = strategy.ChooseNode(ctx, nodes, nil, createMockGPUWorkload(4))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "hosting" {
+		t.Errorf("Expected hosting, the only node with room, got %s", selected.Name)
+	}
+
+	// Without placements it places like leastLoaded
+	selected, err = strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "hosting" {
+		t.Errorf("Expected the least loaded hosting, got %s", selected.Name)
+	}
+}
+
+func TestSpreadStrategy_SpreadsGangReplicas(t *testing.T) {
+	strategy, err := Factory("spread", logr.Discard(), Options{})
+	if err != nil {
+		t.Fatalf("Factory() error = %v", err)
+	}
+	nodes := []corev1.Node{createMockNode("node1", 8), createMockNode("node2", 4), createMockNode("node3", 4)}
+	placements := ModelPlacements{"node2": 1}
+	ctx := WithModelPlacements(context.Background(), placements)
+
+	selected, err := strategy.ChooseNodes(ctx, nodes, nil, createMockGPUWorkload(2), 2)
+	if err != nil {
+		t.Fatalf("ChooseNodes() error = %v", err)
+	}
+	// node1 has the most free GPUs, but after its replica node3 runs none yet
+	if selected[0].Name != "node1" || selected[1].Name != "node3" {
+		t.Errorf("Expected replicas on node1 and node3, got %s and %s", selected[0].Name, selected[1].Name)
+	}
+	if len(placements) != 1 || placements["node2"] != 1 {
+		t.Errorf("Expected the caller's placements to be left untouched, got %v", placements)
+	}
+}
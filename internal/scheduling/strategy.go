@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	gpuv1alpha1 "github.com/reyisjones/GPU_Orchestrator/api/v1alpha1"
@@ -31,9 +32,15 @@ import (
 // Strategy defines the interface for scheduling strategies.
 // Implementations select a suitable node for a GPUWorkload.
 type Strategy interface {
-	// ChooseNode selects a node from the available list to host the workload.
+	// ChooseNode selects a node from the available list to host the workload. The GPUs
+	// requested by the pods already bound to each node, in pods, are not available.
 	// Returns the selected node or an error if no suitable node is found.
-	ChooseNode(ctx context.Context, nodes []corev1.Node, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error)
+	ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error)
+
+	// ChooseNodes selects a node for each of replicas replicas of the workload, indexed by
+	// replica. A node may host several replicas when it has room for all of them. Nodes are
+	// returned only when every replica fits; otherwise an error is returned.
+	ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error)
 
 	// Name returns the name of the strategy.
 	Name() string
@@ -42,76 +49,124 @@ type Strategy interface {
 // LeastLoadedStrategy selects the node with the most available GPU capacity.
 // This strategy minimizes fragmentation and spreads workloads across nodes.
 type LeastLoadedStrategy struct {
-	logger logr.Logger
+	logger   logr.Logger
+	capacity CapacityProvider
 }
 
 var _ Strategy = &LeastLoadedStrategy{}
 
 // NewLeastLoadedStrategy creates a new LeastLoadedStrategy.
 func NewLeastLoadedStrategy(logger logr.Logger) *LeastLoadedStrategy {
-	return &LeastLoadedStrategy{logger: logger}
+	return &LeastLoadedStrategy{logger: logger, capacity: AllocatableCapacity{}}
 }
 
 // ChooseNode selects the node with the most available GPUs.
-func (s *LeastLoadedStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+func (s *LeastLoadedStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
 	if len(nodes) == 0 {
-		return nil, fmt.Errorf("no suitable nodes available for GPU workload")
+		return nil, ErrNoNodes
 	}
+	capacity := pods.Capacity(s.capacity)
 
 	// Find the node with the most available GPUs
-	var bestNode *corev1.Node
-	maxAvailableGPUs := int64(-1)
-
-	for i, node := range nodes {
-		availableGPUs := getAvailableGPUs(&node)
-		if availableGPUs >= int64(gw.Spec.GPUCount) && availableGPUs > maxAvailableGPUs {
-			maxAvailableGPUs = availableGPUs
-			bestNode = &nodes[i]
-		}
-	}
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		return []int64{availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
 
 	if bestNode == nil {
-		return nil, fmt.Errorf("no node has enough available GPUs for workload requiring %d GPUs", gw.Spec.GPUCount)
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
 	}
 
-	s.logger.Info("Selected node using LeastLoadedStrategy", "node", bestNode.Name, "availableGPUs", maxAvailableGPUs)
+	s.logger.Info("Selected node using LeastLoadedStrategy", "node", bestNode.Name, "availableGPUs", score[0])
 	return bestNode, nil
 }
 
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *LeastLoadedStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
 // Name returns the strategy name.
 func (s *LeastLoadedStrategy) Name() string {
 	return "leastLoaded"
 }
 
+// BinPackStrategy selects the fitting node with the fewest available GPUs, so partially used
+// nodes fill up first and idle nodes can be scaled down by the cluster autoscaler.
+type BinPackStrategy struct {
+	logger   logr.Logger
+	capacity CapacityProvider
+}
+
+var _ Strategy = &BinPackStrategy{}
+
+// NewBinPackStrategy creates a new BinPackStrategy.
+func NewBinPackStrategy(logger logr.Logger) *BinPackStrategy {
+	return &BinPackStrategy{logger: logger, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the node with the fewest available GPUs that still fits the workload.
+func (s *BinPackStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	// Negate the available GPUs so the tightest fit scores highest
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		return []int64{-availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	s.logger.Info("Selected node using BinPackStrategy", "node", bestNode.Name, "availableGPUs", -score[0])
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *BinPackStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *BinPackStrategy) Name() string {
+	return "binPack"
+}
+
 // RandomStrategy selects a random node from the available options.
 // This strategy is useful for load distribution when all nodes are comparable.
 type RandomStrategy struct {
-	logger logr.Logger
+	logger   logr.Logger
+	capacity CapacityProvider
 }
 
 var _ Strategy = &RandomStrategy{}
 
 // NewRandomStrategy creates a new RandomStrategy.
 func NewRandomStrategy(logger logr.Logger) *RandomStrategy {
-	return &RandomStrategy{logger: logger}
+	return &RandomStrategy{logger: logger, capacity: AllocatableCapacity{}}
 }
 
 // ChooseNode selects a random node with sufficient GPU capacity.
-func (s *RandomStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+func (s *RandomStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
 	if len(nodes) == 0 {
-		return nil, fmt.Errorf("no suitable nodes available for GPU workload")
+		return nil, ErrNoNodes
 	}
+	capacity := pods.Capacity(s.capacity)
 
 	// Filter nodes with sufficient GPU capacity
 	var suitableNodes []corev1.Node
 	for _, node := range nodes {
-		if getAvailableGPUs(&node) >= int64(gw.Spec.GPUCount) {
+		if capacity.AvailableGPUs(&node) >= int64(gw.RequestedGPUCount()) {
 			suitableNodes = append(suitableNodes, node)
 		}
 	}
 
 	if len(suitableNodes) == 0 {
-		return nil, fmt.Errorf("no node has enough available GPUs for workload requiring %d GPUs", gw.Spec.GPUCount)
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
 	}
 
 	// Select a random node
@@ -122,36 +177,45 @@ func (s *RandomStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, gw
 	return selectedNode, nil
 }
 
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *RandomStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
 // Name returns the strategy name.
 func (s *RandomStrategy) Name() string {
 	return "random"
 }
 
-// CostOptimizedStrategy prefers nodes with the "gpu-orchestrator/cheap-node=true" label.
+// CostOptimizedStrategy prefers nodes with the "gpu-orchestrator/cheap-node=true" label,
+// or the label configured in its options.
 // Falls back to LeastLoadedStrategy if no cost-optimized nodes are available.
 type CostOptimizedStrategy struct {
-	logger logr.Logger
+	logger   logr.Logger
+	opts     CostOptimizedOptions
+	capacity CapacityProvider
 }
 
 var _ Strategy = &CostOptimizedStrategy{}
 
 // NewCostOptimizedStrategy creates a new CostOptimizedStrategy.
-func NewCostOptimizedStrategy(logger logr.Logger) *CostOptimizedStrategy {
-	return &CostOptimizedStrategy{logger: logger}
+func NewCostOptimizedStrategy(logger logr.Logger, opts CostOptimizedOptions) *CostOptimizedStrategy {
+	return &CostOptimizedStrategy{logger: logger, opts: opts.withDefaults(), capacity: AllocatableCapacity{}}
 }
 
 // ChooseNode selects a cost-optimized node if available, otherwise uses LeastLoadedStrategy.
-func (s *CostOptimizedStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+func (s *CostOptimizedStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
 	if len(nodes) == 0 {
-		return nil, fmt.Errorf("no suitable nodes available for GPU workload")
+		return nil, ErrNoNodes
 	}
+	capacity := pods.Capacity(s.capacity)
 
 	// First, try to find a cost-optimized node
 	var cheapNodes []corev1.Node
 	for _, node := range nodes {
 		if node.Labels != nil {
-			if isCheap, exists := node.Labels["gpu-orchestrator/cheap-node"]; exists && isCheap == "true" {
-				if getAvailableGPUs(&node) >= int64(gw.Spec.GPUCount) {
+			if isCheap, exists := node.Labels[s.opts.LabelKey]; exists && isCheap == s.opts.LabelValue {
+				if capacity.AvailableGPUs(&node) >= int64(gw.RequestedGPUCount()) {
 					cheapNodes = append(cheapNodes, node)
 				}
 			}
@@ -160,16 +224,9 @@ func (s *CostOptimizedStrategy) ChooseNode(ctx context.Context, nodes []corev1.N
 
 	// If cheap nodes are available, use least-loaded among them
 	if len(cheapNodes) > 0 {
-		var bestNode *corev1.Node
-		maxAvailableGPUs := int64(-1)
-
-		for i, node := range cheapNodes {
-			availableGPUs := getAvailableGPUs(&node)
-			if availableGPUs > maxAvailableGPUs {
-				maxAvailableGPUs = availableGPUs
-				bestNode = &cheapNodes[i]
-			}
-		}
+		bestNode, _ := pickBest(cheapNodes, func(node *corev1.Node) ([]int64, bool) {
+			return []int64{capacity.AvailableGPUs(node)}, true
+		})
 
 		s.logger.Info("Selected cost-optimized node", "node", bestNode.Name)
 		return bestNode, nil
@@ -177,8 +234,13 @@ func (s *CostOptimizedStrategy) ChooseNode(ctx context.Context, nodes []corev1.N
 
 	// Fall back to least-loaded strategy
 	s.logger.Info("No cost-optimized nodes available, falling back to LeastLoadedStrategy")
-	fallback := NewLeastLoadedStrategy(s.logger)
-	return fallback.ChooseNode(ctx, nodes, gw)
+	fallback := &LeastLoadedStrategy{logger: s.logger, capacity: s.capacity}
+	return fallback.ChooseNode(ctx, nodes, pods, gw)
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *CostOptimizedStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
 }
 
 // Name returns the strategy name.
@@ -186,38 +248,233 @@ func (s *CostOptimizedStrategy) Name() string {
 	return "costOptimized"
 }
 
-// Factory creates a strategy based on the name.
-func Factory(strategyName string, logger logr.Logger) (Strategy, error) {
+const (
+	// NUMAGPUsPerNodeLabel is the node label holding how many GPUs are attached to each NUMA node.
+	NUMAGPUsPerNodeLabel = "gpu-orchestrator/numa-gpus-per-node"
+
+	// TopologyManagerPolicyLabel is the node label mirroring the kubelet topology manager policy.
+	TopologyManagerPolicyLabel = "gpu-orchestrator/topology-manager-policy"
+)
+
+// NUMAAwareStrategy prefers nodes where the workload's GPUs, and the CPUs serving them,
+// can be allocated from a single NUMA node. Nodes whose topology manager policy enforces
+// alignment ("single-numa-node" or "restricted") are preferred over nodes that only
+// have a compatible layout. Equally aligned nodes are ranked by the most available GPUs.
+type NUMAAwareStrategy struct {
+	logger   logr.Logger
+	opts     NUMAAwareOptions
+	capacity CapacityProvider
+}
+
+var _ Strategy = &NUMAAwareStrategy{}
+
+// NewNUMAAwareStrategy creates a new NUMAAwareStrategy.
+func NewNUMAAwareStrategy(logger logr.Logger, opts NUMAAwareOptions) *NUMAAwareStrategy {
+	return &NUMAAwareStrategy{logger: logger, opts: opts.withDefaults(), capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the fitting node with the best NUMA alignment for the workload.
+func (s *NUMAAwareStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	capacity := pods.Capacity(s.capacity)
+
+	bestNode, score := pickBest(nodes, func(node *corev1.Node) ([]int64, bool) {
+		availableGPUs := capacity.AvailableGPUs(node)
+		alignment := numaAlignmentScore(node, gw.RequestedGPUCount(), s.opts)
+		return []int64{int64(alignment), availableGPUs}, availableGPUs >= int64(gw.RequestedGPUCount())
+	})
+
+	if bestNode == nil {
+		return nil, newSchedulingError(RejectionInsufficientGPUs, "no node has enough available GPUs for workload requiring %d GPUs", gw.RequestedGPUCount())
+	}
+
+	s.logger.Info("Selected node using NUMAAwareStrategy", "node", bestNode.Name, "alignmentScore", score[0])
+	return bestNode, nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas.
+func (s *NUMAAwareStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	return chooseReplicaNodes(ctx, s, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *NUMAAwareStrategy) Name() string {
+	return "numaAware"
+}
+
+// numaAlignmentScore rates how well a node can NUMA-align a request for gpuCount GPUs:
+// 0 when alignment is unknown or impossible, 1 when the GPUs fit within one NUMA node,
+// and 2 when they fit and the topology manager policy enforces alignment.
+func numaAlignmentScore(node *corev1.Node, gpuCount int32, opts NUMAAwareOptions) int {
+	if node.Labels == nil {
+		return 0
+	}
+
+	var gpusPerNUMA int64
+	fmt.Sscanf(node.Labels[opts.GPUsPerNUMALabel], "%d", &gpusPerNUMA)
+	if gpusPerNUMA <= 0 || int64(gpuCount) > gpusPerNUMA {
+		return 0
+	}
+
+	switch node.Labels[opts.TopologyPolicyLabel] {
+	case "single-numa-node", "restricted":
+		return 2
+	default:
+		return 1
+	}
+}
+
+// DefaultTopologyKey is the node label TopologyAwareStrategy groups nodes by when the
+// workload names no topology key.
+const DefaultTopologyKey = "topology.kubernetes.io/zone"
+
+// TopologyAwareStrategy keeps all of a workload's GPUs within one topology domain, such as
+// a zone or rack, named by the node label in the workload's topologyKey. Among the domains
+// with room for every replica it picks the one with the most available GPUs, then places
+// each replica on the least loaded node within it. Nodes without the label are a domain of
+// their own, tried last. When no single domain fits, replicas are spread least-loaded
+// across domains.
+type TopologyAwareStrategy struct {
+	logger   logr.Logger
+	capacity CapacityProvider
+}
+
+var _ Strategy = &TopologyAwareStrategy{}
+
+// NewTopologyAwareStrategy creates a new TopologyAwareStrategy.
+func NewTopologyAwareStrategy(logger logr.Logger) *TopologyAwareStrategy {
+	return &TopologyAwareStrategy{logger: logger, capacity: AllocatableCapacity{}}
+}
+
+// ChooseNode selects the least loaded node of the best fitting topology domain.
+func (s *TopologyAwareStrategy) ChooseNode(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload) (*corev1.Node, error) {
+	selected, err := s.ChooseNodes(ctx, nodes, pods, gw, 1)
+	if err != nil {
+		return nil, err
+	}
+	return selected[0], nil
+}
+
+// ChooseNodes selects a node for each of the workload's replicas, all within one topology
+// domain when any has room for them.
+func (s *TopologyAwareStrategy) ChooseNodes(ctx context.Context, nodes []corev1.Node, pods NodePods, gw *gpuv1alpha1.GPUWorkload, replicas int) ([]*corev1.Node, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+	key := gw.Spec.TopologyKey
+	if key == "" {
+		key = DefaultTopologyKey
+	}
+	capacity := pods.Capacity(s.capacity)
+	leastLoaded := &LeastLoadedStrategy{logger: s.logger, capacity: s.capacity}
+
+	members := map[string][]corev1.Node{}
+	free := map[string]int64{}
+	for i := range nodes {
+		domain := nodes[i].Labels[key]
+		members[domain] = append(members[domain], nodes[i])
+		free[domain] += capacity.AvailableGPUs(&nodes[i])
+	}
+	domains := make([]string, 0, len(members))
+	for domain := range members {
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		if (domains[i] == "") != (domains[j] == "") {
+			return domains[j] == ""
+		}
+		if free[domains[i]] != free[domains[j]] {
+			return free[domains[i]] > free[domains[j]]
+		}
+		return domains[i] < domains[j]
+	})
+
+	for _, domain := range domains {
+		selected, err := chooseReplicaNodes(ctx, leastLoaded, members[domain], pods, gw, replicas)
+		if err != nil {
+			continue
+		}
+		// Return the caller's nodes rather than the domain's copies
+		for i, node := range selected {
+			selected[i] = &nodes[nodeIndex(nodes, node.Name)]
+		}
+		s.logger.Info("Selected topology domain using TopologyAwareStrategy", "topologyKey", key, "domain", domain,
+			"availableGPUs", free[domain], "replicas", replicas)
+		return selected, nil
+	}
+
+	s.logger.Info("No topology domain fits every replica, spreading across domains", "topologyKey", key, "replicas", replicas)
+	return chooseReplicaNodes(ctx, leastLoaded, nodes, pods, gw, replicas)
+}
+
+// Name returns the strategy name.
+func (s *TopologyAwareStrategy) Name() string {
+	return "topologyAware"
+}
+
+// DefaultStrategyName is the strategy used for workloads that do not name one.
+const DefaultStrategyName = gpuv1alpha1.DefaultSchedulingStrategy
+
+// StrategyNames lists the strategies Factory can create.
+var StrategyNames = []string{"leastLoaded", "binPack", "random", "costOptimized", "numaAware", "migPartition", "requestRateBalance", "topologyAware", "dataLocality", "reliabilityWeighted", "fairShare", "healthScore", "spread"}
+
+// Factory creates a strategy based on the name, configured with its entry in opts and
+// reading node capacity from opts.Capacity.
+func Factory(strategyName string, logger logr.Logger, opts Options) (Strategy, error) {
+	capacity := capacityOrDefault(opts.Capacity)
 	switch strategyName {
 	case "leastLoaded":
-		return NewLeastLoadedStrategy(logger), nil
+		return &LeastLoadedStrategy{logger: logger, capacity: capacity}, nil
+	case "binPack":
+		return &BinPackStrategy{logger: logger, capacity: capacity}, nil
 	case "random":
-		return NewRandomStrategy(logger), nil
+		return &RandomStrategy{logger: logger, capacity: capacity}, nil
 	case "costOptimized":
-		return NewCostOptimizedStrategy(logger), nil
+		return &CostOptimizedStrategy{logger: logger, opts: opts.CostOptimized.withDefaults(), capacity: capacity}, nil
+	case "numaAware":
+		return &NUMAAwareStrategy{logger: logger, opts: opts.NUMAAware.withDefaults(), capacity: capacity}, nil
+	case "migPartition":
+		return NewMIGPartitionStrategy(logger), nil
+	case "requestRateBalance":
+		return &RequestRateBalanceStrategy{logger: logger, source: opts.RequestRateBalance.Source, capacity: capacity}, nil
+	case "topologyAware":
+		return &TopologyAwareStrategy{logger: logger, capacity: capacity}, nil
+	case "dataLocality":
+		return &DataLocalityStrategy{logger: logger, capacity: capacity}, nil
+	case "reliabilityWeighted":
+		return &ReliabilityWeightedStrategy{logger: logger, tracker: opts.ReliabilityWeighted.Tracker, capacity: capacity}, nil
+	case "fairShare":
+		return &FairShareStrategy{logger: logger, capacity: capacity}, nil
+	case "healthScore":
+		return &HealthScoreStrategy{logger: logger, weights: opts.HealthScore.Weights.withDefaults(), tracker: opts.ReliabilityWeighted.Tracker, capacity: capacity}, nil
+	case "spread":
+		return &SpreadStrategy{logger: logger, capacity: capacity}, nil
 	default:
 		// Default to least-loaded
 		logger.Info("Unknown strategy, defaulting to leastLoaded", "requested", strategyName)
-		return NewLeastLoadedStrategy(logger), nil
+		return &LeastLoadedStrategy{logger: logger, capacity: capacity}, nil
 	}
 }
 
-// getAvailableGPUs returns the number of allocatable GPUs on a node.
-// It checks both the allocatable resources and node labels for GPU availability.
+// getAvailableGPUs returns the number of allocatable GPUs on a node, scaled by its over-commit ratio.
+// It checks the allocatable resources of every supported vendor and node labels for GPU availability.
 //
 // Note: This is a simplified implementation. In production, you might want to:
 // - Query the resource metrics API for actual usage
 // - Account for reserved/allocated GPUs
-// - Support multiple GPU vendors (NVIDIA, AMD, etc.)
 func getAvailableGPUs(node *corev1.Node) int64 {
-	// Try to get from allocatable resources first (most accurate)
-	if quantity, ok := node.Status.Allocatable[corev1.ResourceName("nvidia.com/gpu")]; ok {
-		return quantity.Value()
-	}
+	return overcommitted(allocatableGPUs(node), OvercommitRatio(node))
+}
 
-	// Fall back to capacity
-	if quantity, ok := node.Status.Capacity[corev1.ResourceName("nvidia.com/gpu")]; ok {
-		return quantity.Value()
+// allocatableGPUs returns the number of GPUs a node advertises, before over-commit.
+func allocatableGPUs(node *corev1.Node) int64 {
+	// Nodes carry a single GPU vendor, so the first one found wins
+	for _, vendor := range DefaultVendorPreference {
+		if gpus := VendorGPUs(node, vendor); gpus > 0 {
+			return gpus
+		}
 	}
 
 	// Check for GPU label (some clusters use labels instead of resources)
@@ -235,6 +492,49 @@ func getAvailableGPUs(node *corev1.Node) int64 {
 	return 0
 }
 
+// GPUMemoryLabel is the node label holding the memory of each of its GPUs in megabytes, as
+// published by NVIDIA GPU feature discovery.
+const GPUMemoryLabel = "nvidia.com/gpu.memory"
+
+// getNodeGPUMemoryGB returns the memory of each GPU of a node in gigabytes, read from its
+// GPUMemoryLabel, or 0 when the label is missing or invalid.
+func getNodeGPUMemoryGB(node *corev1.Node) int64 {
+	memoryMB, err := strconv.ParseInt(node.Labels[GPUMemoryLabel], 10, 64)
+	if err != nil || memoryMB <= 0 {
+		return 0
+	}
+	return memoryMB / 1024
+}
+
+// meetsGPUMemory reports whether the node's GPUs have at least the workload's MinGPUMemoryGB.
+func meetsGPUMemory(node *corev1.Node, gw *gpuv1alpha1.GPUWorkload) bool {
+	return getNodeGPUMemoryGB(node) >= int64(gw.Spec.MinGPUMemoryGB)
+}
+
+// NodesWithGPUMemory returns the nodes whose GPUs meet the workload's MinGPUMemoryGB, or a
+// SchedulingError when none does. Strategies do not filter on GPU memory themselves, so the
+// caller applies it to their candidates.
+func NodesWithGPUMemory(nodes []corev1.Node, gw *gpuv1alpha1.GPUWorkload) ([]corev1.Node, error) {
+	if gw.Spec.MinGPUMemoryGB <= 0 {
+		return nodes, nil
+	}
+	var matching []corev1.Node
+	for i := range nodes {
+		if meetsGPUMemory(&nodes[i], gw) {
+			matching = append(matching, nodes[i])
+		}
+	}
+	if len(matching) == 0 {
+		return nil, newSchedulingError(RejectionInsufficientGPUMemory, "no node has GPUs with at least %dGB of memory", gw.Spec.MinGPUMemoryGB)
+	}
+	return matching, nil
+}
+
+// AvailableGPUs returns the number of GPUs the scheduler considers available on a node.
+func AvailableGPUs(node *corev1.Node) int64 {
+	return getAvailableGPUs(node)
+}
+
 // SortNodesByGPUAvailability sorts nodes in descending order by available GPUs.
 // This helper can be useful for strategies that need ordered node lists.
 func SortNodesByGPUAvailability(nodes []corev1.Node) {
@@ -18,6 +18,10 @@ package scheduling
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -75,7 +79,7 @@ func TestLeastLoadedStrategy_ChoosesNodeWithMostGPUs(t *testing.T) {
 
 	workload := createMockGPUWorkload(1)
 
-	selected, err := strategy.ChooseNode(context.Background(), nodes, workload)
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 	if err != nil {
 		t.Fatalf("ChooseNode() error = %v", err)
 	}
@@ -92,7 +96,7 @@ func TestLeastLoadedStrategy_EmptyNodeList(t *testing.T) {
 	nodes := []corev1.Node{}
 	workload := createMockGPUWorkload(1)
 
-	_, err := strategy.ChooseNode(context.Background(), nodes, workload)
+	_, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 	if err == nil {
 		t.Error("Expected error for empty node list")
 	}
@@ -109,12 +113,114 @@ func TestLeastLoadedStrategy_InsufficientGPUs(t *testing.T) {
 
 	workload := createMockGPUWorkload(4) // Requires 4 GPUs
 
-	_, err := strategy.ChooseNode(context.Background(), nodes, workload)
+	_, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 	if err == nil {
 		t.Error("Expected error when no node has enough GPUs")
 	}
 }
 
+func TestBinPackStrategy_ChoosesTightestFit(t *testing.T) {
+	strategy := NewBinPackStrategy(logr.Discard())
+
+	nodes := []corev1.Node{
+		createMockNode("node1", 8),
+		createMockNode("node2", 3),
+		createMockNode("node3", 1),
+	}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "node2" {
+		t.Errorf("Expected node2, the smallest node that fits 2 GPUs, got %s", selected.Name)
+	}
+}
+
+func TestBinPackStrategy_TieBreaksEqualRemainingCapacity(t *testing.T) {
+	strategy := NewBinPackStrategy(logr.Discard())
+
+	nodes := []corev1.Node{
+		createMockNode("node-b", 4),
+		createMockNode("node-a", 4),
+		createMockNode("node-c", 8),
+	}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "node-a" {
+		t.Errorf("Expected the name tie-break to pick node-a among equally full nodes, got %s", selected.Name)
+	}
+}
+
+func TestBinPackStrategy_InsufficientGPUs(t *testing.T) {
+	strategy := NewBinPackStrategy(logr.Discard())
+
+	nodes := []corev1.Node{createMockNode("node1", 1)}
+	if _, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2)); err == nil {
+		t.Error("Expected an error when no node fits the workload")
+	}
+}
+
+// createMemoryNode returns a node with gpuCount GPUs of memoryGB gigabytes each.
+func createMemoryNode(name string, gpuCount, memoryGB int64) corev1.Node {
+	node := createMockNode(name, gpuCount)
+	node.Labels = map[string]string{GPUMemoryLabel: strconv.FormatInt(memoryGB*1024, 10)}
+	return node
+}
+
+func TestGetNodeGPUMemoryGB(t *testing.T) {
+	tests := []struct {
+		label    string
+		expected int64
+	}{
+		{"81920", 80},
+		{"40960", 40},
+		{"", 0},
+		{"lots", 0},
+		{"-1024", 0},
+	}
+	for _, tt := range tests {
+		node := createMockNode("node1", 1)
+		node.Labels = map[string]string{GPUMemoryLabel: tt.label}
+		if got := getNodeGPUMemoryGB(&node); got != tt.expected {
+			t.Errorf("getNodeGPUMemoryGB(%q) = %d, expected %d", tt.label, got, tt.expected)
+		}
+	}
+}
+
+func TestNodesWithGPUMemory_RejectsNodesBelowMinGPUMemory(t *testing.T) {
+	nodes := []corev1.Node{
+		createMemoryNode("small-memory", 8, 24),
+		createMemoryNode("large-memory", 2, 80),
+		createMemoryNode("medium-memory", 1, 40),
+		createMockNode("unlabeled", 8),
+	}
+	workload := createMockGPUWorkload(1)
+	workload.Spec.MinGPUMemoryGB = 40
+
+	matching, err := NodesWithGPUMemory(nodes, workload)
+	if err != nil {
+		t.Fatalf("NodesWithGPUMemory() error = %v", err)
+	}
+	var names []string
+	for _, node := range matching {
+		names = append(names, node.Name)
+	}
+	if strings.Join(names, ",") != "large-memory,medium-memory" {
+		t.Errorf("Expected large-memory and medium-memory, got %v", names)
+	}
+
+	workload.Spec.MinGPUMemoryGB = 96
+	_, err = NodesWithGPUMemory(nodes, workload)
+	var schedErr *SchedulingError
+	if !errors.As(err, &schedErr) || schedErr.Reason != RejectionInsufficientGPUMemory {
+		t.Errorf("Expected an insufficient_gpu_memory error, got %v", err)
+	}
+}
+
 func TestRandomStrategy_ChoosesFromSuitableNodes(t *testing.T) {
 	logger := logr.Discard()
 	strategy := NewRandomStrategy(logger)
@@ -129,7 +235,7 @@ func TestRandomStrategy_ChoosesFromSuitableNodes(t *testing.T) {
 
 	// Run multiple times to ensure randomness doesn't pick unsuitable nodes
 	for i := 0; i < 10; i++ {
-		selected, err := strategy.ChooseNode(context.Background(), nodes, workload)
+		selected, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 		if err != nil {
 			t.Fatalf("Iteration %d: ChooseNode() error = %v", i, err)
 		}
@@ -148,7 +254,7 @@ func TestRandomStrategy_EmptyNodeList(t *testing.T) {
 	nodes := []corev1.Node{}
 	workload := createMockGPUWorkload(1)
 
-	_, err := strategy.ChooseNode(context.Background(), nodes, workload)
+	_, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 	if err == nil {
 		t.Error("Expected error for empty node list")
 	}
@@ -156,7 +262,7 @@ func TestRandomStrategy_EmptyNodeList(t *testing.T) {
 
 func TestCostOptimizedStrategy_PrefersLabeledNodes(t *testing.T) {
 	logger := logr.Discard()
-	strategy := NewCostOptimizedStrategy(logger)
+	strategy := NewCostOptimizedStrategy(logger, CostOptimizedOptions{})
 
 	// Create nodes with and without cost label
 	node1 := createMockNode("cheap-node", 4)
@@ -168,7 +274,7 @@ func TestCostOptimizedStrategy_PrefersLabeledNodes(t *testing.T) {
 	nodes := []corev1.Node{node2, node1}
 	workload := createMockGPUWorkload(2)
 
-	selected, err := strategy.ChooseNode(context.Background(), nodes, workload)
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 	if err != nil {
 		t.Fatalf("ChooseNode() error = %v", err)
 	}
@@ -180,7 +286,7 @@ func TestCostOptimizedStrategy_PrefersLabeledNodes(t *testing.T) {
 
 func TestCostOptimizedStrategy_FallsBackToLeastLoaded(t *testing.T) {
 	logger := logr.Discard()
-	strategy := NewCostOptimizedStrategy(logger)
+	strategy := NewCostOptimizedStrategy(logger, CostOptimizedOptions{})
 
 	// Create nodes without cost label
 	nodes := []corev1.Node{
@@ -190,7 +296,7 @@ func TestCostOptimizedStrategy_FallsBackToLeastLoaded(t *testing.T) {
 
 	workload := createMockGPUWorkload(1)
 
-	selected, err := strategy.ChooseNode(context.Background(), nodes, workload)
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, workload)
 	if err != nil {
 		t.Fatalf("ChooseNode() error = %v", err)
 	}
@@ -201,6 +307,143 @@ func TestCostOptimizedStrategy_FallsBackToLeastLoaded(t *testing.T) {
 	}
 }
 
+func TestNUMAAwareStrategy_PrefersAlignedNodes(t *testing.T) {
+	strategy := NewNUMAAwareStrategy(logr.Discard(), NUMAAwareOptions{})
+
+	// 8 GPUs split 2 per NUMA node: a 4-GPU request spans NUMA nodes
+	split := createMockNode("split-numa", 8)
+	split.Labels = map[string]string{NUMAGPUsPerNodeLabel: "2"}
+
+	// 4 GPUs per NUMA node: a 4-GPU request fits in one NUMA node
+	aligned := createMockNode("aligned-numa", 4)
+	aligned.Labels = map[string]string{NUMAGPUsPerNodeLabel: "4"}
+
+	unlabeled := createMockNode("unlabeled", 8)
+
+	nodes := []corev1.Node{split, unlabeled, aligned}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(4))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "aligned-numa" {
+		t.Errorf("Expected aligned-numa to be selected, got %s", selected.Name)
+	}
+}
+
+func TestNUMAAwareStrategy_PrefersEnforcingPolicy(t *testing.T) {
+	strategy := NewNUMAAwareStrategy(logr.Discard(), NUMAAwareOptions{})
+
+	bestEffort := createMockNode("best-effort", 8)
+	bestEffort.Labels = map[string]string{
+		NUMAGPUsPerNodeLabel:       "4",
+		TopologyManagerPolicyLabel: "best-effort",
+	}
+
+	singleNUMA := createMockNode("single-numa", 4)
+	singleNUMA.Labels = map[string]string{
+		NUMAGPUsPerNodeLabel:       "4",
+		TopologyManagerPolicyLabel: "single-numa-node",
+	}
+
+	nodes := []corev1.Node{bestEffort, singleNUMA}
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "single-numa" {
+		t.Errorf("Expected single-numa to be selected, got %s", selected.Name)
+	}
+}
+
+func TestNUMAAwareStrategy_FallsBackToMostAvailable(t *testing.T) {
+	strategy := NewNUMAAwareStrategy(logr.Discard(), NUMAAwareOptions{})
+
+	nodes := []corev1.Node{
+		createMockNode("node1", 2),
+		createMockNode("node2", 4),
+	}
+
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "node2" {
+		t.Errorf("Expected node2 (most GPUs, no NUMA labels), got %s", selected.Name)
+	}
+}
+
+// createZonedNode returns a mock node in the given zone.
+func createZonedNode(name, zone string, gpuCount int64) corev1.Node {
+	node := createMockNode(name, gpuCount)
+	node.Labels = map[string]string{DefaultTopologyKey: zone}
+	return node
+}
+
+func TestTopologyAwareStrategy_KeepsReplicasInOneZone(t *testing.T) {
+	strategy := NewTopologyAwareStrategy(logr.Discard())
+
+	// zone-a has the most GPUs, but only zone-b has room for both 4-GPU replicas
+	nodes := []corev1.Node{
+		createZonedNode("a1", "zone-a", 6),
+		createZonedNode("a2", "zone-a", 3),
+		createZonedNode("b1", "zone-b", 4),
+		createZonedNode("b2", "zone-b", 4),
+	}
+
+	selected, err := strategy.ChooseNodes(context.Background(), nodes, nil, createMockGPUWorkload(4), 2)
+	if err != nil {
+		t.Fatalf("ChooseNodes() error = %v", err)
+	}
+	for _, node := range selected {
+		if node.Labels[DefaultTopologyKey] != "zone-b" {
+			t.Fatalf("Expected every replica in zone-b, got %s", node.Name)
+		}
+	}
+}
+
+func TestTopologyAwareStrategy_PrefersZoneWithMostAvailableGPUs(t *testing.T) {
+	strategy := NewTopologyAwareStrategy(logr.Discard())
+	nodes := []corev1.Node{
+		createZonedNode("a1", "zone-a", 8),
+		createZonedNode("b1", "zone-b", 4),
+		createZonedNode("b2", "zone-b", 6),
+		createMockNode("unlabeled", 16),
+	}
+
+	// zone-b has the most GPUs of the labeled zones; its least loaded node wins
+	selected, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if selected.Name != "b2" {
+		t.Errorf("Expected b2 to be selected, got %s", selected.Name)
+	}
+}
+
+func TestTopologyAwareStrategy_CustomKeyAndSpreadFallback(t *testing.T) {
+	strategy := NewTopologyAwareStrategy(logr.Discard())
+	rack1, rack2 := createMockNode("rack1", 2), createMockNode("rack2", 2)
+	rack1.Labels = map[string]string{"example.com/rack": "r1"}
+	rack2.Labels = map[string]string{"example.com/rack": "r2"}
+	nodes := []corev1.Node{rack1, rack2}
+
+	gw := createMockGPUWorkload(2)
+	gw.Spec.TopologyKey = "example.com/rack"
+
+	// No rack holds both replicas, so they are spread across racks
+	selected, err := strategy.ChooseNodes(context.Background(), nodes, nil, gw, 2)
+	if err != nil {
+		t.Fatalf("ChooseNodes() error = %v", err)
+	}
+	if selected[0].Name == selected[1].Name {
+		t.Errorf("Expected replicas on both racks, got %s twice", selected[0].Name)
+	}
+
+	if _, err := strategy.ChooseNodes(context.Background(), nodes, nil, gw, 3); err == nil {
+		t.Error("Expected an error when the replicas fit in no combination of racks")
+	}
+}
+
 func TestFactory_CreatesCorrectStrategy(t *testing.T) {
 	logger := logr.Discard()
 
@@ -210,14 +453,22 @@ func TestFactory_CreatesCorrectStrategy(t *testing.T) {
 		expectedType string
 	}{
 		{"leastLoaded", "leastLoaded", "*scheduling.LeastLoadedStrategy"},
+		{"binPack", "binPack", "*scheduling.BinPackStrategy"},
 		{"random", "random", "*scheduling.RandomStrategy"},
 		{"costOptimized", "costOptimized", "*scheduling.CostOptimizedStrategy"},
+		{"numaAware", "numaAware", "*scheduling.NUMAAwareStrategy"},
+		{"migPartition", "migPartition", "*scheduling.MIGPartitionStrategy"},
+		{"requestRateBalance", "requestRateBalance", "*scheduling.RequestRateBalanceStrategy"},
+		{"topologyAware", "topologyAware", "*scheduling.TopologyAwareStrategy"},
+		{"dataLocality", "dataLocality", "*scheduling.DataLocalityStrategy"},
+		{"reliabilityWeighted", "reliabilityWeighted", "*scheduling.ReliabilityWeightedStrategy"},
+		{"fairShare", "fairShare", "*scheduling.FairShareStrategy"},
 		{"unknown defaults to leastLoaded", "unknown", "*scheduling.LeastLoadedStrategy"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			strategy, err := Factory(tt.strategyName, logger)
+			strategy, err := Factory(tt.strategyName, logger, Options{})
 			if err != nil {
 				t.Fatalf("Factory() error = %v", err)
 			}
@@ -228,6 +479,13 @@ func TestFactory_CreatesCorrectStrategy(t *testing.T) {
 	}
 }
 
+func TestStrategyNames_MatchAPIValidation(t *testing.T) {
+	// The webhook accepts exactly the strategies Factory can create
+	if !reflect.DeepEqual(StrategyNames, gpuv1alpha1.SchedulingStrategies) {
+		t.Errorf("StrategyNames %v differ from the strategies the API accepts %v", StrategyNames, gpuv1alpha1.SchedulingStrategies)
+	}
+}
+
 func TestSortNodesByGPUAvailability(t *testing.T) {
 	nodes := []corev1.Node{
 		createMockNode("node1", 1),
@@ -259,7 +517,7 @@ func BenchmarkLeastLoadedStrategy(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		strategy.ChooseNode(ctx, nodes, workload)
+		strategy.ChooseNode(ctx, nodes, nil, workload)
 	}
 }
 
@@ -277,6 +535,6 @@ func BenchmarkRandomStrategy(b *testing.B) {
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		strategy.ChooseNode(ctx, nodes, workload)
+		strategy.ChooseNode(ctx, nodes, nil, workload)
 	}
 }
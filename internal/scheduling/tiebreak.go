@@ -0,0 +1,158 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TieBreakPolicy decides between nodes a strategy scores equally.
+type TieBreakPolicy string
+
+const (
+	// TieBreakName picks the node whose name sorts first, keeping placement deterministic.
+	TieBreakName TieBreakPolicy = "name"
+
+	// TieBreakRandom picks one of the tied nodes at random.
+	TieBreakRandom TieBreakPolicy = "random"
+
+	// TieBreakLeastRecentlyUsed picks the tied node that was least recently selected,
+	// falling back to name order for nodes never selected.
+	TieBreakLeastRecentlyUsed TieBreakPolicy = "leastRecentlyUsed"
+)
+
+// TieBreaker applies a tie-break policy and remembers placements for leastRecentlyUsed.
+type TieBreaker struct {
+	policy TieBreakPolicy
+
+	mu       sync.Mutex
+	rand     *rand.Rand
+	lastUsed map[string]uint64
+	sequence uint64
+}
+
+// NewTieBreaker creates a TieBreaker for the given policy.
+func NewTieBreaker(policy TieBreakPolicy) (*TieBreaker, error) {
+	switch policy {
+	case TieBreakName, TieBreakRandom, TieBreakLeastRecentlyUsed:
+	default:
+		return nil, fmt.Errorf("unknown tie-break policy %q", policy)
+	}
+	return &TieBreaker{
+		policy:   policy,
+		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		lastUsed: map[string]uint64{},
+	}, nil
+}
+
+// Pick returns the preferred node among equally scored candidates.
+func (t *TieBreaker) Pick(nodes []*corev1.Node) *corev1.Node {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.policy == TieBreakRandom {
+		return nodes[t.rand.Intn(len(nodes))]
+	}
+
+	best := nodes[0]
+	for _, node := range nodes[1:] {
+		if t.policy == TieBreakLeastRecentlyUsed && t.lastUsed[node.Name] != t.lastUsed[best.Name] {
+			if t.lastUsed[node.Name] < t.lastUsed[best.Name] {
+				best = node
+			}
+			continue
+		}
+		if node.Name < best.Name {
+			best = node
+		}
+	}
+	return best
+}
+
+// RecordPlacement notes that a node was selected.
+func (t *TieBreaker) RecordPlacement(nodeName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.sequence++
+	t.lastUsed[nodeName] = t.sequence
+}
+
+// defaultTieBreaker is shared by all strategies so placement history outlives a single reconcile.
+var defaultTieBreaker, _ = NewTieBreaker(TieBreakName)
+
+// ConfigureTieBreak sets the tie-break policy used by all strategies.
+// It must be called during startup, before any workloads are scheduled.
+func ConfigureTieBreak(policy TieBreakPolicy) error {
+	tieBreaker, err := NewTieBreaker(policy)
+	if err != nil {
+		return err
+	}
+	defaultTieBreaker = tieBreaker
+	return nil
+}
+
+// RecordPlacement notes that a node was selected, for the leastRecentlyUsed tie-break policy.
+func RecordPlacement(nodeName string) {
+	defaultTieBreaker.RecordPlacement(nodeName)
+}
+
+// pickBest returns the fitting node with the highest score, comparing scores element by
+// element, and the score it achieved. Nodes with equal scores are separated by the
+// configured tie-break policy. score reports false for nodes that cannot host the workload.
+func pickBest(nodes []corev1.Node, score func(node *corev1.Node) ([]int64, bool)) (*corev1.Node, []int64) {
+	var tied []*corev1.Node
+	var bestScore []int64
+
+	for i := range nodes {
+		s, ok := score(&nodes[i])
+		if !ok {
+			continue
+		}
+		switch cmp := compareScores(s, bestScore); {
+		case tied == nil || cmp > 0:
+			tied = []*corev1.Node{&nodes[i]}
+			bestScore = s
+		case cmp == 0:
+			tied = append(tied, &nodes[i])
+		}
+	}
+
+	return defaultTieBreaker.Pick(tied), bestScore
+}
+
+// compareScores compares two scores lexicographically.
+func compareScores(a, b []int64) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] > b[i] {
+				return 1
+			}
+			return -1
+		}
+	}
+	return len(a) - len(b)
+}
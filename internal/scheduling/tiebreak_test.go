@@ -0,0 +1,130 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// useTieBreak configures the tie-break policy for the duration of a test.
+func useTieBreak(t *testing.T, policy TieBreakPolicy) {
+	t.Helper()
+	if err := ConfigureTieBreak(policy); err != nil {
+		t.Fatalf("ConfigureTieBreak(%q) error = %v", policy, err)
+	}
+	t.Cleanup(func() { ConfigureTieBreak(TieBreakName) })
+}
+
+func TestTieBreak_NameIsDeterministic(t *testing.T) {
+	useTieBreak(t, TieBreakName)
+
+	nodes := []corev1.Node{
+		createMockNode("node-c", 4),
+		createMockNode("node-a", 4),
+		createMockNode("node-b", 4),
+	}
+	strategy := NewLeastLoadedStrategy(logr.Discard())
+
+	for i := 0; i < 10; i++ {
+		node, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+		if err != nil {
+			t.Fatalf("ChooseNode() error = %v", err)
+		}
+		if node.Name != "node-a" {
+			t.Fatalf("Expected node-a under the name tie-break, got %s", node.Name)
+		}
+	}
+}
+
+func TestTieBreak_OnlyAppliesToEqualScores(t *testing.T) {
+	useTieBreak(t, TieBreakName)
+
+	nodes := []corev1.Node{
+		createMockNode("node-a", 2),
+		createMockNode("node-b", 8),
+	}
+	node, err := NewLeastLoadedStrategy(logr.Discard()).ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(2))
+	if err != nil {
+		t.Fatalf("ChooseNode() error = %v", err)
+	}
+	if node.Name != "node-b" {
+		t.Errorf("Expected node-b with the most available GPUs, got %s", node.Name)
+	}
+}
+
+func TestTieBreak_LeastRecentlyUsed(t *testing.T) {
+	useTieBreak(t, TieBreakLeastRecentlyUsed)
+
+	nodes := []corev1.Node{
+		createMockNode("node-a", 4),
+		createMockNode("node-b", 4),
+		createMockNode("node-c", 4),
+	}
+	strategy := NewLeastLoadedStrategy(logr.Discard())
+
+	// Each placement sends the next workload to the node used longest ago
+	var picked []string
+	for i := 0; i < 4; i++ {
+		node, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+		if err != nil {
+			t.Fatalf("ChooseNode() error = %v", err)
+		}
+		RecordPlacement(node.Name)
+		picked = append(picked, node.Name)
+	}
+
+	expected := []string{"node-a", "node-b", "node-c", "node-a"}
+	for i := range expected {
+		if picked[i] != expected[i] {
+			t.Fatalf("Expected rotation %v, got %v", expected, picked)
+		}
+	}
+}
+
+func TestTieBreak_RandomPicksAmongTiedNodes(t *testing.T) {
+	useTieBreak(t, TieBreakRandom)
+
+	nodes := []corev1.Node{
+		createMockNode("node-a", 4),
+		createMockNode("node-b", 4),
+		createMockNode("small", 1),
+	}
+	strategy := NewLeastLoadedStrategy(logr.Discard())
+
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		node, err := strategy.ChooseNode(context.Background(), nodes, nil, createMockGPUWorkload(1))
+		if err != nil {
+			t.Fatalf("ChooseNode() error = %v", err)
+		}
+		seen[node.Name] = true
+	}
+
+	if !seen["node-a"] || !seen["node-b"] || seen["small"] {
+		t.Errorf("Expected random picks among node-a and node-b only, got %v", seen)
+	}
+}
+
+func TestConfigureTieBreak_RejectsUnknownPolicy(t *testing.T) {
+	if err := ConfigureTieBreak("roundRobin"); err == nil {
+		t.Error("Expected an error for an unknown tie-break policy")
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Supported GPU vendors.
+const (
+	VendorNVIDIA = "nvidia"
+	VendorAMD    = "amd"
+	VendorIntel  = "intel"
+
+	// VendorAuto lets the scheduler pick the first vendor, in preference order, with a fitting node.
+	VendorAuto = "auto"
+)
+
+// vendorResources maps each vendor to the extended resource its device plugin advertises.
+var vendorResources = map[string]corev1.ResourceName{
+	VendorNVIDIA: "nvidia.com/gpu",
+	VendorAMD:    "amd.com/gpu",
+	VendorIntel:  "gpu.intel.com/i915",
+}
+
+// DefaultVendorPreference is the order in which vendors are tried for "auto" workloads.
+var DefaultVendorPreference = []string{VendorNVIDIA, VendorAMD, VendorIntel}
+
+// VendorResourceName returns the extended resource name for a vendor.
+func VendorResourceName(vendor string) (corev1.ResourceName, bool) {
+	name, ok := vendorResources[vendor]
+	return name, ok
+}
+
+// VendorGPUs returns the number of GPUs of the given vendor on a node,
+// taken from allocatable resources and falling back to capacity.
+func VendorGPUs(node *corev1.Node, vendor string) int64 {
+	resource, ok := vendorResources[vendor]
+	if !ok {
+		return 0
+	}
+	if quantity, ok := node.Status.Allocatable[resource]; ok {
+		return quantity.Value()
+	}
+	if quantity, ok := node.Status.Capacity[resource]; ok {
+		return quantity.Value()
+	}
+	return 0
+}
+
+// FilterNodesByVendor returns the nodes exposing GPUs of the given vendor.
+func FilterNodesByVendor(nodes []corev1.Node, vendor string) []corev1.Node {
+	var filtered []corev1.Node
+	for i := range nodes {
+		if VendorGPUs(&nodes[i], vendor) > 0 {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduling
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func createVendorNode(name string, resourceName corev1.ResourceName, gpus int64) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				resourceName: *resource.NewQuantity(gpus, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+func TestFilterNodesByVendor(t *testing.T) {
+	nodes := []corev1.Node{
+		createVendorNode("nvidia-node", "nvidia.com/gpu", 4),
+		createVendorNode("amd-node", "amd.com/gpu", 8),
+	}
+
+	amd := FilterNodesByVendor(nodes, VendorAMD)
+	if len(amd) != 1 || amd[0].Name != "amd-node" {
+		t.Errorf("Expected only amd-node, got %v", amd)
+	}
+	if n := FilterNodesByVendor(nodes, VendorIntel); len(n) != 0 {
+		t.Errorf("Expected no Intel nodes, got %d", len(n))
+	}
+	if gpus := getAvailableGPUs(&nodes[1]); gpus != 8 {
+		t.Errorf("Expected 8 available AMD GPUs, got %d", gpus)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sizing
+
+import "fmt"
+
+// RoundingMode selects the direction requests are rounded in.
+type RoundingMode string
+
+const (
+	// RoundOff leaves requests unchanged.
+	RoundOff RoundingMode = ""
+	// RoundUp rounds requests up to the next multiple of the granularity.
+	RoundUp RoundingMode = "up"
+	// RoundDown rounds requests down to the previous multiple of the granularity.
+	RoundDown RoundingMode = "down"
+	// RoundNearest rounds requests to the nearest multiple of the granularity, halves up.
+	RoundNearest RoundingMode = "nearest"
+)
+
+// ParseRoundingMode parses a rounding mode: "up", "down", "nearest", or empty for none.
+func ParseRoundingMode(value string) (RoundingMode, error) {
+	switch mode := RoundingMode(value); mode {
+	case RoundOff, RoundUp, RoundDown, RoundNearest:
+		return mode, nil
+	}
+	return "", fmt.Errorf("invalid rounding mode %q, expected up, down, or nearest", value)
+}
+
+// Rounding rounds requests to a multiple of Granularity, such as whole GPU pairs or
+// gibibytes of memory, so they match what device plugins and nodes hand out.
+type Rounding struct {
+	Mode        RoundingMode
+	Granularity int64
+}
+
+// Round returns value rounded to a multiple of the granularity. A positive value is never
+// rounded below one granularity, so rounding down cannot drop a request entirely. Values
+// are returned unchanged when rounding is off or the granularity is 1 or less.
+func (r Rounding) Round(value int64) int64 {
+	g := r.Granularity
+	if g <= 1 || value <= 0 {
+		return value
+	}
+
+	var rounded int64
+	switch r.Mode {
+	case RoundUp:
+		rounded = (value + g - 1) / g * g
+	case RoundDown:
+		rounded = value / g * g
+	case RoundNearest:
+		rounded = (value + g/2) / g * g
+	default:
+		return value
+	}
+	if rounded < g {
+		rounded = g
+	}
+	return rounded
+}
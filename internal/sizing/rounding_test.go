@@ -0,0 +1,59 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sizing
+
+import (
+	"testing"
+)
+
+func TestRounding_Round(t *testing.T) {
+	tests := []struct {
+		name     string
+		rounding Rounding
+		value    int64
+		expected int64
+	}{
+		{"up to the next multiple", Rounding{RoundUp, 4}, 5, 8},
+		{"up keeps a multiple", Rounding{RoundUp, 4}, 8, 8},
+		{"down to the previous multiple", Rounding{RoundDown, 4}, 7, 4},
+		{"down never below one granularity", Rounding{RoundDown, 4}, 3, 4},
+		{"nearest rounds down below half", Rounding{RoundNearest, 4}, 5, 4},
+		{"nearest rounds halves up", Rounding{RoundNearest, 4}, 6, 8},
+		{"off leaves the value", Rounding{RoundOff, 4}, 5, 5},
+		{"granularity of one leaves the value", Rounding{RoundUp, 1}, 5, 5},
+		{"zero stays zero", Rounding{RoundUp, 4}, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rounding.Round(tt.value); got != tt.expected {
+				t.Errorf("Round(%d) = %d, want %d", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseRoundingMode(t *testing.T) {
+	for _, value := range []string{"", "up", "down", "nearest"} {
+		if mode, err := ParseRoundingMode(value); err != nil || string(mode) != value {
+			t.Errorf("ParseRoundingMode(%q) = %q, %v", value, mode, err)
+		}
+	}
+	if _, err := ParseRoundingMode("ceil"); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
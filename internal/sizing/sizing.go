@@ -0,0 +1,75 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sizing infers GPU requirements for workloads that describe their model
+// size instead of an explicit GPU count.
+package sizing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultGPUMemoryGB is the per-GPU memory assumed when none is configured.
+const DefaultGPUMemoryGB = 80
+
+// InferGPUCount returns the number of GPUs needed to host a model.
+//
+// A known model name in lookup takes precedence over the size calculation.
+// Otherwise the count is ceil(modelSizeGB / gpuMemoryGB), with a minimum of 1.
+// A non-positive gpuMemoryGB falls back to DefaultGPUMemoryGB.
+func InferGPUCount(modelName string, modelSizeGB, gpuMemoryGB int32, lookup map[string]int32) int32 {
+	if count, ok := lookup[modelName]; ok && count > 0 {
+		return count
+	}
+
+	if modelSizeGB <= 0 {
+		return 0
+	}
+	if gpuMemoryGB <= 0 {
+		gpuMemoryGB = DefaultGPUMemoryGB
+	}
+
+	count := (modelSizeGB + gpuMemoryGB - 1) / gpuMemoryGB
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+// ParseModelGPUCounts parses a model lookup table of the form
+// "llama2-70b=4,mixtral=2" into a map of model name to GPU count.
+func ParseModelGPUCounts(value string) (map[string]int32, error) {
+	lookup := map[string]int32{}
+	if strings.TrimSpace(value) == "" {
+		return lookup, nil
+	}
+
+	for _, entry := range strings.Split(value, ",") {
+		name, countStr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid model GPU count entry %q, expected model=count", entry)
+		}
+		count, err := strconv.ParseInt(countStr, 10, 32)
+		if err != nil || count < 1 {
+			return nil, fmt.Errorf("invalid GPU count %q for model %q", countStr, name)
+		}
+		lookup[name] = int32(count)
+	}
+
+	return lookup, nil
+}
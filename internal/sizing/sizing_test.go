@@ -0,0 +1,71 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sizing
+
+import (
+	"testing"
+)
+
+func TestInferGPUCount(t *testing.T) {
+	lookup := map[string]int32{"llama2-70b": 4}
+
+	tests := []struct {
+		name        string
+		modelName   string
+		modelSizeGB int32
+		gpuMemoryGB int32
+		expected    int32
+	}{
+		{"small model fits on one GPU", "bert", 10, 80, 1},
+		{"exact multiple of GPU memory", "falcon", 160, 80, 2},
+		{"partial GPU rounds up", "mixtral", 90, 80, 2},
+		{"smaller GPUs need more devices", "mixtral", 90, 24, 4},
+		{"lookup overrides size", "llama2-70b", 10, 80, 4},
+		{"zero GPU memory uses default", "falcon", 160, 0, 2},
+		{"no size and unknown model", "unknown", 0, 80, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := InferGPUCount(tt.modelName, tt.modelSizeGB, tt.gpuMemoryGB, lookup)
+			if result != tt.expected {
+				t.Errorf("InferGPUCount(%q, %d, %d) = %d, want %d", tt.modelName, tt.modelSizeGB, tt.gpuMemoryGB, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseModelGPUCounts(t *testing.T) {
+	lookup, err := ParseModelGPUCounts("llama2-70b=4, mixtral=2")
+	if err != nil {
+		t.Fatalf("ParseModelGPUCounts() error = %v", err)
+	}
+	if lookup["llama2-70b"] != 4 || lookup["mixtral"] != 2 {
+		t.Errorf("Unexpected lookup table: %v", lookup)
+	}
+
+	empty, err := ParseModelGPUCounts("")
+	if err != nil || len(empty) != 0 {
+		t.Errorf("Expected empty lookup for empty input, got %v (err %v)", empty, err)
+	}
+
+	for _, invalid := range []string{"llama2", "llama2=zero", "=2", "llama2=0"} {
+		if _, err := ParseModelGPUCounts(invalid); err == nil {
+			t.Errorf("Expected error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package timewindow parses and evaluates recurring weekly time windows such as
+// "Mon-Fri 22:00-06:00". All times are evaluated in UTC.
+package timewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Window is a daily time range active on a set of weekdays.
+// A range whose end is before its start wraps past midnight.
+type Window struct {
+	days  [7]bool
+	start int
+	end   int
+}
+
+// Schedule is a set of windows; a time is inside the schedule if any window contains it.
+type Schedule []Window
+
+// Parse parses a schedule of one or more windows separated by ";".
+// Each window has the form "[days] HH:MM-HH:MM", where days is "*", a comma-separated
+// list of weekdays ("Sat,Sun"), or a range ("Mon-Fri"). Days default to every day.
+func Parse(spec string) (Schedule, error) {
+	var schedule Schedule
+	for _, part := range strings.Split(spec, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		window, err := parseWindow(part)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, window)
+	}
+	if len(schedule) == 0 {
+		return nil, fmt.Errorf("empty time window schedule %q", spec)
+	}
+	return schedule, nil
+}
+
+func parseWindow(spec string) (Window, error) {
+	var window Window
+	fields := strings.Fields(spec)
+
+	var daySpec, timeSpec string
+	switch len(fields) {
+	case 1:
+		daySpec, timeSpec = "*", fields[0]
+	case 2:
+		daySpec, timeSpec = fields[0], fields[1]
+	default:
+		return window, fmt.Errorf("invalid time window %q, expected \"[days] HH:MM-HH:MM\"", spec)
+	}
+
+	if err := window.parseDays(daySpec); err != nil {
+		return window, err
+	}
+
+	startStr, endStr, ok := strings.Cut(timeSpec, "-")
+	if !ok {
+		return window, fmt.Errorf("invalid time range %q, expected HH:MM-HH:MM", timeSpec)
+	}
+	var err error
+	if window.start, err = parseClock(startStr); err != nil {
+		return window, err
+	}
+	if window.end, err = parseClock(endStr); err != nil {
+		return window, err
+	}
+	return window, nil
+}
+
+func (w *Window) parseDays(spec string) error {
+	if spec == "*" {
+		for i := range w.days {
+			w.days[i] = true
+		}
+		return nil
+	}
+
+	for _, item := range strings.Split(spec, ",") {
+		from, to, isRange := strings.Cut(strings.ToLower(item), "-")
+		start, ok := weekdays[from]
+		if !ok {
+			return fmt.Errorf("invalid weekday %q", from)
+		}
+		end := start
+		if isRange {
+			if end, ok = weekdays[to]; !ok {
+				return fmt.Errorf("invalid weekday %q", to)
+			}
+		}
+		for day := start; ; day = (day + 1) % 7 {
+			w.days[day] = true
+			if day == end {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func parseClock(value string) (int, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", value)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+// Contains reports whether t falls inside the window.
+func (w Window) Contains(t time.Time) bool {
+	_, ok := w.closesAt(t)
+	return ok
+}
+
+// closesAt returns when the window containing t closes, and whether t is inside the window.
+func (w Window) closesAt(t time.Time) (time.Time, bool) {
+	t = t.UTC()
+	minute := t.Hour()*60 + t.Minute()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	if w.start < w.end {
+		if w.days[t.Weekday()] && minute >= w.start && minute < w.end {
+			return midnight.Add(time.Duration(w.end) * time.Minute), true
+		}
+		return time.Time{}, false
+	}
+
+	// The window wraps past midnight (or spans the whole day when start == end)
+	if minute >= w.start && w.days[t.Weekday()] {
+		return midnight.Add(time.Duration(minutesPerDay+w.end) * time.Minute), true
+	}
+	yesterday := (t.Weekday() + 6) % 7
+	if minute < w.end && w.days[yesterday] {
+		return midnight.Add(time.Duration(w.end) * time.Minute), true
+	}
+	return time.Time{}, false
+}
+
+// Contains reports whether t falls inside any window of the schedule.
+func (s Schedule) Contains(t time.Time) bool {
+	for _, window := range s {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remaining returns how long until the window containing t closes.
+// It returns zero when t is outside the schedule.
+func (s Schedule) Remaining(t time.Time) time.Duration {
+	var remaining time.Duration
+	for _, window := range s {
+		if end, ok := window.closesAt(t); ok && end.Sub(t) > remaining {
+			remaining = end.Sub(t)
+		}
+	}
+	return remaining
+}
+
+// UntilOpen returns how long until the schedule next opens after t.
+// It returns zero when t is inside the schedule or the schedule has no active days.
+func (s Schedule) UntilOpen(t time.Time) time.Duration {
+	if s.Contains(t) {
+		return 0
+	}
+
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	var until time.Duration
+	for _, window := range s {
+		// A week and a day covers every weekday even when today's start has passed
+		for day := 0; day <= 7; day++ {
+			opens := midnight.AddDate(0, 0, day).Add(time.Duration(window.start) * time.Minute)
+			if !window.days[opens.Weekday()] || !opens.After(t) {
+				continue
+			}
+			if d := opens.Sub(t); until == 0 || d < until {
+				until = d
+			}
+			break
+		}
+	}
+	return until
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 GPU_Orchestrator contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timewindow
+
+import (
+	"testing"
+	"time"
+)
+
+// 2025-01-03 is a Friday.
+func at(day, hour, minute int) time.Time {
+	return time.Date(2025, time.January, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestSchedule_Contains(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		at       time.Time
+		expected bool
+	}{
+		{"every day inside", "02:00-04:00", at(3, 3, 0), true},
+		{"every day before start", "02:00-04:00", at(3, 1, 59), false},
+		{"end is exclusive", "02:00-04:00", at(3, 4, 0), false},
+		{"weekday range inside", "Mon-Fri 09:00-17:00", at(3, 12, 0), true},
+		{"weekday range on weekend", "Mon-Fri 09:00-17:00", at(4, 12, 0), false},
+		{"day list", "Sat,Sun 00:00-06:00", at(5, 1, 0), true},
+		{"wraps midnight same day", "Fri 22:00-02:00", at(3, 23, 0), true},
+		{"wraps midnight next day", "Fri 22:00-02:00", at(4, 1, 0), true},
+		{"wraps midnight wrong day", "Fri 22:00-02:00", at(3, 1, 0), false},
+		{"multiple windows", "Mon 01:00-02:00; Fri 10:00-11:00", at(3, 10, 30), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.spec, err)
+			}
+			if got := schedule.Contains(tt.at); got != tt.expected {
+				t.Errorf("Contains(%v) = %v, want %v", tt.at, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSchedule_Remaining(t *testing.T) {
+	schedule, err := Parse("Fri 22:00-02:00")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if got := schedule.Remaining(at(3, 23, 0)); got != 3*time.Hour {
+		t.Errorf("Remaining() = %v, want 3h", got)
+	}
+	if got := schedule.Remaining(at(3, 12, 0)); got != 0 {
+		t.Errorf("Remaining() outside window = %v, want 0", got)
+	}
+}
+
+func TestSchedule_UntilOpen(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		at       time.Time
+		expected time.Duration
+	}{
+		{"later today", "22:00-02:00", at(3, 12, 0), 10 * time.Hour},
+		{"inside window", "22:00-02:00", at(3, 23, 0), 0},
+		{"after today's window", "Fri 09:00-17:00", at(3, 18, 0), 7*24*time.Hour - 9*time.Hour},
+		{"over the weekend", "Mon-Fri 09:00-17:00", at(4, 9, 0), 2 * 24 * time.Hour},
+		{"earliest of several windows", "Sun 06:00-07:00; Sat 20:00-21:00", at(3, 20, 0), 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.spec, err)
+			}
+			if got := schedule.UntilOpen(tt.at); got != tt.expected {
+				t.Errorf("UntilOpen(%v) = %v, want %v", tt.at, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "Funday 01:00-02:00", "01:00", "25:00-26:00", "Mon 01:00-02:00 extra"} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Expected error parsing %q", spec)
+		}
+	}
+}